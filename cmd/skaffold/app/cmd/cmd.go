@@ -33,18 +33,20 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/survey"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trace"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/update"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
 
 var (
-	opts              config.SkaffoldOptions
-	v                 string
-	defaultColor      int
-	forceColors       bool
-	overwrite         bool
-	interactive       bool
-	shutdownAPIServer func() error
+	opts                  config.SkaffoldOptions
+	v                     string
+	defaultColor          int
+	forceColors           bool
+	overwrite             bool
+	interactive           bool
+	shutdownAPIServer     func() error
+	shutdownTraceExporter func() error
 )
 
 // Annotation for commands that should allow post execution housekeeping messages like updates and surveys
@@ -85,6 +87,13 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			}
 			shutdownAPIServer = shutdown
 
+			// Start exporting traces
+			shutdownTraces, err := trace.Initialize(opts)
+			if err != nil {
+				return fmt.Errorf("initializing trace exporter: %w", err)
+			}
+			shutdownTraceExporter = shutdownTraces
+
 			// Print version
 			version := version.Get()
 			logrus.Infof("Skaffold %+v", version)
@@ -168,6 +177,7 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 	rootCmd.AddCommand(NewCmdOptions())
 	rootCmd.AddCommand(NewCmdCredits())
 	rootCmd.AddCommand(NewCmdSchema())
+	rootCmd.AddCommand(NewCmdInspect())
 	rootCmd.AddCommand(NewCmdFilter())
 
 	rootCmd.AddCommand(NewCmdGeneratePipeline())
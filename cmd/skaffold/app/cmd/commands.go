@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -113,6 +114,11 @@ func (b *builder) ExactArgs(argCount int, action func(context.Context, io.Writer
 		if shutdownAPIServer != nil {
 			shutdownAPIServer()
 		}
+		if shutdownTraceExporter != nil {
+			if err := shutdownTraceExporter(); err != nil {
+				logrus.Warnf("exporting traces: %s", err)
+			}
+		}
 		return err
 	}
 	return &b.cmd
@@ -127,6 +133,11 @@ func (b *builder) NoArgs(action func(context.Context, io.Writer) error) *cobra.C
 		if shutdownAPIServer != nil {
 			shutdownAPIServer()
 		}
+		if shutdownTraceExporter != nil {
+			if err := shutdownTraceExporter(); err != nil {
+				logrus.Warnf("exporting traces: %s", err)
+			}
+		}
 		return err
 	}
 	return &b.cmd
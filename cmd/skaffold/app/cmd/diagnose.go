@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -54,6 +55,9 @@ func doDiagnose(ctx context.Context, out io.Writer) error {
 	if !yamlOnly {
 		fmt.Fprintln(out, "Skaffold version:", version.Get().GitCommit)
 		fmt.Fprintln(out, "Configuration version:", config.APIVersion)
+		if len(runCtx.RequiredConfigFiles) > 0 {
+			fmt.Fprintln(out, "Required configurations:", strings.Join(runCtx.RequiredConfigFiles, ", "))
+		}
 		fmt.Fprintln(out, "Number of artifacts:", len(config.Build.Artifacts))
 
 		if err := diagnose.CheckArtifacts(ctx, runCtx, out); err != nil {
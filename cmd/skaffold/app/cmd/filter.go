@@ -28,6 +28,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -55,31 +57,51 @@ func NewCmdFilter() *cobra.Command {
 		})
 }
 
-// runFilter loads the Kubernetes manifests from stdin and applies the debug transformations.
-// Unlike `skaffold debug`, this filtering affects all images and not just the built artifacts.
+// runFilter loads the Kubernetes manifests from stdin and applies the image-replacement, manifest
+// and labelling transforms, in the same order as the kubectl, kustomize and kpt deployers.
+// This allows deployers like Helm to pipe their rendered manifests through the same transforms
+// and validation.
+// Unlike `skaffold debug`, the debug filtering affects all images and not just the built artifacts.
 func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildArtifacts []build.Artifact) error {
 	return withRunner(ctx, func(r runner.Runner, cfg *latest.SkaffoldConfig) error {
 		manifestList, err := manifest.Load(os.Stdin)
 		if err != nil {
 			return fmt.Errorf("loading manifests: %w", err)
 		}
-		if debuggingFilters {
-			// TODO(bdealwis): refactor this code
-			debugHelpersRegistry, err := config.GetDebugHelpersRegistry(opts.GlobalConfig)
+		if len(buildArtifacts) > 0 {
+			manifestList, err = manifestList.ReplaceImages(buildArtifacts)
 			if err != nil {
-				return fmt.Errorf("resolving debug helpers: %w", err)
+				return fmt.Errorf("replacing images in manifests: %w", err)
 			}
-			insecureRegistries, err := getInsecureRegistries(opts, cfg)
+		}
+		if debuggingFilters {
+			manifest.AddTransform(debugging.ApplyDebuggingTransforms)
+		}
+		debugHelpersRegistry, err := config.GetDebugHelpersRegistry(opts.GlobalConfig)
+		if err != nil {
+			return fmt.Errorf("resolving debug helpers: %w", err)
+		}
+		insecureRegistries, err := getInsecureRegistries(opts, cfg)
+		if err != nil {
+			return fmt.Errorf("retrieving insecure registries: %w", err)
+		}
+		manifestList, err = manifest.ApplyTransforms(manifestList, buildArtifacts, insecureRegistries, debugHelpersRegistry)
+		if err != nil {
+			return fmt.Errorf("transforming manifests: %w", err)
+		}
+		// the run-id label is passed explicitly by the deployer through `--label`
+		labels := label.NewLabeller(false, opts.CustomLabels).Labels()
+		if manifestList, err = manifestList.SetLabels(labels); err != nil {
+			return fmt.Errorf("labelling manifests: %w", err)
+		}
+		if cfg.Deploy.Validate != nil {
+			// the filter runs in the working directory of the deployer
+			cwd, err := os.Getwd()
 			if err != nil {
-				return fmt.Errorf("retrieving insecure registries: %w", err)
+				return err
 			}
-
-			manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, manifest.Registries{
-				DebugHelpersRegistry: debugHelpersRegistry,
-				InsecureRegistries:   insecureRegistries,
-			})
-			if err != nil {
-				return fmt.Errorf("transforming manifests: %w", err)
+			if err := validate.NewValidator(cfg.Deploy.Validate, cwd).Validate(ctx, manifestList, false); err != nil {
+				return err
 			}
 		}
 		out.Write([]byte(manifestList.String()))
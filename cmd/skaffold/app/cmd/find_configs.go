@@ -69,6 +69,10 @@ func doFindConfigs(_ context.Context, out io.Writer) error {
 			if v != latest.Version {
 				c = color.Green
 			}
+			if requires := requiredConfigs(p); len(requires) > 0 {
+				c.Fprintf(out, fmt.Sprintf("%%-%ds\t%%-%ds\trequires: %%s\n", pathOutLen, versionOutLen), p, v, strings.Join(requires, ", "))
+				continue
+			}
 			c.Fprintf(out, fmt.Sprintf("%%-%ds\t%%-%ds\n", pathOutLen, versionOutLen), p, v)
 		}
 
@@ -96,3 +100,22 @@ func findConfigs(directory string) (map[string]string, error) {
 
 	return pathToVersion, err
 }
+
+// requiredConfigs lists the paths of the configs required by a config file.
+// Only configs using the latest schema version can require other configs.
+func requiredConfigs(path string) []string {
+	parsed, err := schema.ParseConfig(path)
+	if err != nil {
+		return nil
+	}
+	cfg, ok := parsed.(*latest.SkaffoldConfig)
+	if !ok {
+		return nil
+	}
+
+	var requires []string
+	for _, d := range cfg.Dependencies {
+		requires = append(requires, d.Path)
+	}
+	return requires
+}
@@ -69,6 +69,22 @@ func TestFindConfigs(t *testing.T) {
 	}
 }
 
+func TestRequiredConfigs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().WriteFiles(map[string]string{
+			"root.yaml":        validYaml(latest.Version) + "\nrequires:\n- path: backend\n- path: frontend/skaffold.yaml",
+			"valid.yaml":       validYaml(latest.Version),
+			"upgradeable.yaml": validYaml(v1beta7.Version),
+			"invalid.yaml":     invalidYaml(),
+		})
+
+		t.CheckDeepEqual([]string{"backend", "frontend/skaffold.yaml"}, requiredConfigs(tmpDir.Path("root.yaml")))
+		t.CheckEmpty(requiredConfigs(tmpDir.Path("valid.yaml")))
+		t.CheckEmpty(requiredConfigs(tmpDir.Path("upgradeable.yaml")))
+		t.CheckEmpty(requiredConfigs(tmpDir.Path("invalid.yaml")))
+	})
+}
+
 func validYaml(version string) string {
 	return fmt.Sprintf("apiVersion: %s\nkind: Config", version)
 }
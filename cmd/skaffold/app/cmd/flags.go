@@ -103,6 +103,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "explain-cache",
+		Usage:         "Explain which inputs of an artifact changed since its last build when it isn't found in the cache",
+		Value:         &opts.ExplainCache,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "cache-file",
 		Usage:         "Specify the location of the cache file (default $HOME/.skaffold/cache)",
@@ -111,6 +120,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "remote-cache",
+		Usage:         "Location of a cache shared between machines: a directory, an HTTP key/value store (http[s]://...) or a container repository (oci://...). Requires --cache-artifacts",
+		Value:         &opts.RemoteCache,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "insecure-registry",
 		Usage:         "Target registries for built images which are not secure",
@@ -139,6 +156,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "otlp-endpoint",
+		Usage:         "Export each dev iteration as an OpenTelemetry trace to the provided OTLP/HTTP endpoint, e.g. localhost:4318",
+		Value:         &opts.OTLPEndpoint,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "rpc-port",
 		Usage:         "tcp port to expose event API",
@@ -162,7 +187,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.CustomLabels,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "filter"},
 	},
 	{
 		Name:          "toot",
@@ -186,6 +211,23 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "log-format",
+		Usage:         "Format of the streamed logs. One of [text json]. `json` prints each log line as a JSON object with the pod, container, namespace, image, timestamp, level and message",
+		Value:         &opts.LogFormat,
+		DefValue:      "text",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "log-previous",
+		Usage:         "Also stream the logs of the previous instance of containers that crashed before their logs were streamed",
+		Value:         &opts.LogPrevious,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "force",
 		Usage:         "Recreate Kubernetes resources if necessary for deployment, warning: might cause downtime!",
@@ -240,6 +282,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "port-forward-mode",
+		Usage:         "How ports are forwarded. One of [kubectl native]. `native` forwards ports through the Kubernetes API, without spawning `kubectl port-forward` processes, and reconnects when pods are replaced",
+		Value:         &opts.PortForward.Mode,
+		DefValue:      "kubectl",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
 	{
 		Name:          "status-check",
 		Usage:         "Wait for deployed resources to stabilize",
@@ -249,6 +299,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "rollback-on-failure",
+		Usage:         "Restore the previous version of the deployed resources when the status check fails",
+		Value:         &opts.RollbackOnFailure,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"deploy", "run"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "render-only",
 		Usage:         "Print rendered Kubernetes manifests instead of deploying them",
@@ -258,6 +317,14 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "sync-mode",
+		Usage:         "How files are synced to running containers. One of [kubectl native]. `native` streams files through the Kubernetes API and doesn't require `tar` in the container",
+		Value:         &opts.SyncMode,
+		DefValue:      "kubectl",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "debug"},
+	},
 	{
 		Name:          "render-output",
 		Usage:         "Writes '--render-only' output to the specified file",
@@ -401,7 +468,7 @@ var flagRegistry = []Flag{
 	},
 	{
 		Name:          "mute-logs",
-		Usage:         "mute logs for specified stages in pipeline (build, deploy, status-check, none, all)",
+		Usage:         "mute logs for specified stages in pipeline (build, sync, deploy, status-check, none, all)",
 		Value:         &opts.Muted.Phases,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
@@ -41,6 +41,9 @@ var (
 	enableJibInit            bool
 	enableJibGradleInit      bool
 	enableBuildpacksInit     bool
+	enableBazelInit          bool
+	enableKoInit             bool
+	enableCustomInit         bool
 	enableNewInitFormat      bool
 	enableManifestGeneration bool
 )
@@ -66,6 +69,9 @@ func NewCmdInit() *cobra.Command {
 			{Value: &enableJibInit, Name: "XXenableJibInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &enableJibGradleInit, Name: "XXenableJibGradleInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &enableBuildpacksInit, Name: "XXenableBuildpacksInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
+			{Value: &enableBazelInit, Name: "XXenableBazelInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
+			{Value: &enableKoInit, Name: "XXenableKoInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
+			{Value: &enableCustomInit, Name: "XXenableCustomInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &buildpacksBuilder, Name: "XXdefaultBuildpacksBuilder", DefValue: "gcr.io/buildpacks/builder:v1", Usage: "", Hidden: true},
 			{Value: &enableManifestGeneration, Name: "XXenableManifestGeneration", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 		}).
@@ -86,7 +92,10 @@ func doInit(ctx context.Context, out io.Writer) error {
 		EnableJibInit:            enableJibInit,
 		EnableJibGradleInit:      enableJibGradleInit,
 		EnableBuildpacksInit:     enableBuildpacksInit,
-		EnableNewInitFormat:      enableNewInitFormat || enableBuildpacksInit || enableJibInit,
+		EnableBazelInit:          enableBazelInit,
+		EnableKoInit:             enableKoInit,
+		EnableCustomInit:         enableCustomInit,
+		EnableNewInitFormat:      enableNewInitFormat || enableBuildpacksInit || enableJibInit || enableBazelInit || enableKoInit || enableCustomInit,
 		EnableManifestGeneration: enableManifestGeneration,
 		Opts:                     opts,
 		MaxFileSize:              maxFileSize,
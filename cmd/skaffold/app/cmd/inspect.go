@@ -0,0 +1,47 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/cmd/inspect"
+)
+
+func NewCmdInspect() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect the output of past Skaffold sessions",
+	}
+
+	cmd.AddCommand(NewCmdInspectEvents())
+	return cmd
+}
+
+func NewCmdInspectEvents() *cobra.Command {
+	return NewCmd("events").
+		WithDescription("Print the timeline of a saved event log").
+		WithLongDescription("Print, for each dev iteration of a Skaffold session, when each build, test, sync, deploy and status check started and how long it took. The event log is saved with `--event-log-file`.").
+		WithExample("Save the event log of a dev session", "dev --enable-rpc --event-log-file=events.log").
+		WithExample("Print the timeline of the session", "inspect events events.log").
+		ExactArgs(1, func(ctx context.Context, out io.Writer, args []string) error {
+			return inspect.Events(ctx, out, args[0])
+		})
+}
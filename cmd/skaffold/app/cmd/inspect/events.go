@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trace"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// Events prints to `out` the timeline of an event log saved with `--event-log-file`.
+func Events(_ context.Context, out io.Writer, file string) error {
+	entries, err := event.LoadEventsFromFile(file)
+	if err != nil {
+		return err
+	}
+
+	printTimeline(out, timeline(entries))
+	return nil
+}
+
+// timeline groups the events into one span tree per dev iteration.
+func timeline(entries []*proto.LogEntry) []*trace.Span {
+	t := trace.NewTimeline()
+
+	var roots []*trace.Span
+	for _, entry := range entries {
+		if root := t.Add(entry); root != nil {
+			roots = append(roots, root)
+		}
+	}
+	if root := t.Flush(); root != nil {
+		roots = append(roots, root)
+	}
+	return roots
+}
+
+func printTimeline(out io.Writer, roots []*trace.Span) {
+	if len(roots) == 0 {
+		fmt.Fprintln(out, "No events to show")
+		return
+	}
+
+	origin := roots[0].Start
+	fmt.Fprintf(out, "%-10s %-10s %s\n", "START", "DURATION", "PHASE")
+
+	var printSpan func(s *trace.Span, depth int)
+	printSpan = func(s *trace.Span, depth int) {
+		status := ""
+		if s.Failed {
+			status = " (failed)"
+			if s.Message != "" {
+				status = fmt.Sprintf(" (failed: %s)", s.Message)
+			}
+		}
+		fmt.Fprintf(out, "%-10s %-10s %s%s%s\n", offset(s.Start.Sub(origin)), duration(s.Duration()), strings.Repeat("  ", depth), s.Name, status)
+
+		for _, child := range s.Children {
+			printSpan(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		printSpan(root, 0)
+	}
+}
+
+func offset(d time.Duration) string {
+	return "+" + duration(d)
+}
+
+func duration(d time.Duration) string {
+	return fmt.Sprintf("%.3fs", d.Seconds())
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestEvents(t *testing.T) {
+	tests := []struct {
+		description string
+		events      string
+		expected    string
+	}{
+		{
+			description: "dev iterations",
+			events: `{"timestamp":"2020-12-01T10:00:00Z","event":{"devLoopEvent":{"iteration":0,"status":"In Progress"}}}
+{"timestamp":"2020-12-01T10:00:01Z","event":{"buildEvent":{"artifact":"img","status":"In Progress"}}}
+{"timestamp":"2020-12-01T10:00:04.500Z","event":{"buildEvent":{"artifact":"img","status":"Complete"}}}
+{"timestamp":"2020-12-01T10:00:05Z","event":{"deployEvent":{"status":"In Progress"}}}
+{"timestamp":"2020-12-01T10:00:06Z","event":{"deployEvent":{"status":"Complete"}}}
+{"timestamp":"2020-12-01T10:00:06Z","event":{"devLoopEvent":{"iteration":0,"status":"Succeeded"}}}
+{"timestamp":"2020-12-01T10:01:00Z","event":{"devLoopEvent":{"iteration":1,"status":"In Progress"}}}
+{"timestamp":"2020-12-01T10:01:00Z","event":{"testEvent":{"artifact":"img","name":"custom","status":"In Progress"}}}
+{"timestamp":"2020-12-01T10:01:02Z","event":{"testEvent":{"artifact":"img","name":"custom","status":"Failed","actionableErr":{"message":"exit status 1"}}}}
+{"timestamp":"2020-12-01T10:01:02Z","event":{"devLoopEvent":{"iteration":1,"status":"Failed"}}}
+`,
+			expected: `START      DURATION   PHASE
++0.000s    6.000s     dev iteration 0
++1.000s    3.500s       build img
++5.000s    1.000s       deploy
++60.000s   2.000s     dev iteration 1 (failed)
++60.000s   2.000s       test img (failed: exit status 1)
+`,
+		},
+		{
+			description: "no events",
+			expected:    "No events to show\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("events.log", test.events)
+
+			var out bytes.Buffer
+			err := Events(context.Background(), &out, tmpDir.Path("events.log"))
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func TestEventsMissingFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		err := Events(context.Background(), &bytes.Buffer{}, "missing.log")
+
+		t.CheckErrorContains("opening missing.log", err)
+	})
+}
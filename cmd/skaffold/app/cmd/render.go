@@ -22,10 +22,14 @@ import (
 	"io"
 	"io/ioutil"
 
+	"github.com/rakyll/statik/fs"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/cmd/statik"
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -59,6 +63,7 @@ func doRender(ctx context.Context, out io.Writer) error {
 	if showBuild {
 		buildOut = out
 	}
+	validate.OfflineSchema = offlineSchema
 
 	return withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
 		var bRes []build.Artifact
@@ -79,3 +84,17 @@ func doRender(ctx context.Context, out io.Writer) error {
 		return nil
 	})
 }
+
+// offlineSchema reads the Kubernetes OpenAPI schema bundled with Skaffold,
+// used to validate the manifests rendered with --offline.
+func offlineSchema() ([]byte, error) {
+	statikFS, err := statik.FS()
+	if err != nil {
+		return nil, err
+	}
+
+	if version, err := fs.ReadFile(statikFS, "/k8s-openapi/VERSION"); err == nil {
+		logrus.Infof("Validating manifests against the OpenAPI schema of Kubernetes %s", version)
+	}
+	return fs.ReadFile(statikFS, "/k8s-openapi/swagger.json")
+}
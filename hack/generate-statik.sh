@@ -46,6 +46,29 @@ chmod -R u+w "${TMP_DIR}/skaffold-credits"
 echo "Collecting schemas"
 cp -R docs/content/en/schemas "${TMP_DIR}/schemas"
 
+# Upstream Kubernetes OpenAPI schema used by `skaffold render --offline`.
+# It is taken from the k8s.io/kubectl module pinned in go.mod, so no download beyond the
+# module cache is needed, and its checksum is verified before the schema is embedded.
+# When k8s.io/kubectl is updated, update the version and the checksum too.
+K8S_OPENAPI_VERSION=v1.16.0
+K8S_OPENAPI_SHA256=5e66eea78072dfddfd493adb53c11c0f0c2930a292c6a9682556aec8f9406435
+
+echo "Collecting the Kubernetes ${K8S_OPENAPI_VERSION} OpenAPI schema"
+mkdir -p "${TMP_DIR}/k8s-openapi"
+go mod download k8s.io/kubectl
+cp "$(go list -m -f '{{.Dir}}' k8s.io/kubectl)/testdata/openapi/swagger.json" "${TMP_DIR}/k8s-openapi/swagger.json"
+chmod u+w "${TMP_DIR}/k8s-openapi/swagger.json"
+if [ -x "$(command -v sha256sum)" ]; then
+    SHA256=$(sha256sum "${TMP_DIR}/k8s-openapi/swagger.json" | cut -d' ' -f1)
+else
+    SHA256=$(shasum -a 256 "${TMP_DIR}/k8s-openapi/swagger.json" | cut -d' ' -f1)
+fi
+if [ "${SHA256}" != "${K8S_OPENAPI_SHA256}" ]; then
+    echo "Unexpected checksum for the Kubernetes OpenAPI schema: ${SHA256}, want ${K8S_OPENAPI_SHA256}"
+    exit 1
+fi
+echo -n "${K8S_OPENAPI_VERSION}" > "${TMP_DIR}/k8s-openapi/VERSION"
+
 if ! [[ -f ${STATIK} ]]; then
     echo 'Installing statik tool'
     pushd ${DIR}/tools
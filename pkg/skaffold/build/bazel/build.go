@@ -26,6 +26,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -87,7 +89,10 @@ func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string,
 	}
 	defer imageTar.Close()
 
-	bazelTag := buildImageTag(a.BuildTarget)
+	bazelTag, err := loadedImageRef(tarPath, a.BuildTarget)
+	if err != nil {
+		return "", fmt.Errorf("reading image tarball: %w", err)
+	}
 	imageID, err := b.localDocker.Load(ctx, out, imageTar, bazelTag)
 	if err != nil {
 		return "", fmt.Errorf("loading image into docker daemon: %w", err)
@@ -131,6 +136,25 @@ func buildTarPath(buildTarget string) string {
 	return tarPath
 }
 
+// loadedImageRef returns the reference of the image once the tarball is loaded into Docker.
+// rules_docker tags the image after the build target, whereas rules_oci uses the `repo_tags`
+// of the `oci_tarball`, that may be empty: the image ID, which is the config digest, is used instead.
+func loadedImageRef(tarPath string, buildTarget string) (string, error) {
+	if !strings.HasSuffix(buildTarget, "/tarball.tar") {
+		return buildImageTag(buildTarget), nil
+	}
+
+	img, err := tarball.ImageFromPath(tarPath, nil)
+	if err != nil {
+		return "", err
+	}
+	config, err := img.ConfigName()
+	if err != nil {
+		return "", err
+	}
+	return config.String(), nil
+}
+
 func buildImageTag(buildTarget string) string {
 	imageTag := trimTarget(buildTarget)
 	imageTag = strings.TrimPrefix(imageTag, ":")
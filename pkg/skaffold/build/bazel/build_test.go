@@ -21,6 +21,10 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -90,6 +94,27 @@ func TestBuildTarPath(t *testing.T) {
 	testutil.CheckDeepEqual(t, "skaffold_example.tar", tarPath)
 }
 
+func TestLoadedImageRef(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		img, err := random.Image(1024, 1)
+		t.RequireNoError(err)
+		ref, err := name.ParseReference("app:latest")
+		t.RequireNoError(err)
+		t.RequireNoError(tarball.WriteToFile(tmpDir.Path("tarball.tar"), ref, img))
+		config, err := img.ConfigName()
+		t.RequireNoError(err)
+
+		dockerRef, err := loadedImageRef("unused.tar", "//:app.tar")
+		t.CheckNoError(err)
+		t.CheckDeepEqual("bazel:app", dockerRef)
+
+		ociRef, err := loadedImageRef(tmpDir.Path("tarball.tar"), "//app:tarball/tarball.tar")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(config.String(), ociRef)
+	})
+}
+
 func TestBuildImageTag(t *testing.T) {
 	buildTarget := "//:skaffold_example.tar"
 
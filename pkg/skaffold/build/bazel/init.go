@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bazel
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	Validate = validate
+)
+
+// Name is the name of the Bazel builder
+var Name = "Bazel"
+
+var (
+	imageRuleRegex = regexp.MustCompile(`(?m)^\s*(container_image|oci_tarball)\s*\(`)
+	nameAttrRegex  = regexp.MustCompile(`name\s*=\s*"([^"]+)"`)
+)
+
+// ArtifactConfig holds information about a Bazel image target
+type ArtifactConfig struct {
+	File   string `json:"path,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+// Name returns the name of the builder
+func (c ArtifactConfig) Name() string {
+	return Name
+}
+
+// Describe returns the initBuilder's string representation, used when prompting the user to choose a builder.
+func (c ArtifactConfig) Describe() string {
+	return fmt.Sprintf("%s (%s)", c.Name(), c.Target)
+}
+
+// ArtifactType returns the type of the artifact to be built.
+func (c ArtifactConfig) ArtifactType() latest.ArtifactType {
+	return latest.ArtifactType{
+		BazelArtifact: &latest.BazelArtifact{
+			BuildTarget: c.Target,
+		},
+	}
+}
+
+// ConfiguredImage returns the target image configured by the builder, or empty string if no image is configured
+func (c ArtifactConfig) ConfiguredImage() string {
+	// Target image is not configured in bazel
+	return ""
+}
+
+// Path returns the path to the build definition
+func (c ArtifactConfig) Path() string {
+	return c.File
+}
+
+// validate checks if a file is a Bazel BUILD file that defines `container_image` (rules_docker)
+// or `oci_tarball` (rules_oci) targets. Returns a config for the tarball of each target, or nil if there are none.
+// A rules_oci `oci_image` has no tarball of its own and is only found through the `oci_tarball` that loads it.
+func validate(path string) []ArtifactConfig {
+	switch filepath.Base(path) {
+	case "BUILD", "BUILD.bazel":
+	default:
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	rules := imageRuleRegex.FindAllSubmatchIndex(content, -1)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// Labels are relative to the root of the Bazel workspace.
+	root, err := findWorkspace(filepath.Dir(path))
+	if err != nil {
+		return nil
+	}
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil
+	}
+	pkg, err := filepath.Rel(root, dir)
+	if err != nil {
+		return nil
+	}
+	pkg = filepath.ToSlash(pkg)
+	if pkg == "." {
+		pkg = ""
+	}
+
+	var results []ArtifactConfig
+	for i, rule := range rules {
+		end := len(content)
+		if i+1 < len(rules) {
+			end = rules[i+1][0]
+		}
+
+		name := nameAttrRegex.FindSubmatch(content[rule[1]:end])
+		if name == nil {
+			continue
+		}
+		target := fmt.Sprintf("//%s:%s.tar", pkg, name[1])
+		if string(content[rule[2]:rule[3]]) == "oci_tarball" {
+			target = fmt.Sprintf("//%s:%s/tarball.tar", pkg, name[1])
+		}
+		results = append(results, ArtifactConfig{
+			File:   path,
+			Target: target,
+		})
+	}
+	return results
+}
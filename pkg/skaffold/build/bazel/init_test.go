@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bazel
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		files       map[string]string
+		expected    []ArtifactConfig
+	}{
+		{
+			description: "not a BUILD file",
+			path:        "app/main.go",
+			files:       map[string]string{"WORKSPACE": "", "app/main.go": ""},
+		},
+		{
+			description: "no image target",
+			path:        "app/BUILD",
+			files:       map[string]string{"WORKSPACE": "", "app/BUILD": `go_binary(name = "app")`},
+		},
+		{
+			description: "image targets",
+			path:        "services/app/BUILD.bazel",
+			files: map[string]string{
+				"WORKSPACE": "",
+				"services/app/BUILD.bazel": `
+load("@io_bazel_rules_docker//container:container.bzl", "container_image")
+
+go_binary(
+    name = "app",
+    srcs = ["main.go"],
+)
+
+container_image(
+    name = "image",
+    base = "@base//image",
+    files = [":app"],
+)
+
+container_image(
+    files = [":app"],
+    name = "debug-image",
+)
+`,
+			},
+			expected: []ArtifactConfig{
+				{File: "services/app/BUILD.bazel", Target: "//services/app:image.tar"},
+				{File: "services/app/BUILD.bazel", Target: "//services/app:debug-image.tar"},
+			},
+		},
+		{
+			description: "rules_oci targets",
+			path:        "app/BUILD.bazel",
+			files: map[string]string{
+				"WORKSPACE": "",
+				"app/BUILD.bazel": `
+load("@rules_oci//oci:defs.bzl", "oci_image", "oci_tarball")
+
+oci_image(
+    name = "image",
+    base = "@distroless_base",
+    tars = [":app_layer"],
+)
+
+oci_tarball(
+    name = "tarball",
+    image = ":image",
+    repo_tags = ["app:latest"],
+)
+`,
+			},
+			expected: []ArtifactConfig{{File: "app/BUILD.bazel", Target: "//app:tarball/tarball.tar"}},
+		},
+		{
+			description: "oci_image without oci_tarball",
+			path:        "app/BUILD",
+			files:       map[string]string{"WORKSPACE": "", "app/BUILD": `oci_image(name = "image")`},
+		},
+		{
+			description: "root package",
+			path:        "BUILD",
+			files:       map[string]string{"WORKSPACE": "", "BUILD": `container_image(name = "image")`},
+			expected:    []ArtifactConfig{{File: "BUILD", Target: "//:image.tar"}},
+		},
+		{
+			description: "no WORKSPACE",
+			path:        "app/BUILD",
+			files:       map[string]string{"app/BUILD": `container_image(name = "image")`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			configs := validate(test.path)
+
+			t.CheckDeepEqual(test.expected, configs)
+		})
+	}
+}
+
+func TestArtifactType(t *testing.T) {
+	config := ArtifactConfig{File: "app/BUILD", Target: "//app:image.tar"}
+
+	testutil.CheckDeepEqual(t, "Bazel (//app:image.tar)", config.Describe())
+	testutil.CheckDeepEqual(t, latest.ArtifactType{BazelArtifact: &latest.BazelArtifact{BuildTarget: "//app:image.tar"}}, config.ArtifactType())
+}
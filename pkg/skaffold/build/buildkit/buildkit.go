@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// cacheTag is the tag of the images that hold the exported build cache.
+const cacheTag = "buildcache"
+
+// Build builds a `docker` artifact with `buildctl`. The image is either pushed by BuildKit,
+// or exported as a tarball and loaded into the local Docker daemon.
+func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	dockerfile, err := docker.NormalizeDockerfilePath(a.Workspace, a.DockerArtifact.DockerfilePath)
+	if err != nil {
+		return "", fmt.Errorf("normalizing dockerfile path: %w", err)
+	}
+	if _, err := os.Stat(dockerfile); os.IsNotExist(err) {
+		return "", fmt.Errorf("dockerfile not found for %q: %w", a.ImageName, err)
+	}
+
+	if len(a.Platforms) > 1 && !b.pushImages {
+		return "", platform.MultiPlatformNoPushErr(a.ImageName)
+	}
+
+	buildArgs, err := docker.EvalBuildArgs(b.cfg.Mode(), a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true))
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+
+	args, err := b.buildctlArgs(a.Workspace, dockerfile, a.DockerArtifact, buildArgs, a.Platforms, tag)
+	if err != nil {
+		return "", err
+	}
+
+	if b.pushImages {
+		args = append(args, "--output", "type=image,name="+tag+",push=true")
+		if err := b.runBuildctl(ctx, out, args); err != nil {
+			return "", err
+		}
+		return docker.RemoteDigest(tag, b.cfg)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-buildkit")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	tarPath := filepath.Join(tmpDir, "image.tar")
+	args = append(args, "--output", "type=docker,name="+tag+",dest="+tarPath)
+	if err := b.runBuildctl(ctx, out, args); err != nil {
+		return "", err
+	}
+
+	return b.loadImage(ctx, out, tarPath, tag)
+}
+
+func (b *Builder) runBuildctl(ctx context.Context, out io.Writer, args []string) error {
+	cmd := exec.CommandContext(ctx, "buildctl", args...)
+	cmd.Env = util.OSEnviron()
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("running buildctl: %w", err)
+	}
+	return nil
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, tarPath string, tag string) (string, error) {
+	imageTar, err := os.Open(tarPath)
+	if err != nil {
+		return "", fmt.Errorf("opening image tarball: %w", err)
+	}
+	defer imageTar.Close()
+
+	imageID, err := b.localDocker.Load(ctx, out, imageTar, tag)
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+
+	return imageID, nil
+}
+
+// buildctlArgs translates a `docker` artifact into the arguments of `buildctl build`,
+// without the output.
+func (b *Builder) buildctlArgs(workspace, dockerfile string, a *latest.DockerArtifact, buildArgs map[string]*string, platforms []string, tag string) ([]string, error) {
+	var args []string
+	if b.buildkit.Addr != "" {
+		args = append(args, "--addr", b.buildkit.Addr)
+	}
+	args = append(args, "build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context="+workspace,
+		"--local", "dockerfile="+filepath.Dir(dockerfile),
+		"--opt", "filename="+filepath.Base(dockerfile))
+
+	var keys []string
+	for k := range buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		// Like `docker build --build-arg KEY`, a build arg without a value is read from the environment.
+		v := buildArgs[k]
+		if v == nil {
+			value, found := os.LookupEnv(k)
+			if !found {
+				continue
+			}
+			v = &value
+		}
+		args = append(args, "--opt", fmt.Sprintf("build-arg:%s=%s", k, *v))
+	}
+
+	if a.Target != "" {
+		args = append(args, "--opt", "target="+a.Target)
+	}
+
+	if len(platforms) > 0 {
+		args = append(args, "--opt", "platform="+strings.Join(platforms, ","))
+	}
+
+	switch mode := strings.ToLower(a.NetworkMode); {
+	case mode == "" || mode == "bridge":
+	case mode == "host" || mode == "none":
+		args = append(args, "--opt", "force-network-mode="+mode)
+	default:
+		return nil, fmt.Errorf("network mode %q is not supported by BuildKit", a.NetworkMode)
+	}
+
+	if a.Squash {
+		return nil, errors.New("squash is not supported by BuildKit")
+	}
+
+	if a.NoCache {
+		args = append(args, "--no-cache")
+	}
+
+	if a.Secret != nil {
+		secret := "id=" + a.Secret.ID
+		if a.Secret.Source != "" {
+			secret += ",src=" + a.Secret.Source
+		}
+		args = append(args, "--secret", secret)
+	}
+
+	if a.SSH != "" {
+		args = append(args, "--ssh", a.SSH)
+	}
+
+	for _, from := range a.CacheFrom {
+		args = append(args, "--import-cache", "type=registry,ref="+from)
+	}
+
+	if b.buildkit.ExportCache {
+		ref, err := docker.ParseReference(tag)
+		if err != nil {
+			return nil, err
+		}
+		cacheRef := ref.BaseName + ":" + cacheTag
+		args = append(args,
+			"--import-cache", "type=registry,ref="+cacheRef,
+			"--export-cache", "type=registry,ref="+cacheRef+",mode=max")
+	}
+
+	return args, nil
+}
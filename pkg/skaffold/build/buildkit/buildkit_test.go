@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildctlArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		buildkit    *latest.BuildKitConfig
+		artifact    *latest.DockerArtifact
+		buildArgs   map[string]*string
+		platforms   []string
+		env         map[string]string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "defaults",
+			artifact:    &latest.DockerArtifact{},
+			expected:    []string{"build", "--frontend", "dockerfile.v0", "--local", "context=ws", "--local", "dockerfile=ws/docker", "--opt", "filename=Dockerfile"},
+		},
+		{
+			description: "daemon address",
+			buildkit:    &latest.BuildKitConfig{Addr: "tcp://buildkitd:1234"},
+			artifact:    &latest.DockerArtifact{},
+			expected:    []string{"--addr", "tcp://buildkitd:1234", "build", "--frontend", "dockerfile.v0", "--local", "context=ws", "--local", "dockerfile=ws/docker", "--opt", "filename=Dockerfile"},
+		},
+		{
+			description: "docker options",
+			artifact: &latest.DockerArtifact{
+				Target:      "prod",
+				NetworkMode: "Host",
+				NoCache:     true,
+				Secret:      &latest.DockerSecret{ID: "token", Source: "token.txt"},
+				SSH:         "default",
+				CacheFrom:   []string{"gcr.io/test/cache"},
+			},
+			buildArgs: map[string]*string{"B": util.StringPtr("2"), "A": util.StringPtr("1"), "FROM_ENV": nil, "UNSET": nil},
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			env:       map[string]string{"FROM_ENV": "3"},
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=ws", "--local", "dockerfile=ws/docker", "--opt", "filename=Dockerfile",
+				"--opt", "build-arg:A=1", "--opt", "build-arg:B=2", "--opt", "build-arg:FROM_ENV=3",
+				"--opt", "target=prod",
+				"--opt", "platform=linux/amd64,linux/arm64",
+				"--opt", "force-network-mode=host",
+				"--no-cache",
+				"--secret", "id=token,src=token.txt",
+				"--ssh", "default",
+				"--import-cache", "type=registry,ref=gcr.io/test/cache"},
+		},
+		{
+			description: "export cache",
+			buildkit:    &latest.BuildKitConfig{ExportCache: true},
+			artifact:    &latest.DockerArtifact{},
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=ws", "--local", "dockerfile=ws/docker", "--opt", "filename=Dockerfile",
+				"--import-cache", "type=registry,ref=gcr.io/test/image:buildcache",
+				"--export-cache", "type=registry,ref=gcr.io/test/image:buildcache,mode=max"},
+		},
+		{
+			description: "container network",
+			artifact:    &latest.DockerArtifact{NetworkMode: "container:other"},
+			shouldErr:   true,
+		},
+		{
+			description: "squash",
+			artifact:    &latest.DockerArtifact{Squash: true},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(test.env)
+			b := NewArtifactBuilder(nil, &mockConfig{}, test.buildkit, true, nil)
+
+			args, err := b.buildctlArgs("ws", filepath.Join("ws", "docker", "Dockerfile"), test.artifact, test.buildArgs, test.platforms, "gcr.io/test/image:v1")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, args)
+		})
+	}
+}
+
+func TestBuildPush(t *testing.T) {
+	tests := []struct {
+		description    string
+		platforms      []string
+		push           bool
+		command        string
+		err            error
+		expectedDigest string
+		shouldErr      bool
+	}{
+		{
+			description:    "push",
+			push:           true,
+			command:        "--output type=image,name=gcr.io/test/image:v1,push=true",
+			expectedDigest: "sha256:abc",
+		},
+		{
+			description:    "push multiple platforms",
+			platforms:      []string{"linux/amd64", "linux/arm64"},
+			push:           true,
+			command:        "--opt platform=linux/amd64,linux/arm64 --output type=image,name=gcr.io/test/image:v1,push=true",
+			expectedDigest: "sha256:abc",
+		},
+		{
+			description: "build error",
+			push:        true,
+			command:     "--output type=image,name=gcr.io/test/image:v1,push=true",
+			err:         errors.New("failed"),
+			shouldErr:   true,
+		},
+		{
+			description: "multiple platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dir, _ := filepath.Abs(".")
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) { return "sha256:abc", nil })
+			t.Override(&util.OSEnviron, func() []string { return nil })
+			if test.command != "" {
+				command := "buildctl build --frontend dockerfile.v0 --local context=. --local dockerfile=" + dir + " --opt filename=Dockerfile " + test.command
+				t.Override(&util.DefaultExecCommand, testutil.CmdRunErr(command, test.err))
+			}
+
+			b := NewArtifactBuilder(nil, &mockConfig{}, nil, test.push, nil)
+			artifact := &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				Workspace: ".",
+				Platforms: test.platforms,
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+			}
+
+			digest, err := b.Build(context.Background(), ioutil.Discard, artifact, "gcr.io/test/image:v1")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedDigest, digest)
+		})
+	}
+}
+
+type mockConfig struct {
+	docker.Config
+	mode config.RunMode
+}
+
+func (c *mockConfig) Mode() config.RunMode { return c.mode }
@@ -0,0 +1,45 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Builder is an artifact builder that builds `docker` artifacts with a standalone BuildKit daemon
+type Builder struct {
+	localDocker docker.LocalDaemon
+	cfg         docker.Config
+	buildkit    latest.BuildKitConfig
+	pushImages  bool
+	artifacts   docker.ArtifactResolver
+}
+
+// NewArtifactBuilder returns a new buildkit artifact builder
+func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, buildkit *latest.BuildKitConfig, pushImages bool, r docker.ArtifactResolver) *Builder {
+	b := &Builder{
+		localDocker: localDocker,
+		cfg:         cfg,
+		pushImages:  pushImages,
+		artifacts:   r,
+	}
+	if buildkit != nil {
+		b.buildkit = *buildkit
+	}
+	return b
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -53,6 +54,10 @@ type cache struct {
 	client           docker.LocalDaemon
 	cfg              Config
 	cacheFile        string
+	artifactInputs   ArtifactInputs
+	pendingInputs    ArtifactInputs
+	explain          bool
+	index            Index
 	imagesAreLocal   bool
 	tryImportMissing bool
 	lister           DependencyLister
@@ -64,8 +69,11 @@ type DependencyLister func(ctx context.Context, artifact *latest.Artifact) ([]st
 type Config interface {
 	docker.Config
 
+	Pipeline() latest.Pipeline
 	CacheArtifacts() bool
 	CacheFile() string
+	ExplainCache() bool
+	RemoteCache() string
 	Mode() config.RunMode
 }
 
@@ -87,6 +95,19 @@ func NewCache(cfg Config, imagesAreLocal bool, tryImportMissing bool, dependenci
 		return &noCache{}, nil
 	}
 
+	artifactInputs, err := retrieveArtifactInputs(inputsFile(cacheFile))
+	if err != nil {
+		logrus.Warnf("Error retrieving artifact inputs, cache misses can't be explained: %v", err)
+		artifactInputs = ArtifactInputs{}
+	}
+
+	var index Index
+	if location := cfg.RemoteCache(); location != "" {
+		if index, err = NewIndex(location, cfg); err != nil {
+			logrus.Warnf("Error opening remote cache, only using local cache: %v", err)
+		}
+	}
+
 	client, err := docker.NewAPIClient(cfg)
 	if imagesAreLocal && err != nil {
 		return nil, fmt.Errorf("getting local Docker client: %w", err)
@@ -99,12 +120,21 @@ func NewCache(cfg Config, imagesAreLocal bool, tryImportMissing bool, dependenci
 		client:           client,
 		cfg:              cfg,
 		cacheFile:        cacheFile,
+		artifactInputs:   artifactInputs,
+		explain:          cfg.ExplainCache(),
+		index:            index,
 		imagesAreLocal:   imagesAreLocal,
 		tryImportMissing: tryImportMissing,
 		lister:           dependencies,
 	}, nil
 }
 
+// daemonlessTool returns `podman` or `buildah` if the artifact is built without a Docker daemon.
+// Local images of those artifacts are looked up and tagged with that tool.
+func (c *cache) daemonlessTool(a *latest.Artifact) string {
+	return podman.Tool(c.cfg.Pipeline().Build, a)
+}
+
 // resolveCacheFile makes sure that either a passed in cache file or the default cache file exists
 func resolveCacheFile(cacheFile string) (string, error) {
 	if cacheFile != "" {
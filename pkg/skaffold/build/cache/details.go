@@ -19,7 +19,9 @@ package cache
 import (
 	"context"
 	"io"
+	"io/ioutil"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
 
@@ -39,6 +41,8 @@ func (d failed) Hash() string {
 // Not found, needs building
 type needsBuilding struct {
 	hash string
+	// reasons explains why the cache missed, when requested.
+	reasons []string
 }
 
 func (d needsBuilding) Hash() string {
@@ -63,6 +67,8 @@ type needsLocalTagging struct {
 	hash    string
 	tag     string
 	imageID string
+	// tool is set when the image is in the podman or buildah storage.
+	tool string
 }
 
 func (d needsLocalTagging) Hash() string {
@@ -70,6 +76,9 @@ func (d needsLocalTagging) Hash() string {
 }
 
 func (d needsLocalTagging) Tag(ctx context.Context, c *cache) error {
+	if d.tool != "" {
+		return podman.Tag(ctx, ioutil.Discard, d.tool, d.imageID, d.tag)
+	}
 	return c.client.Tag(ctx, d.imageID, d.tag)
 }
 
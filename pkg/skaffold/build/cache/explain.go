@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// hashInputs is the breakdown of the inputs of an artifact's hash.
+type hashInputs struct {
+	// Config is the artifact's configuration.
+	Config string `yaml:"config"`
+
+	// Files lists the artifact's dependencies, sorted by path, with the hash of each file.
+	Files []fileInput `yaml:"files,omitempty"`
+
+	// BuildArgs lists the artifact's build args, as `key=value`.
+	BuildArgs []string `yaml:"buildArgs,omitempty"`
+
+	// Platforms lists the platforms the artifact is built for.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Required maps each required artifact to its hash.
+	Required map[string]string `yaml:"required,omitempty"`
+}
+
+type fileInput struct {
+	Path string `yaml:"path"`
+	Hash string `yaml:"hash"`
+}
+
+// list returns the inputs hashed for a single artifact, ignoring its required artifacts.
+func (i *hashInputs) list() []string {
+	inputs := []string{i.Config}
+	for _, f := range i.Files {
+		inputs = append(inputs, f.Hash)
+	}
+	inputs = append(inputs, i.BuildArgs...)
+	if len(i.Platforms) > 0 {
+		inputs = append(inputs, "platforms="+strings.Join(i.Platforms, ","))
+	}
+	return inputs
+}
+
+// ArtifactInputs is a map of [image name : inputs of the image's last build]
+type ArtifactInputs map[string]*hashInputs
+
+// inputsFile returns the file where the inputs of the artifacts are stored, next to the cache file.
+func inputsFile(cacheFile string) string {
+	return cacheFile + "-inputs"
+}
+
+func retrieveArtifactInputs(file string) (ArtifactInputs, error) {
+	inputs := ArtifactInputs{}
+	contents, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return inputs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(contents, &inputs); err != nil {
+		return nil, err
+	}
+	return inputs, nil
+}
+
+func saveArtifactInputs(file string, contents ArtifactInputs) error {
+	data, err := yaml.Marshal(contents)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, data, 0644)
+}
+
+// explainMiss lists the inputs that changed since the artifact was last built.
+func explainMiss(previous, current *hashInputs) []string {
+	if previous == nil {
+		return []string{"no previous build recorded"}
+	}
+
+	var reasons []string
+	if previous.Config != current.Config {
+		reasons = append(reasons, "artifact configuration changed")
+	}
+	reasons = append(reasons, diff("file", filesByPath(previous.Files), filesByPath(current.Files))...)
+	reasons = append(reasons, diff("build arg", argsByKey(previous.BuildArgs), argsByKey(current.BuildArgs))...)
+	if strings.Join(previous.Platforms, ",") != strings.Join(current.Platforms, ",") {
+		reasons = append(reasons, "target platforms changed")
+	}
+	reasons = append(reasons, diff("required artifact", previous.Required, current.Required)...)
+
+	if len(reasons) == 0 {
+		return []string{"inputs unchanged, but the image built from them is no longer available"}
+	}
+	return reasons
+}
+
+// diff lists the keys that were added, removed or changed between two maps.
+func diff(kind string, previous, current map[string]string) []string {
+	var reasons []string
+	for _, k := range sortedKeys(current) {
+		prev, found := previous[k]
+		switch {
+		case !found:
+			reasons = append(reasons, fmt.Sprintf("%s added: %s", kind, k))
+		case prev != current[k]:
+			reasons = append(reasons, fmt.Sprintf("%s changed: %s", kind, k))
+		}
+	}
+	for _, k := range sortedKeys(previous) {
+		if _, found := current[k]; !found {
+			reasons = append(reasons, fmt.Sprintf("%s removed: %s", kind, k))
+		}
+	}
+	return reasons
+}
+
+func filesByPath(files []fileInput) map[string]string {
+	m := map[string]string{}
+	for _, f := range files {
+		m[f.Path] = f.Hash
+	}
+	return m
+}
+
+func argsByKey(args []string) map[string]string {
+	m := map[string]string{}
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) == 2 {
+			m[kv[0]] = kv[1]
+		} else {
+			m[kv[0]] = ""
+		}
+	}
+	return m
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExplainMiss(t *testing.T) {
+	previous := &hashInputs{
+		Config:    `{"docker":{}}`,
+		Files:     []fileInput{{Path: "Dockerfile", Hash: "1"}, {Path: "main.go", Hash: "2"}, {Path: "old.go", Hash: "3"}},
+		BuildArgs: []string{"VERSION=1", "DEBUG"},
+		Required:  map[string]string{"base": "basehash"},
+	}
+
+	tests := []struct {
+		description string
+		previous    *hashInputs
+		current     *hashInputs
+		expected    []string
+	}{
+		{
+			description: "first build",
+			current:     previous,
+			expected:    []string{"no previous build recorded"},
+		},
+		{
+			description: "unchanged",
+			previous:    previous,
+			current:     previous,
+			expected:    []string{"inputs unchanged, but the image built from them is no longer available"},
+		},
+		{
+			description: "changed inputs",
+			previous:    previous,
+			current: &hashInputs{
+				Config:    `{"docker":{"target":"dev"}}`,
+				Files:     []fileInput{{Path: "Dockerfile", Hash: "1"}, {Path: "generated.go", Hash: "4"}, {Path: "main.go", Hash: "5"}},
+				BuildArgs: []string{"VERSION=2", "DEBUG"},
+				Platforms: []string{"linux/arm64"},
+				Required:  map[string]string{"base": "otherhash"},
+			},
+			expected: []string{
+				"artifact configuration changed",
+				"file added: generated.go",
+				"file changed: main.go",
+				"file removed: old.go",
+				"build arg changed: VERSION",
+				"target platforms changed",
+				"required artifact changed: base",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, explainMiss(test.previous, test.current))
+		})
+	}
+}
+
+func TestTrackInputs(t *testing.T) {
+	tests := []struct {
+		description string
+		explain     bool
+		details     cacheDetails
+		expected    cacheDetails
+	}{
+		{
+			description: "explain cache miss",
+			explain:     true,
+			details:     needsBuilding{hash: "hash"},
+			expected:    needsBuilding{hash: "hash", reasons: []string{"artifact configuration changed"}},
+		},
+		{
+			description: "don't explain by default",
+			details:     needsBuilding{hash: "hash"},
+			expected:    needsBuilding{hash: "hash"},
+		},
+		{
+			description: "hit",
+			explain:     true,
+			details:     found{hash: "hash"},
+			expected:    found{hash: "hash"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cacheFile := t.TempFile("cache", nil)
+			c := &cache{
+				cacheFile:      cacheFile,
+				artifactInputs: ArtifactInputs{"artifact": {Config: "old"}},
+				explain:        test.explain,
+			}
+
+			details := c.trackInputs(context.Background(), &latest.Artifact{ImageName: "artifact"}, mockHasher{"new"}, test.details)
+			t.CheckDeepEqual(test.expected, details, cmp.AllowUnexported(needsBuilding{}, found{}))
+
+			// Inputs are recorded once the artifacts are built.
+			c.saveInputs()
+			saved, err := retrieveArtifactInputs(inputsFile(cacheFile))
+			t.CheckNoError(err)
+			t.CheckDeepEqual(ArtifactInputs{"artifact": {Config: "new"}}, saved)
+		})
+	}
+}
@@ -31,6 +31,8 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -46,6 +48,7 @@ var (
 
 type artifactHasher interface {
 	hash(ctx context.Context, a *latest.Artifact) (string, error)
+	inputs(ctx context.Context, a *latest.Artifact) (*hashInputs, error)
 }
 
 type artifactHasherImpl struct {
@@ -65,8 +68,20 @@ func newArtifactHasher(artifacts build.ArtifactGraph, lister DependencyLister, m
 	}
 }
 
+// NewInputDigester returns a function that computes the same digest of an artifact's inputs as the cache,
+// including the digests of its required artifacts. Each call lists and hashes the dependencies again.
+func NewInputDigester(artifacts build.ArtifactGraph, lister DependencyLister, mode config.RunMode) tag.InputDigester {
+	return func(ctx context.Context, a *latest.Artifact) (string, error) {
+		return newArtifactHasherFunc(artifacts, lister, mode).hash(ctx, a)
+	}
+}
+
 func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact) (string, error) {
-	hash, err := h.safeHash(ctx, a)
+	inputs, err := h.safeInputs(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	hash, err := encode(inputs.list())
 	if err != nil {
 		return "", err
 	}
@@ -85,40 +100,61 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact) (stri
 	return encode(hashes)
 }
 
-func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latest.Artifact) (string, error) {
+// inputs returns the breakdown of the inputs of an artifact's hash, including the hashes of its required artifacts.
+func (h *artifactHasherImpl) inputs(ctx context.Context, a *latest.Artifact) (*hashInputs, error) {
+	inputs, err := h.safeInputs(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	withRequired := *inputs
+	for _, dep := range sortedDependencies(a, h.artifacts) {
+		depHash, err := h.hash(ctx, dep)
+		if err != nil {
+			return nil, err
+		}
+		if withRequired.Required == nil {
+			withRequired.Required = map[string]string{}
+		}
+		withRequired.Required[dep.ImageName] = depHash
+	}
+	return &withRequired, nil
+}
+
+func (h *artifactHasherImpl) safeInputs(ctx context.Context, a *latest.Artifact) (*hashInputs, error) {
 	val := h.syncStore.Exec(a.ImageName,
 		func() interface{} {
-			hash, err := singleArtifactHash(ctx, h.lister, a, h.mode)
+			inputs, err := singleArtifactInputs(ctx, h.lister, a, h.mode)
 			if err != nil {
 				return err
 			}
-			return hash
+			return inputs
 		})
 	switch t := val.(type) {
 	case error:
-		return "", t
-	case string:
+		return nil, t
+	case *hashInputs:
 		return t, nil
 	default:
-		return "", fmt.Errorf("internal error when retrieving cache result of type %T", t)
+		return nil, fmt.Errorf("internal error when retrieving cache result of type %T", t)
 	}
 }
 
-// singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
-func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest.Artifact, mode config.RunMode) (string, error) {
-	var inputs []string
+// singleArtifactInputs lists the inputs of the hash of a single artifact, and ignores its required artifacts.
+func singleArtifactInputs(ctx context.Context, depLister DependencyLister, a *latest.Artifact, mode config.RunMode) (*hashInputs, error) {
+	inputs := &hashInputs{}
 
 	// Append the artifact's configuration
 	config, err := artifactConfigFunc(a)
 	if err != nil {
-		return "", fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
+		return nil, fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
 	}
-	inputs = append(inputs, config)
+	inputs.Config = config
 
 	// Append the digest of each input file
 	deps, err := depLister(ctx, a)
 	if err != nil {
-		return "", fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+		return nil, fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
 	}
 	sort.Strings(deps)
 
@@ -130,20 +166,19 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 				continue // Ignore files that don't exist
 			}
 
-			return "", fmt.Errorf("getting hash for %q: %w", d, err)
+			return nil, fmt.Errorf("getting hash for %q: %w", d, err)
 		}
-		inputs = append(inputs, h)
+		inputs.Files = append(inputs.Files, fileInput{Path: d, Hash: h})
 	}
 
 	// add build args for the artifact if specified
 	args, err := hashBuildArgs(a, mode)
 	if err != nil {
-		return "", fmt.Errorf("hashing build args: %w", err)
-	}
-	if args != nil {
-		inputs = append(inputs, args...)
+		return nil, fmt.Errorf("hashing build args: %w", err)
 	}
-	return encode(inputs)
+	inputs.BuildArgs = args
+	inputs.Platforms = a.Platforms
+	return inputs, nil
 }
 
 func encode(inputs []string) (string, error) {
@@ -177,6 +212,9 @@ func hashBuildArgs(artifact *latest.Artifact, mode config.RunMode) ([]string, er
 		args, err = docker.EvalBuildArgs(mode, artifact.Workspace, artifact.KanikoArtifact.DockerfilePath, artifact.KanikoArtifact.BuildArgs, nil)
 	case artifact.BuildpackArtifact != nil:
 		env, err = buildpacks.GetEnv(artifact, mode)
+	case artifact.KoArtifact != nil:
+		// debug builds disable optimizations
+		return ko.BuildArgs(artifact.KoArtifact, mode), nil
 	case artifact.CustomArtifact != nil && artifact.CustomArtifact.Dependencies.Dockerfile != nil:
 		args, err = util.EvaluateEnvTemplateMap(artifact.CustomArtifact.Dependencies.Dockerfile.BuildArgs)
 	default:
@@ -124,6 +124,13 @@ func TestGetHashForArtifact(t *testing.T) {
 			mode:     config.RunModes.Dev,
 			expected: "f3f710a4ec1d1bfb2a9b8ef2b4b7cc5f254102d17095a71872821b396953a4ce",
 		},
+		{
+			description:  "platforms",
+			dependencies: []string{"a", "b"},
+			artifact:     &latest.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			mode:         config.RunModes.Dev,
+			expected:     "f63559844a53f3f62dd9780ef8777c65d6afd292f0987b92d1e131b2443f68c9",
+		},
 		{
 			description:  "buildpack in dev mode",
 			dependencies: []string{"a", "b"},
@@ -251,6 +258,59 @@ func TestGetHashForArtifactWithDependencies(t *testing.T) {
 	}
 }
 
+func TestInputDigester(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&fileHasherFunc, mockCacheHasher)
+		t.Override(&artifactConfigFunc, fakeArtifactConfig)
+		artifacts := []*latest.Artifact{
+			{ImageName: "img1", Dependencies: []*latest.ArtifactDependency{{ImageName: "img2"}}},
+			{ImageName: "img2"},
+		}
+		fileDeps := map[string][]string{"img1": {"a"}, "img2": {"b"}}
+		depLister := func(_ context.Context, a *latest.Artifact) ([]string, error) {
+			return fileDeps[a.ImageName], nil
+		}
+		g := build.ToArtifactGraph(artifacts)
+		digester := NewInputDigester(g, depLister, config.RunModes.Dev)
+
+		expected, err := newArtifactHasher(g, depLister, config.RunModes.Dev).hash(context.Background(), artifacts[0])
+		t.CheckNoError(err)
+		digest, err := digester(context.Background(), artifacts[0])
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected, digest)
+
+		// Files are listed and hashed again on every call.
+		fileDeps["img2"] = []string{"c"}
+		changed, err := digester(context.Background(), artifacts[0])
+		t.CheckNoError(err)
+		t.CheckFalse(changed == digest)
+	})
+}
+
+func TestHashInputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&fileHasherFunc, mockCacheHasher)
+		t.Override(&artifactConfigFunc, fakeArtifactConfig)
+
+		artifacts := []*latest.Artifact{
+			{ImageName: "img1", Dependencies: []*latest.ArtifactDependency{{ImageName: "img2"}}},
+			{ImageName: "img2"},
+		}
+		depLister := func(_ context.Context, a *latest.Artifact) ([]string, error) {
+			return map[string][]string{"img1": {"b", "a"}, "img2": {"c"}}[a.ImageName], nil
+		}
+		h := newArtifactHasher(build.ToArtifactGraph(artifacts), depLister, config.RunModes.Dev)
+
+		inputs, err := h.inputs(context.Background(), artifacts[0])
+		t.CheckNoError(err)
+		img2Hash, err := h.hash(context.Background(), artifacts[1])
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual([]fileInput{{Path: "a", Hash: "a"}, {Path: "b", Hash: "b"}}, inputs.Files)
+		t.CheckDeepEqual(map[string]string{"img2": img2Hash}, inputs.Required)
+	})
+}
+
 func TestArtifactConfig(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		config1, err := artifactConfig(&latest.Artifact{
@@ -524,6 +584,22 @@ func TestHashBuildArgs(t *testing.T) {
 			},
 			mode:     config.RunModes.Debug,
 			expected: []string{"GOOGLE_GOGCFLAGS=all=-N -l"},
+		}, {
+			description: "ko artifact for dev",
+			artifactType: latest.ArtifactType{
+				KoArtifact: &latest.KoArtifact{
+					Ldflags: []string{"-s"},
+				},
+			},
+			mode:     config.RunModes.Dev,
+			expected: []string{"-trimpath", "-ldflags", "-s"},
+		}, {
+			description: "ko artifact for debug",
+			artifactType: latest.ArtifactType{
+				KoArtifact: &latest.KoArtifact{},
+			},
+			mode:     config.RunModes.Debug,
+			expected: []string{"-gcflags", "all=-N -l"},
 		}, {
 			description: "custom artifact, dockerfile dependency, with build args",
 			artifactType: latest.ArtifactType{
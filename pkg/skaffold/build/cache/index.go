@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// Index is a cache index shared between machines, like developer laptops and CI runners.
+// It maps the hashes of artifacts to the details of the images built from them.
+// Only image digests are shared, since image IDs are only meaningful to a local Docker daemon.
+type Index interface {
+	// Get returns the details of the image built for a given hash, if any.
+	Get(ctx context.Context, hash string) (ImageDetails, bool, error)
+
+	// Put records the details of the image built for a given hash.
+	Put(ctx context.Context, hash string, details ImageDetails) error
+}
+
+// NewIndex returns the Index stored at a given location:
+//   - `oci://<repository>` stores the index in a container registry, as one tag per hash.
+//   - `http://<url>` or `https://<url>` stores the index in an HTTP key/value store.
+//   - `file://<path>` or a plain path stores the index in a local, possibly shared, directory.
+func NewIndex(location string, cfg docker.Config) (Index, error) {
+	switch {
+	case strings.HasPrefix(location, "oci://"):
+		return &registryIndex{repo: strings.TrimPrefix(location, "oci://"), cfg: cfg}, nil
+
+	case strings.HasPrefix(location, "http://"), strings.HasPrefix(location, "https://"):
+		return newHTTPIndex(location), nil
+
+	default:
+		dir := strings.TrimPrefix(location, "file://")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("creating cache index directory %q: %w", dir, err)
+		}
+		return &dirIndex{dir: dir}, nil
+	}
+}
+
+// dirIndex stores each entry in its own file, named after the hash.
+type dirIndex struct {
+	dir string
+}
+
+func (i *dirIndex) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	var details ImageDetails
+
+	contents, err := ioutil.ReadFile(filepath.Join(i.dir, hash))
+	if os.IsNotExist(err) {
+		return details, false, nil
+	}
+	if err != nil {
+		return details, false, err
+	}
+
+	if err := yaml.Unmarshal(contents, &details); err != nil {
+		return details, false, fmt.Errorf("reading cache entry %q: %w", hash, err)
+	}
+	return details, details.Digest != "", nil
+}
+
+func (i *dirIndex) Put(_ context.Context, hash string, details ImageDetails) error {
+	data, err := yaml.Marshal(ImageDetails{Digest: details.Digest})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see partial entries.
+	tmp, err := ioutil.TempFile(i.dir, hash+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(i.dir, hash))
+}
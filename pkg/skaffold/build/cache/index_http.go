@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// httpIndex stores entries in an HTTP key/value store:
+// entries are read with `GET <url>/<hash>` and written with `PUT <url>/<hash>`.
+type httpIndex struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPIndex(url string) *httpIndex {
+	return &httpIndex{
+		url:    strings.TrimSuffix(url, "/"),
+		client: http.DefaultClient,
+	}
+}
+
+func (i *httpIndex) Get(ctx context.Context, hash string) (ImageDetails, bool, error) {
+	var details ImageDetails
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, i.url+"/"+hash, nil)
+	if err != nil {
+		return details, false, err
+	}
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return details, false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return details, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return details, false, fmt.Errorf("getting cache entry %q: %s", hash, resp.Status)
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return details, false, err
+	}
+	if err := yaml.Unmarshal(contents, &details); err != nil {
+		return details, false, fmt.Errorf("reading cache entry %q: %w", hash, err)
+	}
+	return details, details.Digest != "", nil
+}
+
+func (i *httpIndex) Put(ctx context.Context, hash string, details ImageDetails) error {
+	data, err := yaml.Marshal(ImageDetails{Digest: details.Digest})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, i.url+"/"+hash, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-yaml")
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("putting cache entry %q: %s", hash, resp.Status)
+	}
+	return nil
+}
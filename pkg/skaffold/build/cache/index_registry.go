@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// digestLabel is the label holding the digest of the cached image.
+const digestLabel = "dev.skaffold.cache.digest"
+
+// registryIndex stores each entry as an empty image, tagged with the hash in a given repository.
+// The digest of the cached image is stored as a label of that image.
+type registryIndex struct {
+	repo string
+	cfg  docker.Config
+}
+
+func (i *registryIndex) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	config, err := docker.RetrieveRemoteConfig(i.repo+":"+hash, i.cfg)
+	if err != nil {
+		var tErr *transport.Error
+		if errors.As(err, &tErr) && tErr.StatusCode == http.StatusNotFound {
+			return ImageDetails{}, false, nil
+		}
+		return ImageDetails{}, false, err
+	}
+
+	digest := config.Config.Labels[digestLabel]
+	return ImageDetails{Digest: digest}, digest != "", nil
+}
+
+func (i *registryIndex) Put(_ context.Context, hash string, details ImageDetails) error {
+	img, err := mutate.ConfigFile(empty.Image, &v1.ConfigFile{
+		Config: v1.Config{
+			Labels: map[string]string{digestLabel: details.Digest},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return docker.WriteRemoteImage(i.repo+":"+hash, img, i.cfg)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeIndex struct {
+	entries map[string]ImageDetails
+}
+
+func (f *fakeIndex) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	details, found := f.entries[hash]
+	return details, found, nil
+}
+
+func (f *fakeIndex) Put(_ context.Context, hash string, details ImageDetails) error {
+	f.entries[hash] = details
+	return nil
+}
+
+// kvServer is a minimal HTTP key/value store.
+func kvServer() *httptest.Server {
+	var lock sync.Mutex
+	values := map[string][]byte{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			value, found := values[r.URL.Path]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(value)
+		case http.MethodPut:
+			values[r.URL.Path], _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func TestIndexes(t *testing.T) {
+	kv := kvServer()
+	defer kv.Close()
+
+	reg := httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+	defer reg.Close()
+
+	tests := []struct {
+		description string
+		location    func(t *testutil.T) string
+		expected    interface{}
+	}{
+		{
+			description: "directory",
+			location:    func(t *testutil.T) string { return t.NewTempDir().Path("index") },
+			expected:    &dirIndex{},
+		},
+		{
+			description: "file url",
+			location:    func(t *testutil.T) string { return "file://" + t.NewTempDir().Root() },
+			expected:    &dirIndex{},
+		},
+		{
+			description: "http key/value store",
+			location:    func(*testutil.T) string { return kv.URL + "/skaffold/" },
+			expected:    &httpIndex{},
+		},
+		{
+			description: "container registry",
+			location:    func(*testutil.T) string { return "oci://" + strings.TrimPrefix(reg.URL, "http://") + "/skaffold/cache" },
+			expected:    &registryIndex{},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ctx := context.Background()
+
+			index, err := NewIndex(test.location(t), &mockConfig{})
+			t.CheckNoError(err)
+			t.CheckTypeEquality(test.expected, index)
+
+			_, found, err := index.Get(ctx, "hash")
+			t.CheckNoError(err)
+			t.CheckFalse(found)
+
+			// Image IDs are not shared.
+			err = index.Put(ctx, "hash", ImageDetails{Digest: "sha256:abacab", ID: "imageID"})
+			t.CheckNoError(err)
+
+			details, found, err := index.Get(ctx, "hash")
+			t.CheckNoError(err)
+			t.CheckTrue(found)
+			t.CheckDeepEqual(ImageDetails{Digest: "sha256:abacab"}, details)
+
+			_, found, err = index.Get(ctx, "other")
+			t.CheckNoError(err)
+			t.CheckFalse(found)
+		})
+	}
+}
+
+func TestAddArtifactsSharesDigests(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		index := &fakeIndex{entries: map[string]ImageDetails{}}
+		c := &cache{
+			artifactCache: ArtifactCache{},
+			index:         index,
+		}
+
+		err := c.addArtifacts(context.Background(), []build.Artifact{
+			{ImageName: "artifact", Tag: "gcr.io/project/artifact:tag@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		}, map[string]string{"artifact": "hash"}, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(map[string]ImageDetails{"hash": {Digest: "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}}, index.entries)
+	})
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -41,6 +42,7 @@ func (c *cache) lookupArtifacts(ctx context.Context, tags tag.ImageTags, artifac
 		i := i
 		go func() {
 			details[i] = c.lookup(ctx, artifacts[i], tags[artifacts[i].ImageName], h)
+			details[i] = c.trackInputs(ctx, artifacts[i], h, details[i])
 			wg.Done()
 		}()
 	}
@@ -59,6 +61,12 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, h ar
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
 	if !cacheHit {
+		entry, cacheHit = c.lookupIndex(ctx, hash)
+	}
+	if !cacheHit {
+		if c.daemonlessTool(a) != "" {
+			return needsBuilding{hash: hash}
+		}
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
 			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
 			return needsBuilding{hash: hash}
@@ -66,11 +74,65 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, h ar
 	}
 
 	if c.imagesAreLocal {
+		if tool := c.daemonlessTool(a); tool != "" {
+			return c.lookupDaemonless(ctx, tool, hash, tag, entry)
+		}
 		return c.lookupLocal(ctx, hash, tag, entry)
 	}
 	return c.lookupRemote(ctx, hash, tag, entry)
 }
 
+// trackInputs records the inputs of an artifact's hash. When the artifact needs to be built and
+// explanations were requested, it lists the inputs that changed since the artifact was last built.
+func (c *cache) trackInputs(ctx context.Context, a *latest.Artifact, h artifactHasher, details cacheDetails) cacheDetails {
+	if _, isFailed := details.(failed); isFailed {
+		return details
+	}
+
+	inputs, err := h.inputs(ctx, a)
+	if err != nil {
+		logrus.Debugf("Could not list the inputs of %s: %v", a.ImageName, err)
+		return details
+	}
+
+	c.cacheMutex.Lock()
+	previous := c.artifactInputs[a.ImageName]
+	if c.pendingInputs == nil {
+		c.pendingInputs = ArtifactInputs{}
+	}
+	c.pendingInputs[a.ImageName] = inputs
+	c.cacheMutex.Unlock()
+
+	if result, ok := details.(needsBuilding); ok && c.explain {
+		result.reasons = explainMiss(previous, inputs)
+		return result
+	}
+	return details
+}
+
+// lookupIndex looks for a hash in the remote cache index, shared with other machines.
+// Only digests are shared, so the remote index is only useful when images are pushed.
+func (c *cache) lookupIndex(ctx context.Context, hash string) (ImageDetails, bool) {
+	if c.index == nil || c.imagesAreLocal {
+		return ImageDetails{}, false
+	}
+
+	entry, found, err := c.index.Get(ctx, hash)
+	if err != nil {
+		logrus.Warnf("Error reading remote cache, ignoring it: %v", err)
+		return ImageDetails{}, false
+	}
+	if !found {
+		return ImageDetails{}, false
+	}
+
+	logrus.Debugf("Found %s in remote cache", hash)
+	c.cacheMutex.Lock()
+	c.artifactCache[hash] = entry
+	c.cacheMutex.Unlock()
+	return entry, true
+}
+
 func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
 	if entry.ID == "" {
 		return needsBuilding{hash: hash}
@@ -96,6 +158,25 @@ func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDe
 	return needsBuilding{hash: hash}
 }
 
+// lookupDaemonless looks for an image built with podman or buildah in the tool's storage.
+func (c *cache) lookupDaemonless(ctx context.Context, tool, hash, tag string, entry ImageDetails) cacheDetails {
+	if entry.ID == "" {
+		return needsBuilding{hash: hash}
+	}
+
+	// Image exists locally with the same tag
+	if podman.ImageID(ctx, tool, tag) == entry.ID {
+		return found{hash: hash}
+	}
+
+	// Image exists locally with a different tag
+	if podman.ImageID(ctx, tool, entry.ID) == entry.ID {
+		return needsLocalTagging{hash: hash, tag: tag, imageID: entry.ID, tool: tool}
+	}
+
+	return needsBuilding{hash: hash}
+}
+
 func (c *cache) lookupRemote(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
 	if remoteDigest, err := docker.RemoteDigest(tag, c.cfg); err == nil {
 		// Image exists remotely with the same tag and digest
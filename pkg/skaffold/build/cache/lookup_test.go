@@ -142,6 +142,7 @@ func TestLookupRemote(t *testing.T) {
 		description string
 		hasher      artifactHasher
 		cache       map[string]ImageDetails
+		index       Index
 		api         *testutil.FakeAPIClient
 		expected    cacheDetails
 	}{
@@ -165,6 +166,21 @@ func TestLookupRemote(t *testing.T) {
 			},
 			expected: found{hash: "hash"},
 		},
+		{
+			description: "hit in remote index",
+			hasher:      mockHasher{"hash"},
+			cache:       map[string]ImageDetails{},
+			index:       &fakeIndex{entries: map[string]ImageDetails{"hash": {Digest: "digest"}}},
+			expected:    found{hash: "hash"},
+		},
+		{
+			description: "miss in remote index",
+			hasher:      mockHasher{"hash"},
+			api:         &testutil.FakeAPIClient{ErrImagePull: true},
+			cache:       map[string]ImageDetails{},
+			index:       &fakeIndex{entries: map[string]ImageDetails{"other": {Digest: "digest"}}},
+			expected:    needsBuilding{hash: "hash"},
+		},
 		{
 			description: "hit with different tag",
 			hasher:      mockHasher{"hash"},
@@ -208,6 +224,7 @@ func TestLookupRemote(t *testing.T) {
 			cache := &cache{
 				imagesAreLocal: false,
 				artifactCache:  test.cache,
+				index:          test.index,
 				client:         fakeLocalDaemon(test.api),
 				cfg:            &mockConfig{mode: config.RunModes.Build},
 			}
@@ -232,6 +249,10 @@ func (m mockHasher) hash(context.Context, *latest.Artifact) (string, error) {
 	return m.val, nil
 }
 
+func (m mockHasher) inputs(context.Context, *latest.Artifact) (*hashInputs, error) {
+	return &hashInputs{Config: m.val}, nil
+}
+
 type failingHasher struct {
 	err error
 }
@@ -240,6 +261,10 @@ func (f failingHasher) hash(context.Context, *latest.Artifact) (string, error) {
 	return "", f.err
 }
 
+func (f failingHasher) inputs(context.Context, *latest.Artifact) (*hashInputs, error) {
+	return nil, f.err
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -20,15 +20,18 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
@@ -64,6 +67,12 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 
 		case needsBuilding:
 			color.Yellow.Fprintln(out, "Not found. Building")
+			for _, reason := range result.reasons {
+				color.Default.Fprintf(out, "   - %s\n", reason)
+			}
+			if c.explain {
+				event.BuildCacheMiss(artifact.ImageName, result.reasons)
+			}
 			hashByName[artifact.ImageName] = result.Hash()
 			needToBuild = append(needToBuild, artifact)
 			continue
@@ -95,7 +104,13 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		tag := tags[artifact.ImageName]
 
 		var uniqueTag string
-		if c.imagesAreLocal {
+		if tool := c.daemonlessTool(artifact); c.imagesAreLocal && tool != "" {
+			var err error
+			uniqueTag, err = podman.TagWithImageID(ctx, ioutil.Discard, tool, tag, entry.ID)
+			if err != nil {
+				return nil, err
+			}
+		} else if c.imagesAreLocal {
 			var err error
 			uniqueTag, err = build.TagWithImageID(ctx, tag, entry.ID, c.client)
 			if err != nil {
@@ -118,11 +133,13 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		return nil, err
 	}
 
-	if err := c.addArtifacts(ctx, bRes, hashByName); err != nil {
+	if err := c.addArtifacts(ctx, bRes, hashByName, needToBuild); err != nil {
 		logrus.Warnf("error adding artifacts to cache; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
 	}
 
+	c.saveInputs()
+
 	if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
 		logrus.Warnf("error saving cache file; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
@@ -131,6 +148,30 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 	return maintainArtifactOrder(append(bRes, alreadyBuilt...), artifacts), err
 }
 
+// saveInputs records the inputs of the artifacts that were just built or found in the cache.
+func (c *cache) saveInputs() {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if len(c.pendingInputs) == 0 {
+		return
+	}
+	if c.artifactInputs == nil {
+		c.artifactInputs = ArtifactInputs{}
+	}
+	for image, inputs := range c.pendingInputs {
+		c.artifactInputs[image] = inputs
+	}
+	c.pendingInputs = nil
+
+	if c.cacheFile == "" {
+		return
+	}
+	if err := saveArtifactInputs(inputsFile(c.cacheFile), c.artifactInputs); err != nil {
+		logrus.Warnf("error saving artifact inputs; cache misses may not be explained: %v", err)
+	}
+}
+
 func maintainArtifactOrder(built []build.Artifact, artifacts []*latest.Artifact) []build.Artifact {
 	byName := make(map[string]build.Artifact)
 	for _, build := range built {
@@ -146,10 +187,19 @@ func maintainArtifactOrder(built []build.Artifact, artifacts []*latest.Artifact)
 	return ordered
 }
 
-func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByName map[string]string) error {
+func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByName map[string]string, artifacts []*latest.Artifact) error {
+	toolByName := make(map[string]string)
+	for _, a := range artifacts {
+		toolByName[a.ImageName] = c.daemonlessTool(a)
+	}
+
 	for _, a := range bRes {
 		entry := ImageDetails{}
-		if c.imagesAreLocal {
+		if tool := toolByName[a.ImageName]; c.imagesAreLocal && tool != "" {
+			if imageID := podman.ImageID(ctx, tool, a.Tag); imageID != "" {
+				entry.ID = imageID
+			}
+		} else if c.imagesAreLocal {
 			imageID, err := c.client.ImageID(ctx, a.Tag)
 			if err != nil {
 				return err
@@ -168,6 +218,12 @@ func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByN
 		c.cacheMutex.Lock()
 		c.artifactCache[hashByName[a.ImageName]] = entry
 		c.cacheMutex.Unlock()
+
+		if c.index != nil && entry.Digest != "" {
+			if err := c.index.Put(ctx, hashByName[a.ImageName], entry); err != nil {
+				logrus.Warnf("Error sharing %s in remote cache: %v", a.ImageName, err)
+			}
+		}
 	}
 	return nil
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -188,6 +189,82 @@ func TestCacheBuildLocal(t *testing.T) {
 	})
 }
 
+func TestCacheBuildLocalDaemonless(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("dep1", "content1").
+			Chdir()
+
+		artifacts := []*latest.Artifact{
+			{ImageName: "artifact1", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+		}
+		deps := depLister(map[string][]string{
+			"artifact1": {"dep1"},
+		})
+
+		// Docker is not available: podman images never land in the Docker daemon
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(&testutil.FakeAPIClient{
+				ErrVersion:      true,
+				ErrImageInspect: true,
+				ErrImageList:    true,
+				ErrImagePull:    true,
+			}), nil
+		})
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+		t.Override(&util.DefaultExecCommand, testutil.
+			// First build: the image is built and its ID is read from podman
+			CmdRunOut("podman inspect --type image --format {{.Id}} artifact1:tag1", "abc\n").
+			// Second build: the image is found in podman's storage
+			AndRunOut("podman inspect --type image --format {{.Id}} artifact1:tag1", "abc\n").
+			AndRun("podman tag sha256:abc artifact1:abc").
+			// Third build: the image is found with a different tag
+			AndRunOutErr("podman inspect --type image --format {{.Id}} artifact1:tag2", "", errors.New("image not known")).
+			AndRunOut("podman inspect --type image --format {{.Id}} sha256:abc", "abc\n").
+			AndRun("podman tag sha256:abc artifact1:tag2").
+			AndRun("podman tag sha256:abc artifact1:abc"))
+
+		cfg := &mockConfig{
+			RunContext: runcontext.RunContext{
+				Cfg: latest.Pipeline{Build: latest.BuildConfig{
+					BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Backend: "podman"}},
+				}},
+			},
+			cacheFile: tmpDir.Path("cache"),
+		}
+		artifactCache, err := NewCache(cfg, true, false, deps, build.ToArtifactGraph(artifacts), make(mockArtifactStore))
+		t.CheckNoError(err)
+
+		var built []string
+		buildAndTest := func(_ context.Context, _ io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
+			var bRes []build.Artifact
+			for _, a := range artifacts {
+				built = append(built, a.ImageName)
+				bRes = append(bRes, build.Artifact{ImageName: a.ImageName, Tag: tags[a.ImageName]})
+			}
+			return bRes, nil
+		}
+
+		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, map[string]string{"artifact1": "artifact1:tag1"}, artifacts, buildAndTest)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"artifact1"}, built)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "artifact1", Tag: "artifact1:tag1"}}, bRes)
+
+		built = nil
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, map[string]string{"artifact1": "artifact1:tag1"}, artifacts, buildAndTest)
+		t.CheckNoError(err)
+		t.CheckEmpty(built)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "artifact1", Tag: "artifact1:abc"}}, bRes)
+
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, map[string]string{"artifact1": "artifact1:tag2"}, artifacts, buildAndTest)
+		t.CheckNoError(err)
+		t.CheckEmpty(built)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "artifact1", Tag: "artifact1:abc"}}, bRes)
+	})
+}
+
 func TestCacheBuildRemote(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	specs "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,13 +33,14 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const initContainer = "kaniko-init-container"
 
-func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, requiredImages map[string]*string) (string, error) {
+func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, platforms []string, tag string, requiredImages map[string]*string) (string, error) {
 	generatedEnvs, err := generateEnvFromImage(tag)
 	if err != nil {
 		return "", fmt.Errorf("error processing generated env variables from image uri: %w", err)
@@ -60,14 +63,48 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}
 	pods := client.CoreV1().Pods(b.Namespace)
 
-	podSpec, err := b.kanikoPodSpec(artifact, tag)
+	if len(platforms) <= 1 {
+		var targetPlatform string
+		if len(platforms) == 1 {
+			targetPlatform = platforms[0]
+		}
+		if err := b.runKanikoPod(ctx, out, pods, workspace, artifactName, artifact, tag, targetPlatform); err != nil {
+			return "", err
+		}
+		return docker.RemoteDigest(tag, b.cfg)
+	}
+
+	// Kaniko builds for a single platform: build each platform on matching nodes,
+	// then assemble the images into a manifest list.
+	var images []docker.PlatformImage
+	for _, targetPlatform := range platforms {
+		p, err := platform.Parse(targetPlatform)
+		if err != nil {
+			return "", err
+		}
+		platformTag, err := tagForPlatform(tag, p)
+		if err != nil {
+			return "", err
+		}
+
+		if err := b.runKanikoPod(ctx, out, pods, workspace, artifactName, artifact, platformTag, targetPlatform); err != nil {
+			return "", fmt.Errorf("building for platform %s: %w", targetPlatform, err)
+		}
+		images = append(images, docker.PlatformImage{Platform: p, Image: platformTag})
+	}
+
+	return docker.CreateManifestList(tag, images, b.cfg)
+}
+
+func (b *Builder) runKanikoPod(ctx context.Context, out io.Writer, pods corev1.PodInterface, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, targetPlatform string) error {
+	podSpec, err := b.kanikoPodSpec(artifact, tag, targetPlatform)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
 	if err != nil {
-		return "", fmt.Errorf("creating kaniko pod: %w", err)
+		return fmt.Errorf("creating kaniko pod: %w", err)
 	}
 	defer func() {
 		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
@@ -78,7 +115,7 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}()
 
 	if err := b.copyKanikoBuildContext(ctx, workspace, artifactName, artifact, pods, pod.Name); err != nil {
-		return "", fmt.Errorf("copying sources: %w", err)
+		return fmt.Errorf("copying sources: %w", err)
 	}
 
 	// Wait for the pods to succeed while streaming the logs
@@ -86,12 +123,20 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 
 	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
 		waitForLogs()
-		return "", err
+		return err
 	}
 
 	waitForLogs()
+	return nil
+}
 
-	return docker.RemoteDigest(tag, b.cfg)
+// tagForPlatform derives, from the tag of a multi-platform image, the tag of the image built for one of the platforms.
+func tagForPlatform(tag string, p specs.Platform) (string, error) {
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+	return ref.Context().Tag(ref.TagStr() + "_" + platform.TagSuffix(p)).Name(), nil
 }
 
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
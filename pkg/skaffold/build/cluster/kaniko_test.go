@@ -19,6 +19,7 @@ package cluster
 import (
 	"testing"
 
+	specs "github.com/google/go-containerregistry/pkg/v1"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -96,3 +97,39 @@ func TestEnvInterpolation_Latest(t *testing.T) {
 	}
 	testutil.CheckElementsMatch(t, expected, actual)
 }
+
+func TestTagForPlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		tag         string
+		platform    specs.Platform
+		expected    string
+	}{
+		{
+			description: "tagged image",
+			tag:         "gcr.io/project/image:v1",
+			platform:    specs.Platform{OS: "linux", Architecture: "arm64"},
+			expected:    "gcr.io/project/image:v1_linux_arm64",
+		},
+		{
+			description: "with variant",
+			tag:         "image:v1",
+			platform:    specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			expected:    "index.docker.io/library/image:v1_linux_arm_v7",
+		},
+		{
+			description: "untagged image",
+			tag:         "localhost:5000/image",
+			platform:    specs.Platform{OS: "linux", Architecture: "amd64"},
+			expected:    "localhost:5000/image:latest_linux_amd64",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tag, err := tagForPlatform(test.tag, test.platform)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, tag)
+		})
+	}
+}
@@ -26,15 +26,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
 
-func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string) (*v1.Pod, error) {
+func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string, targetPlatform string) (*v1.Pod, error) {
 	args, err := kanikoArgs(artifact, tag, b.cfg.GetInsecureRegistries())
 	if err != nil {
 		return nil, fmt.Errorf("building args list: %w", err)
 	}
+	if targetPlatform != "" {
+		args = append(args, "--custom-platform="+targetPlatform)
+	}
 
 	vm := v1.VolumeMount{
 		Name:      kaniko.DefaultEmptyDirName,
@@ -109,6 +113,15 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string) (*v
 		pod.Spec.Tolerations = b.ClusterDetails.Tolerations
 	}
 
+	// Run on nodes of the target platform, since kaniko doesn't cross-compile
+	if targetPlatform != "" {
+		p, err := platform.Parse(targetPlatform)
+		if err != nil {
+			return nil, err
+		}
+		pod.Spec.NodeSelector = platform.NodeSelector(p)
+	}
+
 	// Add used-defines Volumes
 	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
 
@@ -17,6 +17,7 @@ limitations under the License.
 package cluster
 
 import (
+	"strings"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -240,7 +241,7 @@ func TestKanikoPodSpec(t *testing.T) {
 			},
 		},
 	}
-	pod, _ := builder.kanikoPodSpec(artifact, "tag")
+	pod, _ := builder.kanikoPodSpec(artifact, "tag", "")
 
 	expectedPod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -383,6 +384,21 @@ func TestKanikoPodSpec(t *testing.T) {
 	testutil.CheckDeepEqual(t, expectedPod.Spec.Containers[0].Env, pod.Spec.Containers[0].Env)
 }
 
+func TestKanikoPodSpecPlatform(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		builder := &Builder{
+			cfg:            &mockConfig{},
+			ClusterDetails: &latest.ClusterDetails{Namespace: "ns"},
+		}
+
+		pod, err := builder.kanikoPodSpec(&latest.KanikoArtifact{DockerfilePath: "Dockerfile"}, "tag", "linux/arm64")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"}, pod.Spec.NodeSelector)
+		t.CheckContains("--custom-platform=linux/arm64", strings.Join(pod.Spec.Containers[0].Args, " "))
+	})
+}
+
 func TestResourceRequirements(t *testing.T) {
 	tests := []struct {
 		description string
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	Validate = validate
+)
+
+// Name is the name of the custom builder
+var Name = "Custom"
+
+var (
+	// imageBuildRegex matches commands that build container images.
+	imageBuildRegex = regexp.MustCompile(`\b(docker( buildx| image)? build|podman build|buildah (bud|build)|pack build|ko (build|publish))\b`)
+	// imageVarRegex matches references to the `IMAGE` environment variable set by Skaffold.
+	imageVarRegex = regexp.MustCompile(`\$(IMAGE\b|\{IMAGE\}|\(IMAGE\)|\$IMAGE\b|\$\{IMAGE\})`)
+	// makeTargetRegex matches the first line of a Makefile rule.
+	makeTargetRegex = regexp.MustCompile(`^([A-Za-z0-9_/-][A-Za-z0-9_./-]*)\s*:([^=]|$)`)
+)
+
+// ArtifactConfig holds information about a script or Makefile target that builds an image
+type ArtifactConfig struct {
+	File         string `json:"path,omitempty"`
+	BuildCommand string `json:"buildCommand,omitempty"`
+}
+
+// Name returns the name of the builder
+func (c ArtifactConfig) Name() string {
+	return Name
+}
+
+// Describe returns the initBuilder's string representation, used when prompting the user to choose a builder.
+func (c ArtifactConfig) Describe() string {
+	return fmt.Sprintf("%s (%s, %s)", c.Name(), c.BuildCommand, c.File)
+}
+
+// ArtifactType returns the type of the artifact to be built.
+func (c ArtifactConfig) ArtifactType() latest.ArtifactType {
+	return latest.ArtifactType{
+		CustomArtifact: &latest.CustomArtifact{
+			BuildCommand: c.BuildCommand,
+		},
+	}
+}
+
+// ConfiguredImage returns the target image configured by the builder, or empty string if no image is configured
+func (c ArtifactConfig) ConfiguredImage() string {
+	// Target image is passed by Skaffold to custom builds
+	return ""
+}
+
+// Path returns the path to the build definition
+func (c ArtifactConfig) Path() string {
+	return c.File
+}
+
+// validate checks if a file is a Makefile or a shell script that builds the image named by `$IMAGE`,
+// following the contract of custom builds. Returns a config for each such Makefile target or script.
+func validate(path string) []ArtifactConfig {
+	base := filepath.Base(path)
+	isMakefile := base == "Makefile" || base == "makefile" || base == "GNUmakefile"
+	if !isMakefile && filepath.Ext(base) != ".sh" {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	if isMakefile {
+		var results []ArtifactConfig
+		for _, target := range imageMakeTargets(content) {
+			results = append(results, ArtifactConfig{File: path, BuildCommand: "make " + target})
+		}
+		return results
+	}
+
+	if !buildsImage(string(content)) {
+		return nil
+	}
+	command := "sh " + base
+	if info, err := os.Stat(path); err == nil && info.Mode()&0111 != 0 {
+		command = "./" + base
+	}
+	return []ArtifactConfig{{File: path, BuildCommand: command}}
+}
+
+// imageMakeTargets lists the Makefile targets whose recipe builds the image.
+func imageMakeTargets(makefile []byte) []string {
+	var targets []string
+	var target string
+	var recipe strings.Builder
+
+	flush := func() {
+		if target != "" && buildsImage(recipe.String()) {
+			targets = append(targets, target)
+		}
+		target = ""
+		recipe.Reset()
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(makefile))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "\t") {
+			recipe.WriteString(line)
+			recipe.WriteString("\n")
+			continue
+		}
+
+		if match := makeTargetRegex.FindStringSubmatch(line); match != nil {
+			flush()
+			target = match[1]
+		}
+	}
+	flush()
+
+	return targets
+}
+
+func buildsImage(script string) bool {
+	return imageBuildRegex.MatchString(script) && imageVarRegex.MatchString(script)
+}
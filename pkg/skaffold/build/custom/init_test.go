@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"os"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		contents    string
+		executable  bool
+		expected    []ArtifactConfig
+	}{
+		{
+			description: "not a script",
+			path:        "main.go",
+			contents:    "docker build -t $IMAGE .",
+		},
+		{
+			description: "Makefile targets",
+			path:        "app/Makefile",
+			contents: `IMAGE ?= app
+VERSION := 1.0
+
+.PHONY: build image push
+
+build:
+	go build -o bin/app .
+
+image: build
+	docker build -t $(IMAGE) .
+
+push: image
+	docker push $(IMAGE)
+
+debug-image:
+	@echo building
+	docker buildx build --target debug -t ${IMAGE} .
+`,
+			expected: []ArtifactConfig{
+				{File: "app/Makefile", BuildCommand: "make image"},
+				{File: "app/Makefile", BuildCommand: "make debug-image"},
+			},
+		},
+		{
+			description: "Makefile that ignores IMAGE",
+			path:        "Makefile",
+			contents:    "image:\n\tdocker build -t my-app .\n",
+		},
+		{
+			description: "executable script",
+			path:        "build.sh",
+			contents:    "#!/bin/sh\nset -e\npack build \"$IMAGE\" --builder gcr.io/buildpacks/builder\n",
+			executable:  true,
+			expected:    []ArtifactConfig{{File: "build.sh", BuildCommand: "./build.sh"}},
+		},
+		{
+			description: "script",
+			path:        "scripts/image.sh",
+			contents:    "podman build -t ${IMAGE} .\nif [ \"$PUSH_IMAGE\" = true ]; then podman push $IMAGE; fi\n",
+			expected:    []ArtifactConfig{{File: "scripts/image.sh", BuildCommand: "sh image.sh"}},
+		},
+		{
+			description: "script that doesn't build images",
+			path:        "test.sh",
+			contents:    "go test ./... -run $IMAGE_TEST",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write(test.path, test.contents).Chdir()
+			mode := os.FileMode(0644)
+			if test.executable {
+				mode = 0755
+			}
+			t.CheckNoError(os.Chmod(tmpDir.Path(test.path), mode))
+
+			configs := validate(test.path)
+
+			t.CheckDeepEqual(test.expected, configs)
+		})
+	}
+}
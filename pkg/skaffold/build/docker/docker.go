@@ -22,9 +22,11 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
@@ -43,7 +45,17 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	if err := b.pullCacheFromImages(ctx, out, a.ArtifactType.DockerArtifact); err != nil {
 		return "", cacheFromPullErr(err, a.ImageName)
 	}
-	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
+	opts := docker.BuildOptions{Tag: tag, Mode: b.cfg.Mode(), ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
+
+	if len(a.Platforms) > 1 {
+		if !b.pushImages {
+			return "", platform.MultiPlatformNoPushErr(a.ImageName)
+		}
+		return b.buildxMultiPlatform(ctx, color.GetWriter(out), a.Workspace, dockerfile, a.ArtifactType.DockerArtifact, a.Platforms, opts)
+	}
+	if len(a.Platforms) == 1 {
+		opts.Platform = a.Platforms[0]
+	}
 
 	var imageID string
 
@@ -68,7 +80,7 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 
 func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions) (string, error) {
 	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
-	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
+	ba, err := docker.EvalBuildArgs(b.cfg.Mode(), workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
 	if err != nil {
 		return "", fmt.Errorf("unable to evaluate build args: %w", err)
 	}
@@ -78,6 +90,10 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	}
 	args = append(args, cliArgs...)
 
+	if opts.Platform != "" {
+		args = append(args, "--platform", opts.Platform)
+	}
+
 	if b.prune {
 		args = append(args, "--force-rm")
 	}
@@ -97,6 +113,34 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	return b.localDocker.ImageID(ctx, opts.Tag)
 }
 
+// buildxMultiPlatform builds the image for several platforms with `docker buildx`
+// and pushes the resulting OCI image index.
+func (b *Builder) buildxMultiPlatform(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest.DockerArtifact, platforms []string, opts docker.BuildOptions) (string, error) {
+	args := []string{"buildx", "build", workspace, "--file", dockerfilePath, "-t", opts.Tag,
+		"--platform", strings.Join(platforms, ","),
+		"--output", "type=image,push=true,oci-mediatypes=true"}
+	ba, err := docker.EvalBuildArgs(b.cfg.Mode(), workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a, ba)
+	if err != nil {
+		return "", fmt.Errorf("getting docker build args: %w", err)
+	}
+	args = append(args, cliArgs...)
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running buildx build: %w", err)
+	}
+
+	return docker.RemoteDigest(opts.Tag, b.cfg)
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest.DockerArtifact) error {
 	if len(a.CacheFrom) == 0 {
 		return nil
@@ -106,7 +106,7 @@ func TestDockerCLIBuild(t *testing.T) {
 			}
 			t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
 
-			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, test.mode, nil, mockArtifactResolver{make(map[string]string)})
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), &mockConfig{mode: test.mode}, test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, mockArtifactResolver{make(map[string]string)})
 
 			artifact := &latest.Artifact{
 				Workspace: ".",
@@ -126,6 +126,72 @@ func TestDockerCLIBuild(t *testing.T) {
 	}
 }
 
+func TestDockerBuildPlatforms(t *testing.T) {
+	tests := []struct {
+		description    string
+		localBuild     latest.LocalBuild
+		platforms      []string
+		push           bool
+		command        string
+		expectedDigest string
+		shouldErr      bool
+	}{
+		{
+			description: "single platform",
+			localBuild:  latest.LocalBuild{UseBuildkit: true},
+			platforms:   []string{"linux/arm64"},
+			command:     "docker build . --file %s -t tag --platform linux/arm64",
+		},
+		{
+			description:    "several platforms",
+			platforms:      []string{"linux/amd64", "linux/arm64"},
+			push:           true,
+			command:        "docker buildx build . --file %s -t tag --platform linux/amd64,linux/arm64 --output type=image,push=true,oci-mediatypes=true",
+			expectedDigest: "sha256:abacab",
+		},
+		{
+			description: "several platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+				return "sha256:abacab", nil
+			})
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			if test.command != "" {
+				t.Override(&util.DefaultExecCommand, testutil.CmdRun(fmt.Sprintf(test.command, dockerfilePath)))
+			}
+
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(nil), &mockConfig{}, test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, test.push, false, mockArtifactResolver{make(map[string]string)})
+			artifact := &latest.Artifact{
+				ImageName: "image",
+				Workspace: ".",
+				Platforms: test.platforms,
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+			}
+
+			digest, err := builder.Build(context.Background(), ioutil.Discard, artifact, "tag")
+
+			t.CheckError(test.shouldErr, err)
+			if test.expectedDigest != "" {
+				t.CheckDeepEqual(test.expectedDigest, digest)
+			}
+		})
+	}
+}
+
 func fakeLocalDaemonWithExtraEnv(extraEnv []string) docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, extraEnv, false, nil)
 }
@@ -142,6 +208,14 @@ func (r mockArtifactResolver) GetImageTag(imageName string) (string, bool) {
 	return val, found
 }
 
+type mockConfig struct {
+	docker.Config
+	mode config.RunMode
+}
+
+func (c *mockConfig) Mode() config.RunMode                   { return c.mode }
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+
 type stubAuth struct{}
 
 func (t stubAuth) GetAuthConfig(string) (types.AuthConfig, error) {
@@ -17,20 +17,18 @@ limitations under the License.
 package docker
 
 import (
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
 
 // Builder is an artifact builder that uses docker
 type Builder struct {
-	localDocker        docker.LocalDaemon
-	pushImages         bool
-	prune              bool
-	useCLI             bool
-	useBuildKit        bool
-	mode               config.RunMode
-	insecureRegistries map[string]bool
-	artifacts          ArtifactResolver
+	localDocker docker.LocalDaemon
+	pushImages  bool
+	prune       bool
+	useCLI      bool
+	useBuildKit bool
+	cfg         docker.Config
+	artifacts   ArtifactResolver
 }
 
 // ArtifactResolver provides an interface to resolve built artifact tags by image name.
@@ -39,15 +37,14 @@ type ArtifactResolver interface {
 }
 
 // NewBuilder returns an new instance of a docker builder
-func NewArtifactBuilder(localDocker docker.LocalDaemon, useCLI, useBuildKit, pushImages, prune bool, mode config.RunMode, insecureRegistries map[string]bool, r ArtifactResolver) *Builder {
+func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, useCLI, useBuildKit, pushImages, prune bool, r ArtifactResolver) *Builder {
 	return &Builder{
-		localDocker:        localDocker,
-		pushImages:         pushImages,
-		prune:              prune,
-		useCLI:             useCLI,
-		useBuildKit:        useBuildKit,
-		mode:               mode,
-		insecureRegistries: insecureRegistries,
-		artifacts:          r,
+		localDocker: localDocker,
+		pushImages:  pushImages,
+		prune:       prune,
+		useCLI:      useCLI,
+		useBuildKit: useBuildKit,
+		cfg:         cfg,
+		artifacts:   r,
 	}
 }
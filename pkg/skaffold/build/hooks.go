@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// WithHooks wraps an `artifactBuilder` so that it runs the artifact's lifecycle hooks before and after the build.
+func WithHooks(builder ArtifactBuilder, pushImages bool) ArtifactBuilder {
+	return func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+		h := artifact.LifecycleHooks
+		if len(h.PreHooks) == 0 && len(h.PostHooks) == 0 {
+			return builder(ctx, out, artifact, tag)
+		}
+
+		opts, err := hooks.NewBuildEnvOpts(artifact, tag, pushImages)
+		if err != nil {
+			return "", err
+		}
+		r := hooks.BuildRunner(h, opts)
+
+		if err := r.RunPreHooks(ctx, out); err != nil {
+			return "", err
+		}
+		digest, err := builder(ctx, out, artifact, tag)
+		if err != nil {
+			return "", err
+		}
+		if err := r.RunPostHooks(ctx, out); err != nil {
+			return "", err
+		}
+		return digest, nil
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWithHooks(t *testing.T) {
+	hooks := latest.BuildHooks{
+		PreHooks:  []latest.HostHook{{Command: []string{"pre"}}},
+		PostHooks: []latest.HostHook{{Command: []string{"post"}}},
+	}
+
+	tests := []struct {
+		description    string
+		builder        ArtifactBuilder
+		hooks          latest.BuildHooks
+		commands       util.Command
+		shouldErr      bool
+		expectedDigest string
+		expectedOutput string
+	}{
+		{
+			description:    "no hooks",
+			builder:        fakeBuilder,
+			expectedDigest: "digest",
+			expectedOutput: "building img with tag img:123\n",
+		},
+		{
+			description:    "pre and post hooks",
+			builder:        fakeBuilder,
+			hooks:          hooks,
+			commands:       testutil.CmdRun("pre").AndRun("post"),
+			expectedDigest: "digest",
+			expectedOutput: "Starting pre-build hooks...\nCompleted pre-build hooks\nbuilding img with tag img:123\nStarting post-build hooks...\nCompleted post-build hooks\n",
+		},
+		{
+			description:    "failed pre hook",
+			builder:        fakeBuilder,
+			hooks:          hooks,
+			commands:       testutil.CmdRunErr("pre", errors.New("bug")),
+			shouldErr:      true,
+			expectedOutput: "Starting pre-build hooks...\n",
+		},
+		{
+			description:    "failed build skips post hooks",
+			builder:        fakeFailingBuilder,
+			hooks:          hooks,
+			commands:       testutil.CmdRun("pre"),
+			shouldErr:      true,
+			expectedOutput: "Starting pre-build hooks...\nCompleted pre-build hooks\nfailed to build img with tag img:123\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+			var out bytes.Buffer
+
+			builder := WithHooks(test.builder, false)
+			digest, err := builder(context.Background(), &out, &latest.Artifact{ImageName: "img", LifecycleHooks: test.hooks}, "img:123")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedDigest, digest)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
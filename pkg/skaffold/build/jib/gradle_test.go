@@ -37,6 +37,7 @@ func TestBuildJibGradleToDocker(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -84,6 +85,8 @@ func TestBuildJibGradleToDocker(t *testing.T) {
 
 			builder := NewArtifactBuilder(localDocker, &mockConfig{}, false, false, nil)
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+				ImageName: "img",
+				Platforms: test.platforms,
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
 				},
@@ -103,6 +106,7 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -128,6 +132,12 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 				"gradle fake-gradleBuildArgs-for-jib -Djib.from.image=docker://busybox --image=img:tag",
 			),
 		},
+		{
+			description: "build for several platforms",
+			artifact:    &latest.JibArtifact{},
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRun("gradle fake-gradleBuildArgs-for-jib --image=img:tag -Djib.from.platforms=linux/amd64,linux/arm64 -Djib.container.format=OCI"),
+		},
 		{
 			description: "fail build",
 			artifact:    &latest.JibArtifact{},
@@ -155,6 +165,8 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 
 			builder := NewArtifactBuilder(localDocker, &mockConfig{}, true, false, nil)
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+				ImageName: "img",
+				Platforms: test.platforms,
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
 				},
@@ -326,6 +326,21 @@ func isOnInsecureRegistry(image string, insecureRegistries map[string]bool) (boo
 	return docker.IsInsecure(ref, insecureRegistries), nil
 }
 
+// platformArgs formats the target platforms as build arguments.
+// Jib builds an image index when the base image is available for several platforms,
+// and uses the OCI format so that it's an OCI image index.
+func platformArgs(platforms []string) []string {
+	if len(platforms) == 0 {
+		return nil
+	}
+
+	args := []string{"-Djib.from.platforms=" + strings.Join(platforms, ",")}
+	if len(platforms) > 1 {
+		args = append(args, "-Djib.container.format=OCI")
+	}
+	return args
+}
+
 // baseImageArg formats the base image as a build argument. It also replaces the provided base image with an image from the required artifacts if specified.
 func baseImageArg(a *latest.JibArtifact, r ArtifactResolver, deps []*latest.ArtifactDependency, pushImages bool) (string, bool) {
 	if a.BaseImage == "" {
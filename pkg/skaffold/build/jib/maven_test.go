@@ -37,6 +37,7 @@ func TestBuildJibMavenToDocker(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -62,6 +63,21 @@ func TestBuildJibMavenToDocker(t *testing.T) {
 				"mvn fake-mavenBuildArgs-for-dockerBuild -Djib.from.image=docker://busybox -Dimage=img:tag",
 			),
 		},
+		{
+			description: "build for a single platform",
+			artifact:    &latest.JibArtifact{},
+			platforms:   []string{"linux/arm64"},
+			commands: testutil.CmdRun(
+				"mvn fake-mavenBuildArgs-for-dockerBuild -Dimage=img:tag -Djib.from.platforms=linux/arm64",
+			),
+		},
+		{
+			description:   "several platforms need push",
+			artifact:      &latest.JibArtifact{},
+			platforms:     []string{"linux/amd64", "linux/arm64"},
+			shouldErr:     true,
+			expectedError: "artifact img targets several platforms and must be pushed to a registry",
+		},
 		{
 			description: "fail build",
 			artifact:    &latest.JibArtifact{},
@@ -84,6 +100,8 @@ func TestBuildJibMavenToDocker(t *testing.T) {
 
 			builder := NewArtifactBuilder(localDocker, &mockConfig{}, false, false, mockArtifactResolver{})
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+				ImageName: "img",
+				Platforms: test.platforms,
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
 				},
@@ -103,6 +121,7 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 	tests := []struct {
 		description   string
 		artifact      *latest.JibArtifact
+		platforms     []string
 		commands      util.Command
 		shouldErr     bool
 		expectedError string
@@ -122,6 +141,12 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 			artifact:    &latest.JibArtifact{BaseImage: "docker://busybox"},
 			commands:    testutil.CmdRun("mvn fake-mavenBuildArgs-for-build -Djib.from.image=docker://busybox -Dimage=img:tag"),
 		},
+		{
+			description: "build for several platforms",
+			artifact:    &latest.JibArtifact{},
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			commands:    testutil.CmdRun("mvn fake-mavenBuildArgs-for-build -Dimage=img:tag -Djib.from.platforms=linux/amd64,linux/arm64 -Djib.container.format=OCI"),
+		},
 		{
 			description: "fail build",
 			artifact:    &latest.JibArtifact{},
@@ -149,6 +174,8 @@ func TestBuildJibMavenToRegistry(t *testing.T) {
 
 			builder := NewArtifactBuilder(localDocker, &mockConfig{}, true, false, mockArtifactResolver{})
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+				ImageName: "img",
+				Platforms: test.platforms,
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
 				},
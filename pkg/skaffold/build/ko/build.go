@@ -0,0 +1,269 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// appDir is the directory of the image where the binary is added.
+const appDir = "/ko-app"
+
+// for testing
+var osEnviron = os.Environ
+
+// Build builds an artifact by compiling its main package for each target platform.
+func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+	a := artifact.KoArtifact
+
+	platforms := artifact.Platforms
+	if len(platforms) == 0 {
+		platforms = []string{"linux/" + runtime.GOARCH}
+	}
+	if len(platforms) > 1 && !b.pushImages {
+		return "", platform.MultiPlatformNoPushErr(artifact.ImageName)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-ko")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var images []v1.Image
+	var adds []mutate.IndexAddendum
+	for _, targetPlatform := range platforms {
+		p, err := platform.Parse(targetPlatform)
+		if err != nil {
+			return "", err
+		}
+
+		img, err := b.buildImage(ctx, out, artifact.Workspace, a, p, filepath.Join(tmpDir, platform.TagSuffix(p)))
+		if err != nil {
+			return "", fmt.Errorf("building for platform %s: %w", targetPlatform, err)
+		}
+		images = append(images, img)
+		adds = append(adds, mutate.IndexAddendum{
+			Add:        img,
+			Descriptor: v1.Descriptor{Platform: &p},
+		})
+	}
+
+	if !b.pushImages {
+		return b.loadImage(ctx, out, images[0], tag)
+	}
+
+	if len(images) == 1 {
+		if err := docker.WriteRemoteImage(tag, images[0], b.cfg); err != nil {
+			return "", err
+		}
+		h, err := images[0].Digest()
+		if err != nil {
+			return "", fmt.Errorf("computing image digest: %w", err)
+		}
+		return h.String(), nil
+	}
+
+	idx := mutate.IndexMediaType(mutate.AppendManifests(empty.Index, adds...), types.OCIImageIndex)
+	return docker.WriteRemoteIndex(tag, idx, b.cfg)
+}
+
+// buildImage compiles the main package for the given platform and adds the binary to the base image.
+func (b *Builder) buildImage(ctx context.Context, out io.Writer, workspace string, a *latest.KoArtifact, p v1.Platform, binary string) (v1.Image, error) {
+	base, err := docker.RetrievePlatformImage(a.BaseImage, p, b.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting base image %q: %w", a.BaseImage, err)
+	}
+
+	env, err := buildEnv(a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"build", "-o", binary}
+	args = append(args, BuildArgs(a, b.cfg.Mode())...)
+	if a.Main != "" {
+		args = append(args, a.Main)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = workspace
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return nil, fmt.Errorf("running go build: %w", err)
+	}
+
+	return appImage(base, binary, appName(workspace, a.Main), b.cfg.Mode() == config.RunModes.Debug)
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, img v1.Image, tag string) (string, error) {
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+
+	imageID, err := b.localDocker.Load(ctx, out, r, tag)
+	r.Close()
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+
+	return imageID, nil
+}
+
+// BuildArgs returns the flags passed to `go build`.
+// Under `skaffold debug`, optimizations are disabled so that dlv can attach to the binary.
+func BuildArgs(a *latest.KoArtifact, mode config.RunMode) []string {
+	var args []string
+	if mode == config.RunModes.Debug {
+		args = append(args, "-gcflags", "all=-N -l")
+	} else {
+		args = append(args, "-trimpath")
+	}
+	args = append(args, a.Flags...)
+	if len(a.Ldflags) > 0 {
+		args = append(args, "-ldflags", strings.Join(a.Ldflags, " "))
+	}
+	return args
+}
+
+// buildEnv returns the environment of `go build`, cross-compiling a static binary for the given platform.
+func buildEnv(a *latest.KoArtifact, p v1.Platform) ([]string, error) {
+	env := append(osEnviron(), "CGO_ENABLED=0", "GOOS="+p.OS, "GOARCH="+p.Architecture)
+	if p.Architecture == "arm" && p.Variant != "" {
+		env = append(env, "GOARM="+strings.TrimPrefix(p.Variant, "v"))
+	}
+
+	artifactEnv, err := misc.EvaluateEnv(a.Env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate env variables: %w", err)
+	}
+	return append(env, artifactEnv...), nil
+}
+
+// appName returns the name of the binary, after the directory of the main package.
+func appName(workspace, main string) string {
+	dir, err := filepath.Abs(filepath.Join(workspace, main))
+	if err != nil {
+		dir = filepath.Join(workspace, main)
+	}
+	return filepath.Base(dir)
+}
+
+// appImage adds the binary to the base image and runs it as the entrypoint.
+// Debug images declare GOTRACEBACK so that `skaffold debug` recognizes a Go application.
+func appImage(base v1.Image, binary, name string, debug bool) (v1.Image, error) {
+	target := path.Join(appDir, name)
+
+	layer, err := binaryLayer(binary, target)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := mutate.Append(base, mutate.Addendum{
+		Layer: layer,
+		History: v1.History{
+			CreatedBy: "skaffold ko build",
+			Comment:   "go build output, at " + target,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding binary layer: %w", err)
+	}
+
+	cf, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading image config: %w", err)
+	}
+	cfg := cf.Config.DeepCopy()
+	cfg.Entrypoint = []string{target}
+	cfg.Cmd = nil
+	if debug {
+		cfg.Env = append(cfg.Env, "GOTRACEBACK=all")
+	}
+
+	return mutate.Config(img, *cfg)
+}
+
+// binaryLayer creates an image layer holding the binary at the given path.
+func binaryLayer(binary, target string) (v1.Layer, error) {
+	content, err := ioutil.ReadFile(binary)
+	if err != nil {
+		return nil, fmt.Errorf("reading binary: %w", err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(path.Dir(target), "/") + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0555,
+	}); err != nil {
+		return nil, err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(target, "/"),
+		Typeflag: tar.TypeReg,
+		Mode:     0555,
+		Size:     int64(len(content)),
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	layerBytes := buf.Bytes()
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(layerBytes)), nil
+	})
+}
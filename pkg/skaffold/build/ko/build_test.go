@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	mode config.RunMode
+}
+
+func (c *mockConfig) GetKubeContext() string                 { return "" }
+func (c *mockConfig) MinikubeProfile() string                { return "" }
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) Mode() config.RunMode                   { return c.mode }
+func (c *mockConfig) Prune() bool                            { return false }
+
+func TestBuildArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.KoArtifact
+		mode        config.RunMode
+		expected    []string
+	}{
+		{
+			description: "default",
+			artifact:    &latest.KoArtifact{},
+			mode:        config.RunModes.Dev,
+			expected:    []string{"-trimpath"},
+		},
+		{
+			description: "flags and ldflags",
+			artifact:    &latest.KoArtifact{Flags: []string{"-tags=netgo"}, Ldflags: []string{"-s", "-w"}},
+			mode:        config.RunModes.Build,
+			expected:    []string{"-trimpath", "-tags=netgo", "-ldflags", "-s -w"},
+		},
+		{
+			description: "debug",
+			artifact:    &latest.KoArtifact{Flags: []string{"-v"}},
+			mode:        config.RunModes.Debug,
+			expected:    []string{"-gcflags", "all=-N -l", "-v"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			args := BuildArgs(test.artifact, test.mode)
+
+			t.CheckDeepEqual(test.expected, args)
+		})
+	}
+}
+
+func TestBuildEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		env         []string
+		expected    []string
+	}{
+		{
+			description: "linux/amd64",
+			platform:    "linux/amd64",
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=amd64"},
+		},
+		{
+			description: "arm variant",
+			platform:    "linux/arm/v7",
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"},
+		},
+		{
+			description: "artifact env comes last",
+			platform:    "linux/arm64",
+			env:         []string{"GOPRIVATE=example.com", "CGO_ENABLED=1"},
+			expected:    []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm64", "GOPRIVATE=example.com", "CGO_ENABLED=1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&osEnviron, func() []string { return []string{"PATH=/bin"} })
+			p, err := platform.Parse(test.platform)
+			t.CheckNoError(err)
+
+			env, err := buildEnv(&latest.KoArtifact{Env: test.env}, p)
+
+			t.CheckErrorAndDeepEqual(false, err, append([]string{"PATH=/bin"}, test.expected...), env)
+		})
+	}
+}
+
+func TestAppName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "app", appName("cmd/app", ""))
+	testutil.CheckDeepEqual(t, "server", appName(".", "./cmd/server"))
+}
+
+func TestAppImage(t *testing.T) {
+	tests := []struct {
+		description string
+		debug       bool
+		expectedEnv []string
+	}{
+		{
+			description: "dev",
+			expectedEnv: []string{"PATH=/bin"},
+		},
+		{
+			description: "debug",
+			debug:       true,
+			expectedEnv: []string{"PATH=/bin", "GOTRACEBACK=all"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			binary := t.NewTempDir().Write("app", "binary").Path("app")
+			base, err := mutate.Config(empty.Image, v1.Config{Env: []string{"PATH=/bin"}, Cmd: []string{"sh"}})
+			t.CheckNoError(err)
+
+			img, err := appImage(base, binary, "app", test.debug)
+			t.CheckNoError(err)
+
+			cf, err := img.ConfigFile()
+			t.CheckNoError(err)
+			t.CheckDeepEqual([]string{"/ko-app/app"}, cf.Config.Entrypoint)
+			t.CheckEmpty(cf.Config.Cmd)
+			t.CheckDeepEqual(test.expectedEnv, cf.Config.Env)
+
+			layers, err := img.Layers()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(1, len(layers))
+
+			content, err := layers[0].Uncompressed()
+			t.CheckNoError(err)
+			defer content.Close()
+			files := map[string]string{}
+			tr := tar.NewReader(content)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				t.CheckNoError(err)
+				buf, err := ioutil.ReadAll(tr)
+				t.CheckNoError(err)
+				files[hdr.Name] = string(buf)
+			}
+			t.CheckDeepEqual(map[string]string{"ko-app/": "", "ko-app/app": "binary"}, files)
+		})
+	}
+}
+
+func TestBuildMultiPlatformWithoutPush(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		builder := NewArtifactBuilder(nil, &mockConfig{}, false)
+
+		_, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+			ImageName: "app",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ArtifactType: latest.ArtifactType{
+				KoArtifact: &latest.KoArtifact{},
+			},
+		}, "app:tag")
+
+		t.CheckErrorContains("push", err)
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// goPackage holds the fields of `go list -json` output needed to find source files.
+type goPackage struct {
+	Dir        string
+	Standard   bool
+	GoFiles    []string
+	EmbedFiles []string
+	Module     *goModule
+}
+
+type goModule struct {
+	Main    bool
+	GoMod   string
+	Replace *goModule
+	Version string
+}
+
+// GetDependencies finds the source files of the packages that the main package depends on,
+// as listed by `go list -deps`. Packages downloaded into the module cache are ignored.
+// All paths are relative to the workspace.
+func GetDependencies(ctx context.Context, workspace string, a *latest.KoArtifact) ([]string, error) {
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find absolute path for %q: %w", workspace, err)
+	}
+
+	env, err := buildEnv(a, v1.Platform{OS: "linux", Architecture: "amd64"})
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"list", "-deps", "-json"}
+	args = append(args, a.Flags...)
+	if a.Main != "" {
+		args = append(args, a.Main)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = workspace
+	cmd.Env = env
+	stdout, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("listing go dependencies: %w", err)
+	}
+
+	var deps []string
+	seen := map[string]bool{}
+	add := func(path string) error {
+		if seen[path] {
+			return nil
+		}
+		seen[path] = true
+
+		rel, err := filepath.Rel(absWorkspace, path)
+		if err != nil {
+			return fmt.Errorf("unable to find relative path for %q: %w", path, err)
+		}
+		deps = append(deps, rel)
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(stdout))
+	for {
+		var pkg goPackage
+		if err := decoder.Decode(&pkg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("parsing go list output: %w", err)
+		}
+
+		if !isLocal(pkg) {
+			continue
+		}
+
+		if pkg.Module != nil {
+			mod := pkg.Module
+			if mod.Replace != nil {
+				mod = mod.Replace
+			}
+			if mod.GoMod != "" {
+				for _, file := range []string{mod.GoMod, filepath.Join(filepath.Dir(mod.GoMod), "go.sum")} {
+					if _, err := os.Stat(file); err != nil {
+						continue
+					}
+					if err := add(file); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		for _, files := range [][]string{pkg.GoFiles, pkg.EmbedFiles} {
+			for _, file := range files {
+				if err := add(filepath.Join(pkg.Dir, file)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	logrus.Debugf("Found dependencies for ko artifact: %v", deps)
+
+	return deps, nil
+}
+
+// isLocal tells if a package is part of the sources: either in the main module,
+// in a module replaced by a local directory, or outside of any module.
+func isLocal(pkg goPackage) bool {
+	switch {
+	case pkg.Standard:
+		return false
+	case pkg.Module == nil:
+		return true
+	case pkg.Module.Main:
+		return true
+	default:
+		return pkg.Module.Replace != nil && pkg.Module.Replace.Version == ""
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetDependencies(t *testing.T) {
+	tests := []struct {
+		description   string
+		artifact      *latest.KoArtifact
+		expectedQuery string
+		output        string
+		expected      []string
+		shouldErr     bool
+	}{
+		{
+			description:   "main module",
+			artifact:      &latest.KoArtifact{Main: "./cmd/app"},
+			expectedQuery: "go list -deps -json ./cmd/app",
+			output: `{"Dir": "/usr/lib/go/src/fmt", "Standard": true, "GoFiles": ["print.go"]}
+{"Dir": "{{.}}/lib", "GoFiles": ["lib.go", "util.go"], "Module": {"Main": true, "GoMod": "{{.}}/go.mod"}}
+{"Dir": "{{.}}/cmd/app", "GoFiles": ["main.go"], "EmbedFiles": ["index.html"], "Module": {"Main": true, "GoMod": "{{.}}/go.mod"}}`,
+			expected: []string{"go.mod", "go.sum", filepath.Join("lib", "lib.go"), filepath.Join("lib", "util.go"), filepath.Join("cmd", "app", "main.go"), filepath.Join("cmd", "app", "index.html")},
+		},
+		{
+			description:   "ignore module cache",
+			artifact:      &latest.KoArtifact{Flags: []string{"-tags=netgo"}},
+			expectedQuery: "go list -deps -json -tags=netgo",
+			output: `{"Dir": "/go/pkg/mod/github.com/pkg/errors@v0.9.1", "GoFiles": ["errors.go"], "Module": {"GoMod": "/go/pkg/mod/cache/download/github.com/pkg/errors/@v/v0.9.1.mod", "Version": "v0.9.1"}}
+{"Dir": "/go/pkg/mod/example.com/fork@v1.0.0", "GoFiles": ["fork.go"], "Module": {"Replace": {"Version": "v1.0.0"}}}
+{"Dir": "{{.}}/../shared", "GoFiles": ["shared.go"], "Module": {"Replace": {"GoMod": "{{.}}/../shared/go.mod"}}}
+{"Dir": "{{.}}", "GoFiles": ["main.go"], "Module": {"Main": true, "GoMod": "{{.}}/go.mod"}}`,
+			expected: []string{filepath.Join("..", "shared", "shared.go"), "go.mod", "go.sum", "main.go"},
+		},
+		{
+			description:   "invalid go list output",
+			artifact:      &latest.KoArtifact{},
+			expectedQuery: "go list -deps -json",
+			output:        "not json",
+			shouldErr:     true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(map[string]string{
+				"go.mod": "",
+				"go.sum": "",
+			}).Chdir()
+			output := strings.ReplaceAll(test.output, "{{.}}", tmpDir.Root())
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(test.expectedQuery, output))
+
+			deps, err := GetDependencies(context.Background(), ".", test.artifact)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, deps)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		contents    string
+		expected    bool
+	}{
+		{
+			description: "main package",
+			path:        "cmd/app/main.go",
+			contents:    "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello\")\n}\n",
+			expected:    true,
+		},
+		{
+			description: "other file of main package",
+			path:        "cmd/app/flags.go",
+			contents:    "package main\n\nvar verbose bool\n",
+		},
+		{
+			description: "method named main",
+			path:        "server.go",
+			contents:    "package main\n\ntype server struct{}\n\nfunc (s server) main() {}\n",
+		},
+		{
+			description: "library",
+			path:        "lib/lib.go",
+			contents:    "package lib\n\nfunc main() {}\n",
+		},
+		{
+			description: "test",
+			path:        "main_test.go",
+			contents:    "package main\n\nfunc main() {}\n",
+		},
+		{
+			description: "invalid source",
+			path:        "main.go",
+			contents:    "package main\n\nfunc main() {",
+		},
+		{
+			description: "not go",
+			path:        "main.py",
+			contents:    "def main():\n  pass\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Write(test.path, test.contents).Chdir()
+
+			isValid := validate(test.path)
+
+			t.CheckDeepEqual(test.expected, isValid)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
@@ -38,17 +39,17 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags,
 	defer b.localDocker.Close()
 
 	if b.prune {
-		b.localPruner.asynchronousCleanupOldImages(ctx, artifacts)
+		b.localPruner.asynchronousCleanupOldImages(ctx, b.dockerArtifacts(artifacts))
 	}
 
-	builder := build.WithLogFile(b.buildArtifact, b.muted)
+	builder := build.WithLogFile(build.WithHooks(b.buildArtifact, b.pushImages), b.muted)
 	rt, err := build.InOrder(ctx, out, tags, artifacts, builder, *b.local.Concurrency, b.artifactStore)
 
 	if b.prune {
 		if b.mode == config.RunModes.Build {
-			b.localPruner.synchronousCleanupOldImages(ctx, artifacts)
+			b.localPruner.synchronousCleanupOldImages(ctx, b.dockerArtifacts(artifacts))
 		} else {
-			b.localPruner.asynchronousCleanupOldImages(ctx, artifacts)
+			b.localPruner.asynchronousCleanupOldImages(ctx, b.dockerArtifacts(artifacts))
 		}
 	}
 
@@ -64,7 +65,7 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		if a.DockerArtifact != nil && (b.local.Backend == "" || b.local.Backend == "docker") {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				logrus.Warnf("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -79,12 +80,16 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	}
 
 	imageID := digestOrImageID
+	if b.daemonless(a) {
+		// the image is in the podman or buildah storage, out of reach of the Docker daemon
+		return podman.TagWithImageID(ctx, out, b.local.Backend, tag, imageID)
+	}
 	b.builtImages = append(b.builtImages, imageID)
 	return build.TagWithImageID(ctx, tag, imageID, b.localDocker)
 }
 
 func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
-	if !b.pushImages {
+	if !b.pushImages && !b.daemonless(a) {
 		// All of the builders will rely on a local Docker:
 		// + Either to build the image,
 		// + Or to docker load it.
@@ -101,6 +106,22 @@ func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *lat
 	return builder.Build(ctx, out, a, tag)
 }
 
+// daemonless returns true if the artifact is built without a Docker daemon, with podman or buildah.
+func (b *Builder) daemonless(a *latest.Artifact) bool {
+	return a.DockerArtifact != nil && (b.local.Backend == podman.Podman || b.local.Backend == podman.Buildah)
+}
+
+// dockerArtifacts filters out the artifacts that don't end up in the Docker daemon and can't be pruned.
+func (b *Builder) dockerArtifacts(artifacts []*latest.Artifact) []*latest.Artifact {
+	var filtered []*latest.Artifact
+	for _, a := range artifacts {
+		if !b.daemonless(a) {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
 func (b *Builder) getImageIDForTag(ctx context.Context, tag string) (string, error) {
 	insp, _, err := b.localDocker.ImageInspectWithRaw(ctx, tag)
 	if err != nil {
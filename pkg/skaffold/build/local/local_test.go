@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
+	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/docker/docker/api/types"
@@ -27,10 +29,12 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	dockerbuilder "github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
@@ -270,6 +274,64 @@ func TestLocalRun(t *testing.T) {
 	}
 }
 
+// fakePodman records the podman commands and writes the image ID of the built images.
+type fakePodman struct {
+	commands []string
+}
+
+func (f *fakePodman) RunCmdOut(cmd *exec.Cmd) ([]byte, error) {
+	return nil, errors.New("unexpected command")
+}
+
+func (f *fakePodman) RunCmd(cmd *exec.Cmd) error {
+	f.commands = append(f.commands, strings.Join(cmd.Args, " "))
+	for i, arg := range cmd.Args {
+		if arg == "--iidfile" {
+			return ioutil.WriteFile(cmd.Args[i+1], []byte("sha256:abc"), 0644)
+		}
+	}
+	return nil
+}
+
+func TestLocalRunDaemonless(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().Touch("Dockerfile").Chdir()
+		// no Docker daemon
+		api := &testutil.FakeAPIClient{ErrVersion: true}
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(api), nil
+		})
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+		fakeCmd := &fakePodman{}
+		t.Override(&util.DefaultExecCommand, fakeCmd)
+
+		builder, err := NewBuilder(&mockConfig{
+			local: latest.LocalBuild{
+				Push:        util.BoolPtr(false),
+				Concurrency: &constants.DefaultLocalConcurrency,
+				Backend:     "podman",
+			},
+		})
+		t.CheckNoError(err)
+		builder.ArtifactStore(build.NewArtifactStore())
+		res, err := builder.Build(context.Background(), ioutil.Discard, tag.ImageTags(map[string]string{"gcr.io/test/image": "gcr.io/test/image:tag"}), []*latest.Artifact{{
+			ImageName: "gcr.io/test/image",
+			Workspace: ".",
+			ArtifactType: latest.ArtifactType{
+				DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			},
+		}})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "gcr.io/test/image", Tag: "gcr.io/test/image:abc"}}, res)
+		t.CheckDeepEqual(2, len(fakeCmd.commands))
+		t.CheckDeepEqual("podman tag sha256:abc gcr.io/test/image:abc", fakeCmd.commands[1])
+		t.CheckDeepEqual(0, len(builder.builtImages))
+	})
+}
+
 type dummyLocalDaemon struct {
 	docker.LocalDaemon
 }
@@ -336,6 +398,7 @@ func TestGetArtifactBuilder(t *testing.T) {
 	tests := []struct {
 		description string
 		artifact    *latest.Artifact
+		backend     string
 		expected    string
 		shouldErr   bool
 	}{
@@ -389,6 +452,50 @@ func TestGetArtifactBuilder(t *testing.T) {
 			},
 			expected: "custom",
 		},
+		{
+			description: "buildkit backend",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{},
+				},
+			},
+			backend:  "buildkit",
+			expected: "buildkit",
+		},
+		{
+			description: "podman backend",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{},
+				},
+			},
+			backend:  "podman",
+			expected: "podman",
+		},
+		{
+			description: "buildah backend",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{},
+				},
+			},
+			backend:  "buildah",
+			expected: "podman",
+		},
+		{
+			description: "unknown backend",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{},
+				},
+			},
+			backend:   "unknown",
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -402,13 +509,14 @@ func TestGetArtifactBuilder(t *testing.T) {
 			b, err := NewBuilder(&mockConfig{
 				local: latest.LocalBuild{
 					Concurrency: &constants.DefaultLocalConcurrency,
+					Backend:     test.backend,
 				},
 			})
 			t.CheckNoError(err)
 			b.ArtifactStore(build.NewArtifactStore())
 
 			builder, err := newPerArtifactBuilder(b, test.artifact)
-			t.CheckNoError(err)
+			t.CheckError(test.shouldErr, err)
 
 			switch builder.(type) {
 			case *dockerbuilder.Builder:
@@ -421,6 +529,10 @@ func TestGetArtifactBuilder(t *testing.T) {
 				t.CheckDeepEqual(test.expected, "custom")
 			case *jib.Builder:
 				t.CheckDeepEqual(test.expected, "jib")
+			case *buildkit.Builder:
+				t.CheckDeepEqual(test.expected, "buildkit")
+			case *podman.Builder:
+				t.CheckDeepEqual(test.expected, "podman")
 			}
 		})
 	}
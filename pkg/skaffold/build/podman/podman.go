@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podman
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Build builds a `docker` artifact with `podman build` or `buildah bud`.
+// It returns the digest of the pushed image or the local image ID.
+func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	dockerfile, err := docker.NormalizeDockerfilePath(a.Workspace, a.DockerArtifact.DockerfilePath)
+	if err != nil {
+		return "", fmt.Errorf("normalizing dockerfile path: %w", err)
+	}
+	if _, err := os.Stat(dockerfile); os.IsNotExist(err) {
+		return "", fmt.Errorf("dockerfile not found for %q: %w", a.ImageName, err)
+	}
+
+	if len(a.Platforms) > 1 {
+		return "", fmt.Errorf("building %q for multiple platforms is not supported with %s", a.ImageName, b.tool)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-"+b.tool)
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	iidFile := filepath.Join(tmpDir, "iid")
+	args, err := b.buildArgs(a, dockerfile, tag, iidFile)
+	if err != nil {
+		return "", err
+	}
+	if err := b.run(ctx, out, args); err != nil {
+		return "", fmt.Errorf("running %s build: %w", b.tool, err)
+	}
+
+	if !b.pushImages {
+		return readID(iidFile)
+	}
+
+	digestFile := filepath.Join(tmpDir, "digest")
+	if err := b.run(ctx, out, []string{"push", "--digestfile", digestFile, tag}); err != nil {
+		return "", fmt.Errorf("running %s push: %w", b.tool, err)
+	}
+	return readID(digestFile)
+}
+
+// TagWithImageID tags an image built with podman or buildah with a unique tag made of its image ID.
+// Unlike build.TagWithImageID, this doesn't need a Docker daemon: the image stays in the tool's storage.
+func TagWithImageID(ctx context.Context, out io.Writer, tool, tag, imageID string) (string, error) {
+	parsed, err := docker.ParseReference(tag)
+	if err != nil {
+		return "", err
+	}
+
+	uniqueTag := parsed.BaseName + ":" + strings.TrimPrefix(imageID, "sha256:")
+	if err := Tag(ctx, out, tool, imageID, uniqueTag); err != nil {
+		return "", err
+	}
+	return uniqueTag, nil
+}
+
+// Tag adds a tag to an image in the podman or buildah storage.
+func Tag(ctx context.Context, out io.Writer, tool, image, tag string) error {
+	b := &Builder{tool: tool}
+	if err := b.run(ctx, out, []string{"tag", image, tag}); err != nil {
+		return fmt.Errorf("running %s tag: %w", tool, err)
+	}
+	return nil
+}
+
+// ImageID returns the ID of an image in the podman or buildah storage.
+// An image that can't be inspected, most likely because it doesn't exist, has an empty ID.
+func ImageID(ctx context.Context, tool, ref string) string {
+	format := "{{.Id}}"
+	if tool == Buildah {
+		format = "{{.FromImageID}}"
+	}
+
+	cmd := exec.CommandContext(ctx, tool, "inspect", "--type", "image", "--format", format, ref)
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		logrus.Debugf("Unable to inspect %s with %s: %v", ref, tool, err)
+		return ""
+	}
+
+	id := strings.TrimSpace(string(out))
+	if id == "" || strings.HasPrefix(id, "sha256:") {
+		return id
+	}
+	return "sha256:" + id
+}
+
+// Save writes an image from the podman or buildah storage to a tarball.
+func Save(ctx context.Context, tool, tag, path string) error {
+	cmd := exec.CommandContext(ctx, tool, "save", "--output", path, tag)
+	if out, err := util.RunCmdOut(cmd); err != nil {
+		return fmt.Errorf("running %s save: %w, %s", tool, err, out)
+	}
+	return nil
+}
+
+func (b *Builder) buildArgs(a *latest.Artifact, dockerfile, tag, iidFile string) ([]string, error) {
+	args := []string{"build"}
+	if b.tool == Buildah {
+		args = []string{"bud"}
+	}
+	args = append(args, "--file", dockerfile, "-t", tag, "--iidfile", iidFile)
+
+	buildArgs, err := docker.EvalBuildArgs(b.cfg.Mode(), a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a.DockerArtifact, buildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s build args: %w", b.tool, err)
+	}
+	args = append(args, cliArgs...)
+
+	if len(a.Platforms) == 1 {
+		args = append(args, "--platform", a.Platforms[0])
+	}
+
+	return append(args, a.Workspace), nil
+}
+
+func (b *Builder) run(ctx context.Context, out io.Writer, args []string) error {
+	cmd := exec.CommandContext(ctx, b.tool, args...)
+	cmd.Env = util.OSEnviron()
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	return util.RunCmd(cmd)
+}
+
+func readID(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
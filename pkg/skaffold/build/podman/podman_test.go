@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podman
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		tool        string
+		artifact    *latest.DockerArtifact
+		platforms   []string
+		expected    []string
+	}{
+		{
+			description: "podman",
+			tool:        Podman,
+			artifact:    &latest.DockerArtifact{},
+			expected:    []string{"build", "--file", "ws/Dockerfile", "-t", "img:tag", "--iidfile", "iid", "ws"},
+		},
+		{
+			description: "buildah",
+			tool:        Buildah,
+			artifact:    &latest.DockerArtifact{},
+			expected:    []string{"bud", "--file", "ws/Dockerfile", "-t", "img:tag", "--iidfile", "iid", "ws"},
+		},
+		{
+			description: "docker options",
+			tool:        Podman,
+			artifact: &latest.DockerArtifact{
+				BuildArgs: map[string]*string{"A": util.StringPtr("1")},
+				Target:    "prod",
+				CacheFrom: []string{"cache"},
+				NoCache:   true,
+			},
+			platforms: []string{"linux/arm64"},
+			expected:  []string{"build", "--file", "ws/Dockerfile", "-t", "img:tag", "--iidfile", "iid", "--build-arg", "A=1", "--cache-from", "cache", "--target", "prod", "--no-cache", "--platform", "linux/arm64", "ws"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			b := NewArtifactBuilder(test.tool, &mockConfig{}, false, nil)
+			artifact := &latest.Artifact{
+				Workspace: "ws",
+				Platforms: test.platforms,
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: test.artifact,
+				},
+			}
+
+			args, err := b.buildArgs(artifact, "ws/Dockerfile", "img:tag", "iid")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, args)
+		})
+	}
+}
+
+func TestBuildMultiplePlatforms(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().Touch("Dockerfile").Chdir()
+		b := NewArtifactBuilder(Podman, &mockConfig{}, true, nil)
+		artifact := &latest.Artifact{
+			ImageName: "img",
+			Workspace: ".",
+			Platforms: []string{"linux/amd64", "linux/arm64"},
+			ArtifactType: latest.ArtifactType{
+				DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			},
+		}
+
+		_, err := b.Build(context.Background(), ioutil.Discard, artifact, "img:tag")
+
+		t.CheckErrorContains("multiple platforms", err)
+	})
+}
+
+func TestTagWithImageID(t *testing.T) {
+	tests := []struct {
+		description string
+		tool        string
+		command     string
+	}{
+		{
+			description: "podman",
+			tool:        Podman,
+			command:     "podman tag sha256:abc gcr.io/test/image:abc",
+		},
+		{
+			description: "buildah",
+			tool:        Buildah,
+			command:     "buildah tag sha256:abc gcr.io/test/image:abc",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(test.command))
+
+			uniqueTag, err := TagWithImageID(context.Background(), ioutil.Discard, test.tool, "gcr.io/test/image:tag", "sha256:abc")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual("gcr.io/test/image:abc", uniqueTag)
+		})
+	}
+}
+
+func TestImageID(t *testing.T) {
+	tests := []struct {
+		description string
+		tool        string
+		commands    util.Command
+		expected    string
+	}{
+		{
+			description: "podman",
+			tool:        Podman,
+			commands:    testutil.CmdRunOut("podman inspect --type image --format {{.Id}} gcr.io/test/image:tag", "abc\n"),
+			expected:    "sha256:abc",
+		},
+		{
+			description: "buildah",
+			tool:        Buildah,
+			commands:    testutil.CmdRunOut("buildah inspect --type image --format {{.FromImageID}} gcr.io/test/image:tag", "abc\n"),
+			expected:    "sha256:abc",
+		},
+		{
+			description: "image not found",
+			tool:        Podman,
+			commands:    testutil.CmdRunOutErr("podman inspect --type image --format {{.Id}} gcr.io/test/image:tag", "", errors.New("image not known")),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			imageID := ImageID(context.Background(), test.tool, "gcr.io/test/image:tag")
+
+			t.CheckDeepEqual(test.expected, imageID)
+		})
+	}
+}
+
+type mockConfig struct {
+	docker.Config
+	mode config.RunMode
+}
+
+func (c *mockConfig) Mode() config.RunMode { return c.mode }
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podman
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	// Podman builds images with `podman build`.
+	Podman = "podman"
+	// Buildah builds images with `buildah bud`.
+	Buildah = "buildah"
+)
+
+// Builder is an artifact builder that builds `docker` artifacts with Podman or Buildah
+type Builder struct {
+	tool       string
+	cfg        docker.Config
+	pushImages bool
+	artifacts  docker.ArtifactResolver
+}
+
+// NewArtifactBuilder returns a new podman artifact builder. tool is either `podman` or `buildah`.
+func NewArtifactBuilder(tool string, cfg docker.Config, pushImages bool, r docker.ArtifactResolver) *Builder {
+	return &Builder{
+		tool:       tool,
+		cfg:        cfg,
+		pushImages: pushImages,
+		artifacts:  r,
+	}
+}
+
+// Tool returns `podman` or `buildah` if the artifact is built without a Docker daemon,
+// and an empty string otherwise.
+func Tool(b latest.BuildConfig, a *latest.Artifact) string {
+	if a.DockerArtifact == nil || b.LocalBuild == nil {
+		return ""
+	}
+	switch b.LocalBuild.Backend {
+	case Podman, Buildah:
+		return b.LocalBuild.Backend
+	default:
+		return ""
+	}
+}
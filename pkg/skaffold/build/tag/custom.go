@@ -17,7 +17,10 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 type CustomTag struct {
@@ -25,7 +28,7 @@ type CustomTag struct {
 }
 
 // GenerateTag generates a tag using the custom tag.
-func (t *CustomTag) GenerateTag(_, _ string) (string, error) {
+func (t *CustomTag) GenerateTag(context.Context, latest.Artifact) (string, error) {
 	tag := t.Tag
 	if tag == "" {
 		return "", errors.New("custom tag not provided")
@@ -18,10 +18,13 @@ package tag
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"text/template"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 // customTemplateTagger implements Tagger
@@ -44,8 +47,8 @@ func NewCustomTemplateTagger(t string, components map[string]Tagger) (Tagger, er
 }
 
 // GenerateTag generates a tag from a template referencing tagging strategies.
-func (t *customTemplateTagger) GenerateTag(workingDir, imageName string) (string, error) {
-	customMap, err := t.EvaluateComponents(workingDir, imageName)
+func (t *customTemplateTagger) GenerateTag(ctx context.Context, image latest.Artifact) (string, error) {
+	customMap, err := t.EvaluateComponents(ctx, image)
 	if err != nil {
 		return "", err
 	}
@@ -60,14 +63,14 @@ func (t *customTemplateTagger) GenerateTag(workingDir, imageName string) (string
 }
 
 // EvaluateComponents creates a custom mapping of component names to their tagger string representation.
-func (t *customTemplateTagger) EvaluateComponents(workingDir, imageName string) (map[string]string, error) {
+func (t *customTemplateTagger) EvaluateComponents(ctx context.Context, image latest.Artifact) (map[string]string, error) {
 	customMap := map[string]string{}
 
 	gitTagger, _ := NewGitCommit("", "", false)
 	dateTimeTagger := NewDateTimeTagger("", "")
 
 	for k, v := range map[string]Tagger{"GIT": gitTagger, "DATE": dateTimeTagger, "SHA": &ChecksumTagger{}} {
-		tag, _ := v.GenerateTag(workingDir, imageName)
+		tag, _ := v.GenerateTag(ctx, image)
 		customMap[k] = tag
 	}
 
@@ -75,7 +78,7 @@ func (t *customTemplateTagger) EvaluateComponents(workingDir, imageName string)
 		if _, ok := v.(*customTemplateTagger); ok {
 			return nil, fmt.Errorf("invalid component specified in custom template: %v", v)
 		}
-		tag, err := v.GenerateTag(workingDir, imageName)
+		tag, err := v.GenerateTag(ctx, image)
 		if err != nil {
 			return nil, fmt.Errorf("evaluating custom template component: %w", err)
 		}
@@ -17,9 +17,11 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -103,7 +105,7 @@ func TestTagTemplate_GenerateTag(t *testing.T) {
 
 			t.CheckNoError(err)
 
-			tag, err := c.GenerateTag(".", "test")
+			tag, err := c.GenerateTag(context.Background(), latest.Artifact{ImageName: "test"})
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
 		})
@@ -17,8 +17,10 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -43,7 +45,7 @@ func TestCustomTag_GenerateTag(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		tag, err := test.c.GenerateTag(".", "test")
+		tag, err := test.c.GenerateTag(context.Background(), latest.Artifact{ImageName: "test"})
 		testutil.CheckErrorAndDeepEqual(t, test.shouldErr, err, test.expected, tag)
 	}
 }
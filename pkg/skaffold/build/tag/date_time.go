@@ -17,10 +17,13 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"4d63.com/tz"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 const tagTime = "2006-01-02_15-04-05.999_MST"
@@ -43,7 +46,7 @@ func NewDateTimeTagger(format, timezone string) Tagger {
 }
 
 // GenerateTag generates a tag using the current timestamp.
-func (t *dateTimeTagger) GenerateTag(_, _ string) (string, error) {
+func (t *dateTimeTagger) GenerateTag(context.Context, latest.Artifact) (string, error) {
 	format := tagTime
 	if len(t.Format) > 0 {
 		format = t.Format
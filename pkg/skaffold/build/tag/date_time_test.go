@@ -17,9 +17,11 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -68,7 +70,7 @@ func TestDateTime_GenerateTag(t *testing.T) {
 				timeFn:   func() time.Time { return test.buildTime },
 			}
 
-			tag, err := c.GenerateTag(".", "test")
+			tag, err := c.GenerateTag(context.Background(), latest.Artifact{ImageName: "test"})
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.want, tag)
 		})
@@ -17,11 +17,13 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"text/template"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -43,10 +45,10 @@ func NewEnvTemplateTagger(t string) (Tagger, error) {
 }
 
 // GenerateTag generates a tag from a template referencing environment variables.
-func (t *envTemplateTagger) GenerateTag(_, imageName string) (string, error) {
+func (t *envTemplateTagger) GenerateTag(_ context.Context, image latest.Artifact) (string, error) {
 	// missingkey=error throws error when map is indexed with an undefined key
 	tag, err := util.ExecuteEnvTemplate(t.Template.Option("missingkey=error"), map[string]string{
-		"IMAGE_NAME":  imageName,
+		"IMAGE_NAME":  image.ImageName,
 		"DIGEST":      "_DEPRECATED_DIGEST_",
 		"DIGEST_ALGO": "_DEPRECATED_DIGEST_ALGO_",
 		"DIGEST_HEX":  "_DEPRECATED_DIGEST_HEX_",
@@ -19,6 +19,7 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -30,6 +31,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -394,7 +396,7 @@ func TestGitCommit_GenerateTag(t *testing.T) {
 				tagger, err := NewGitCommit("", variant, test.ignoreChanges)
 				t.CheckNoError(err)
 
-				tag, err := tagger.GenerateTag(workspace, "test")
+				tag, err := tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 
 				t.CheckErrorAndDeepEqual(test.shouldErr, err, expectedTag, tag)
 			}
@@ -453,7 +455,7 @@ func TestGitCommit_GenerateFullyQualifiedImageName(t *testing.T) {
 				tagger, err := NewGitCommit("", variant, false)
 				t.CheckNoError(err)
 
-				tag, err := GenerateFullyQualifiedImageName(tagger, workspace, "test")
+				tag, err := GenerateFullyQualifiedImageName(context.Background(), tagger, latest.Artifact{ImageName: "test", Workspace: workspace})
 
 				t.CheckErrorAndDeepEqual(test.shouldErr, err, expectedTag, tag)
 			}
@@ -506,7 +508,7 @@ func TestGitCommit_CustomTemplate(t *testing.T) {
 
 			t.CheckNoError(err)
 
-			tag, err := c.GenerateTag(workspace, "test")
+			tag, err := c.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, tag)
@@ -522,30 +524,30 @@ func TestGitCommitSubDirectory(t *testing.T) {
 
 		tagger, err := NewGitCommit("", "Tags", false)
 		t.CheckNoError(err)
-		tag, err := tagger.GenerateTag(workspace, "test")
+		tag, err := tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a6", tag)
 
 		tagger, err = NewGitCommit("", "CommitSha", false)
 		t.CheckNoError(err)
-		tag, err = tagger.GenerateTag(workspace, "test")
+		tag, err = tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a69335a6daa51bd89cc1bf30bd31df228ba", tag)
 
 		tagger, err = NewGitCommit("", "AbbrevCommitSha", false)
 		t.CheckNoError(err)
-		tag, err = tagger.GenerateTag(workspace, "test")
+		tag, err = tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckNoError(err)
 		t.CheckDeepEqual("a7b32a6", tag)
 
 		tagger, err = NewGitCommit("", "TreeSha", false)
 		t.CheckNoError(err)
-		_, err = tagger.GenerateTag(workspace, "test")
+		_, err = tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckErrorAndDeepEqual(true, err, "a7b32a6", tag)
 
 		tagger, err = NewGitCommit("", "AbbrevTreeSha", false)
 		t.CheckNoError(err)
-		_, err = tagger.GenerateTag(workspace, "test")
+		_, err = tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckErrorAndDeepEqual(true, err, "a7b32a6", tag)
 	})
 }
@@ -558,13 +560,13 @@ func TestPrefix(t *testing.T) {
 
 		tagger, err := NewGitCommit("tag-", "Tags", false)
 		t.CheckNoError(err)
-		tag, err := tagger.GenerateTag(workspace, "test")
+		tag, err := tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckNoError(err)
 		t.CheckDeepEqual("tag-a7b32a6", tag)
 
 		tagger, err = NewGitCommit("commit-", "CommitSha", false)
 		t.CheckNoError(err)
-		tag, err = tagger.GenerateTag(workspace, "test")
+		tag, err = tagger.GenerateTag(context.Background(), latest.Artifact{ImageName: "test", Workspace: workspace})
 		t.CheckNoError(err)
 		t.CheckDeepEqual("commit-a7b32a69335a6daa51bd89cc1bf30bd31df228ba", tag)
 	})
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// InputDigester computes the digest of an artifact's inputs: its source files, its
+// configuration and the digests of its required artifacts.
+type InputDigester func(ctx context.Context, a *latest.Artifact) (string, error)
+
+// inputDigestTagger tags an image by the digest of its inputs, so that identical sources get identical tags.
+// inputDigestTagger implements Tagger
+type inputDigestTagger struct {
+	digest InputDigester
+}
+
+// NewInputDigestTagger creates a tagger that uses the given digester.
+func NewInputDigestTagger(digest InputDigester) Tagger {
+	return &inputDigestTagger{
+		digest: digest,
+	}
+}
+
+// GenerateTag generates a tag from the digest of the artifact's inputs.
+func (t *inputDigestTagger) GenerateTag(ctx context.Context, image latest.Artifact) (string, error) {
+	if t.digest == nil {
+		return "", errors.New("no input digester")
+	}
+
+	digest, err := t.digest(ctx, &image)
+	if err != nil {
+		return "", fmt.Errorf("computing input digest: %w", err)
+	}
+
+	return digest, nil
+}
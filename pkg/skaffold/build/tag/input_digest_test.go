@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInputDigest_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description string
+		digest      InputDigester
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "digest",
+			digest: func(_ context.Context, a *latest.Artifact) (string, error) {
+				return "digest-of-" + a.ImageName, nil
+			},
+			expected: "digest-of-test",
+		},
+		{
+			description: "digest error",
+			digest: func(context.Context, *latest.Artifact) (string, error) {
+				return "", errors.New("unable to list dependencies")
+			},
+			shouldErr: true,
+		},
+		{
+			description: "no digester",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			c := NewInputDigestTagger(test.digest)
+
+			tag, err := c.GenerateTag(context.Background(), latest.Artifact{ImageName: "test"})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
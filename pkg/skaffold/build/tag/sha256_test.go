@@ -17,29 +17,31 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
 func TestSha256_GenerateTag(t *testing.T) {
 	c := &ChecksumTagger{}
 
-	tag, err := c.GenerateTag(".", "img:tag")
+	tag, err := c.GenerateTag(context.Background(), latest.Artifact{ImageName: "img:tag"})
 	testutil.CheckErrorAndDeepEqual(t, false, err, "", tag)
 
-	tag, err = c.GenerateTag(".", "img")
+	tag, err = c.GenerateTag(context.Background(), latest.Artifact{ImageName: "img"})
 	testutil.CheckErrorAndDeepEqual(t, false, err, "latest", tag)
 
-	tag, err = c.GenerateTag(".", "registry.example.com:8080/img:tag")
+	tag, err = c.GenerateTag(context.Background(), latest.Artifact{ImageName: "registry.example.com:8080/img:tag"})
 	testutil.CheckErrorAndDeepEqual(t, false, err, "", tag)
 
-	tag, err = c.GenerateTag(".", "registry.example.com:8080/img")
+	tag, err = c.GenerateTag(context.Background(), latest.Artifact{ImageName: "registry.example.com:8080/img"})
 	testutil.CheckErrorAndDeepEqual(t, false, err, "latest", tag)
 
-	tag, err = c.GenerateTag(".", "registry.example.com/img")
+	tag, err = c.GenerateTag(context.Background(), latest.Artifact{ImageName: "registry.example.com/img"})
 	testutil.CheckErrorAndDeepEqual(t, false, err, "latest", tag)
 
-	tag, err = c.GenerateTag(".", "registry.example.com:8080:garbage")
+	tag, err = c.GenerateTag(context.Background(), latest.Artifact{ImageName: "registry.example.com:8080:garbage"})
 	testutil.CheckErrorAndDeepEqual(t, true, err, "", tag)
 }
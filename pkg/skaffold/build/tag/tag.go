@@ -17,9 +17,11 @@ limitations under the License.
 package tag
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
@@ -29,18 +31,20 @@ type ImageTags map[string]string
 // Tagger is an interface for tag strategies to be implemented against
 type Tagger interface {
 	// GenerateTag generates a tag for an artifact.
-	GenerateTag(workingDir, imageName string) (string, error)
+	GenerateTag(ctx context.Context, image latest.Artifact) (string, error)
 }
 
 const DeprecatedImageName = "_DEPRECATED_IMAGE_NAME_"
 
 // GenerateFullyQualifiedImageName resolves the fully qualified image name for an artifact.
-// The workingDir is the root directory of the artifact with respect to the Skaffold root,
-// and imageName is the base name of the image.
-func GenerateFullyQualifiedImageName(t Tagger, workingDir, imageName string) (string, error) {
+// The image name is the artifact's image name, tagged by the tagger.
+func GenerateFullyQualifiedImageName(ctx context.Context, t Tagger, image latest.Artifact) (string, error) {
+	imageName := image.ImageName
+
 	// Supporting the use of the deprecated {{.IMAGE_NAME}} in envTemplate
 	if v, ok := t.(*envTemplateTagger); ok {
-		tag, err := v.GenerateTag(workingDir, DeprecatedImageName)
+		image.ImageName = DeprecatedImageName
+		tag, err := v.GenerateTag(ctx, image)
 
 		if err != nil {
 			return "", fmt.Errorf("generating envTemplate tag: %w", err)
@@ -55,7 +59,7 @@ func GenerateFullyQualifiedImageName(t Tagger, workingDir, imageName string) (st
 		return fmt.Sprintf("%s:%s", imageName, tag), nil
 	}
 
-	tag, err := t.GenerateTag(workingDir, imageName)
+	tag, err := t.GenerateTag(ctx, image)
 	if err != nil {
 		return "", fmt.Errorf("generating tag: %w", err)
 	}
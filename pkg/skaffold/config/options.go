@@ -28,6 +28,7 @@ import (
 type PortForwardOptions struct {
 	Enabled     bool
 	ForwardPods bool
+	Mode        string
 }
 
 // WaitForDeletions configures the wait for pending deletions.
@@ -43,16 +44,22 @@ type SkaffoldOptions struct {
 	ConfigurationFile     string
 	GlobalConfig          string
 	EventLogFile          string
+	OTLPEndpoint          string
 	Cleanup               bool
 	Notification          bool
 	Tail                  bool
+	LogFormat             string
+	LogPrevious           bool
 	SkipTests             bool
 	CacheArtifacts        bool
+	ExplainCache          bool
 	EnableRPC             bool
 	Force                 bool
 	NoPrune               bool
 	NoPruneChildren       bool
 	StatusCheck           bool
+	RollbackOnFailure     bool
+	SyncMode              string
 	AutoBuild             bool
 	AutoSync              bool
 	AutoDeploy            bool
@@ -73,6 +80,7 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	RemoteCache        string
 	Trigger            string
 	KubeContext        string
 	KubeConfig         string
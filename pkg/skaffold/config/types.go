@@ -55,6 +55,7 @@ func (m Muted) MuteBuild() bool       { return m.mute("build") }
 func (m Muted) MuteTest() bool        { return m.mute("test") }
 func (m Muted) MuteStatusCheck() bool { return m.mute("status-check") }
 func (m Muted) MuteDeploy() bool      { return m.mute("deploy") }
+func (m Muted) MuteSync() bool        { return m.mute("sync") }
 func (m Muted) mute(phase string) bool {
 	return util.StrSliceContains(m.Phases, phase) || util.StrSliceContains(m.Phases, "all")
 }
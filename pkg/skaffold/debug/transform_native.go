@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type nativeTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, nativeTransformer{})
+}
+
+// gdbserverSpec captures the useful gdbserver and lldb-server options
+type gdbserverSpec struct {
+	// server is either `gdbserver` or `lldb-server`
+	server string
+	host   string
+	port   int32
+}
+
+// isLaunchingGdbserver determines if the arguments seems to be invoking gdbserver or lldb-server
+func isLaunchingGdbserver(args []string) bool {
+	return len(args) > 0 && (path.Base(args[0]) == "gdbserver" || path.Base(args[0]) == "lldb-server")
+}
+
+// IsApplicable only recognizes native binaries that are explicitly launched with
+// `gdbserver` or `lldb-server gdbserver`, as skaffold doesn't provide these servers.
+func (t nativeTransformer) IsApplicable(config imageConfiguration) bool {
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return extractGdbserverSpec(config.entrypoint) != nil
+	}
+	return extractGdbserverSpec(config.arguments) != nil
+}
+
+// Apply configures a container definition for native binaries (Rust, C, C++) launched with
+// `gdbserver` or `lldb-server gdbserver`: the existing listening port is exposed.
+// Returns a simple map describing the debug configuration details.
+func (t nativeTransformer) Apply(container *v1.Container, config imageConfiguration, _ portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for native debugging", container.Name)
+
+	spec := retrieveGdbserverSpec(config)
+	if spec == nil {
+		return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q is not launched with gdbserver or lldb-server", container.Name)
+	}
+
+	container.Ports = exposePort(container.Ports, "gdbserver", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "native",
+		Ports:   map[string]uint32{"gdbserver": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveGdbserverSpec(config imageConfiguration) *gdbserverSpec {
+	if spec := extractGdbserverSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractGdbserverSpec(config.arguments); spec != nil {
+		return spec
+	}
+	return nil
+}
+
+// extractGdbserverSpec extracts the listening address from `gdbserver [options] [host]:port prog args`
+// and `lldb-server gdbserver [options] [host]:port -- prog args`.
+func extractGdbserverSpec(args []string) *gdbserverSpec {
+	if !isLaunchingGdbserver(args) {
+		return nil
+	}
+	spec := gdbserverSpec{server: path.Base(args[0])}
+	options := args[1:]
+	if spec.server == "lldb-server" {
+		// lldb-server is a multi-tool: `gdbserver` (or `g`) is the only mode we support
+		if len(options) == 0 || (options[0] != "gdbserver" && options[0] != "g") {
+			return nil
+		}
+		options = options[1:]
+	}
+	for _, arg := range options {
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+		// the first non-option is the communication address
+		split := strings.Split(arg, ":")
+		if len(split) < 2 {
+			return nil
+		}
+		port, err := strconv.ParseInt(split[len(split)-1], 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid %s address %q: %s\n", spec.server, arg, err)
+			return nil
+		}
+		spec.host = strings.Join(split[:len(split)-1], ":")
+		spec.port = int32(port)
+		return &spec
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractGdbserverSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *gdbserverSpec
+	}{
+		{nil, nil},
+		{[]string{"/app/server"}, nil},
+		{[]string{"gdbserver", ":2345", "/app/server"}, &gdbserverSpec{server: "gdbserver", port: 2345}},
+		{[]string{"/usr/bin/gdbserver", "--once", "0.0.0.0:9000", "/app/server"}, &gdbserverSpec{server: "gdbserver", host: "0.0.0.0", port: 9000}},
+		{[]string{"gdbserver", "--multi", "localhost:9000"}, &gdbserverSpec{server: "gdbserver", host: "localhost", port: 9000}},
+		{[]string{"gdbserver", "/app/server"}, nil},
+		{[]string{"gdbserver", ":foo", "/app/server"}, nil},
+		{[]string{"lldb-server", "gdbserver", "*:1234", "--", "/app/server"}, &gdbserverSpec{server: "lldb-server", host: "*", port: 1234}},
+		{[]string{"lldb-server", "g", "[::]:1234", "--", "/app/server"}, &gdbserverSpec{server: "lldb-server", host: "[::]", port: 1234}},
+		{[]string{"lldb-server", "platform", "--listen", "*:1234"}, nil},
+		{[]string{"lldb-server", "gdbserver", "--", "/app/server"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractGdbserverSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractGdbserverSpec(test.in), cmp.AllowUnexported(gdbserverSpec{}))
+			}
+		})
+	}
+}
+
+func TestNativeTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUST_BACKTRACE without gdbserver",
+			source:      imageConfiguration{env: map[string]string{"RUST_BACKTRACE": "1"}, entrypoint: []string{"/app"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint gdbserver",
+			source:      imageConfiguration{entrypoint: []string{"gdbserver", ":2345", "/app"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args lldb-server",
+			source:      imageConfiguration{arguments: []string{"/usr/bin/lldb-server", "gdbserver", "*:1234", "--", "/app"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"gdbserver", ":2345", "/app"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint binary",
+			source:      imageConfiguration{entrypoint: []string{"/app"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint binary, args gdbserver",
+			source:      imageConfiguration{entrypoint: []string{"/app"}, arguments: []string{"gdbserver", ":2345", "/app"}},
+			result:      false,
+		},
+		{
+			description: "lldb-server platform",
+			source:      imageConfiguration{entrypoint: []string{"lldb-server", "platform", "--listen", "*:1234"}},
+			result:      false,
+		},
+		{
+			description: "gdbserver in node image",
+			source:      imageConfiguration{env: map[string]string{"NODE_VERSION": "14.15.4"}, entrypoint: []string{"gdbserver", ":2345", "/app"}},
+			result:      true,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := nativeTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestNativeTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "empty",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{},
+			result:        v1.Container{},
+			shouldErr:     true,
+		},
+		{
+			description:   "not launched with gdbserver",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"/app", "--flag"}},
+			result:        v1.Container{},
+			shouldErr:     true,
+		},
+		{
+			description: "existing gdbserver in args",
+			containerSpec: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}},
+			},
+			configuration: imageConfiguration{arguments: []string{"gdbserver", ":2345", "/app"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}, {Name: "gdbserver", ContainerPort: 2345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "native", Ports: map[string]uint32{"gdbserver": 2345}},
+		},
+		{
+			description:   "existing lldb-server",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"lldb-server", "gdbserver", "*:9000", "--", "/app"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 9000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "native", Ports: map[string]uint32{"gdbserver": 9000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := nativeTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
+
+func TestTransformManifestNative(t *testing.T) {
+	tests := []struct {
+		description string
+		in          runtime.Object
+		transformed bool
+		out         runtime.Object
+	}{
+		{
+			"Pod with Rust container",
+			&v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"/app"},
+						Env:     []v1.EnvVar{{Name: "RUST_BACKTRACE", Value: "1"}},
+					}},
+				}},
+			false,
+			&v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"/app"},
+						Env:     []v1.EnvVar{{Name: "RUST_BACKTRACE", Value: "1"}},
+					}},
+				}},
+		},
+		{
+			"Pod with gdbserver container",
+			&v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"gdbserver", ":2345", "/app"},
+					}},
+				}},
+			true,
+			&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"native","ports":{"gdbserver":2345}}}`},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"gdbserver", ":2345", "/app"},
+						Ports:   []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 2345}},
+					}},
+				}},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			value := test.in.DeepCopyObject()
+
+			retriever := func(image string) (imageConfiguration, error) {
+				return imageConfiguration{}, nil
+			}
+			result := transformManifest(value, retriever, "HELPERS")
+
+			t.CheckDeepEqual(test.transformed, result)
+			t.CheckDeepEqual(test.out, value)
+		})
+	}
+}
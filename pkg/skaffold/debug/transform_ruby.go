@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+type rubyTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rubyTransformer{})
+}
+
+const (
+	// ruby-debug-ide's default port
+	defaultRdebugPort = 1234
+)
+
+// rdebugSpec captures the useful ruby-debug-ide options
+type rdebugSpec struct {
+	host string
+	port int32
+}
+
+// rubyLaunchers are the commands commonly used to launch a Ruby application
+var rubyLaunchers = []string{"ruby", "rails", "rackup", "rake", "puma", "unicorn", "bundle", "rdebug-ide"}
+
+// isLaunchingRuby determines if the arguments seems to be invoking ruby or a well-known ruby launcher
+func isLaunchingRuby(args []string) bool {
+	return len(args) > 0 && util.StrSliceContains(rubyLaunchers, path.Base(args[0]))
+}
+
+// isLaunchingRdebug determines if the arguments seems to be invoking rdebug-ide, possibly through `bundle exec`
+func isLaunchingRdebug(args []string) bool {
+	return rdebugIndex(args) >= 0
+}
+
+// rdebugIndex returns the index of the `rdebug-ide` command in the arguments, or -1 if not found
+func rdebugIndex(args []string) int {
+	switch {
+	case len(args) > 0 && path.Base(args[0]) == "rdebug-ide":
+		return 0
+	case isBundleExec(args) && len(args) > 2 && path.Base(args[2]) == "rdebug-ide":
+		return 2
+	}
+	return -1
+}
+
+// isBundleExec determines if the arguments are a `bundle exec` command-line
+func isBundleExec(args []string) bool {
+	return len(args) > 1 && path.Base(args[0]) == "bundle" && args[1] == "exec"
+}
+
+func (t rubyTransformer) IsApplicable(config imageConfiguration) bool {
+	// RUBY_VERSION and RUBY_MAJOR are defined in the Official Docker `ruby` image.
+	// We debug Ruby by wrapping the command-line with rdebug-ide, so a command-line is required.
+	hasCommandLine := len(config.arguments) > 0 || (len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint))
+	for _, v := range []string{"RUBY_VERSION", "RUBY_MAJOR"} {
+		if _, found := config.env[v]; found && hasCommandLine {
+			logrus.Infof("Artifact %q has Ruby runtime: has env %q", config.artifact, v)
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingRuby(config.entrypoint)
+	}
+	return isLaunchingRuby(config.arguments)
+}
+
+// Apply configures a container definition for Ruby with ruby-debug-ide.
+// The `ruby-debug-ide` and `debase` gems must be installed in the image.
+// Returns a simple map describing the debug configuration details.
+func (t rubyTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Ruby debugging", container.Name)
+
+	// try to find existing `rdebug-ide` command
+	spec := retrieveRdebugSpec(config)
+
+	if spec == nil {
+		spec = &rdebugSpec{host: "0.0.0.0", port: portAlloc(defaultRdebugPort)}
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+			container.Command = rewriteRubyCommandLine(config.entrypoint, *spec)
+
+		case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && len(config.arguments) > 0:
+			container.Args = rewriteRubyCommandLine(config.arguments, *spec)
+
+		default:
+			return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q has no command-line", container.Name)
+		}
+	}
+
+	container.Ports = exposePort(container.Ports, "rdebug", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "ruby",
+		Ports:   map[string]uint32{"rdebug": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveRdebugSpec(config imageConfiguration) *rdebugSpec {
+	if spec := extractRdebugSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractRdebugSpec(config.arguments); spec != nil {
+		return spec
+	}
+	return nil
+}
+
+func extractRdebugSpec(args []string) *rdebugSpec {
+	index := rdebugIndex(args)
+	if index < 0 {
+		return nil
+	}
+	// ruby-debug-ide's defaults
+	spec := rdebugSpec{host: "127.0.0.1", port: defaultRdebugPort}
+	args = args[index+1:]
+arguments:
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			break arguments
+		case (arg == "--host" || arg == "-h") && i < len(args)-1:
+			spec.host = args[i+1]
+		case strings.HasPrefix(arg, "--host="):
+			spec.host = strings.SplitN(arg, "=", 2)[1]
+		case (arg == "--port" || arg == "-p") && i < len(args)-1:
+			spec.port = parseRdebugPort(args[i+1])
+		case strings.HasPrefix(arg, "--port="):
+			spec.port = parseRdebugPort(strings.SplitN(arg, "=", 2)[1])
+		}
+	}
+	if spec.port < 0 {
+		return nil
+	}
+	return &spec
+}
+
+func parseRdebugPort(value string) int32 {
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		logrus.Errorf("Invalid rdebug-ide port %q: %s\n", value, err)
+		return -1
+	}
+	return int32(port)
+}
+
+// rewriteRubyCommandLine rewrites a ruby command-line to be launched by `rdebug-ide`.
+// `ruby [options] script args` is launched as `ruby [options] -S rdebug-ide ... -- script args` to preserve
+// the interpreter options, and `bundle exec cmd` as `bundle exec rdebug-ide ... -- cmd` to preserve the bundle.
+func rewriteRubyCommandLine(commandLine []string, spec rdebugSpec) []string {
+	switch {
+	case path.Base(commandLine[0]) == "ruby":
+		// find the script: the first argument that is not a ruby option
+		index := 1
+		for index < len(commandLine) && strings.HasPrefix(commandLine[index], "-") {
+			index++
+		}
+		rdebug := append([]string{"-S"}, spec.asArguments()...)
+		return util.StrSliceInsert(commandLine, index, append(rdebug, "--"))
+
+	case isBundleExec(commandLine):
+		return util.StrSliceInsert(commandLine, 2, append(spec.asArguments(), "--"))
+
+	default:
+		return append(append(spec.asArguments(), "--"), commandLine...)
+	}
+}
+
+func (spec rdebugSpec) asArguments() []string {
+	return []string{"rdebug-ide", "--host", spec.host, "--port", strconv.FormatInt(int64(spec.port), 10)}
+}
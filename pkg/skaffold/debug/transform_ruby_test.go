@@ -0,0 +1,248 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractRdebugSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *rdebugSpec
+	}{
+		{nil, nil},
+		{[]string{"ruby", "app.rb"}, nil},
+		{[]string{"rdebug-ide"}, &rdebugSpec{host: "127.0.0.1", port: 1234}},
+		{[]string{"rdebug-ide", "--port", "9000", "--", "app.rb"}, &rdebugSpec{host: "127.0.0.1", port: 9000}},
+		{[]string{"rdebug-ide", "-h", "0.0.0.0", "-p", "9000", "--", "app.rb"}, &rdebugSpec{host: "0.0.0.0", port: 9000}},
+		{[]string{"rdebug-ide", "--host=0.0.0.0", "--port=9000", "--", "app.rb"}, &rdebugSpec{host: "0.0.0.0", port: 9000}},
+		{[]string{"rdebug-ide", "--", "app.rb", "--port", "9000"}, &rdebugSpec{host: "127.0.0.1", port: 1234}},
+		{[]string{"bundle", "exec", "rdebug-ide", "--port", "9000", "--", "rails", "s"}, &rdebugSpec{host: "127.0.0.1", port: 9000}},
+		{[]string{"bundle", "exec", "rails", "s"}, nil},
+		{[]string{"rdebug-ide", "--port", "foo"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractRdebugSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractRdebugSpec(test.in), cmp.AllowUnexported(rdebugSpec{}))
+			}
+		})
+	}
+}
+
+func TestRewriteRubyCommandLine(t *testing.T) {
+	spec := rdebugSpec{host: "0.0.0.0", port: 1234}
+	tests := []struct {
+		in     []string
+		result []string
+	}{
+		{[]string{"ruby", "app.rb"}, []string{"ruby", "-S", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "app.rb"}},
+		{[]string{"/usr/local/bin/ruby", "-Ilib", "app.rb", "arg"}, []string{"/usr/local/bin/ruby", "-Ilib", "-S", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "app.rb", "arg"}},
+		{[]string{"bundle", "exec", "rails", "s"}, []string{"bundle", "exec", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "rails", "s"}},
+		{[]string{"bin/rails", "server"}, []string{"rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "bin/rails", "server"}},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			t.CheckDeepEqual(test.result, rewriteRubyCommandLine(test.in, spec))
+		})
+	}
+}
+
+func TestRubyTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUBY_VERSION",
+			source:      imageConfiguration{env: map[string]string{"RUBY_VERSION": "2.7"}, arguments: []string{"bin/server"}},
+			result:      true,
+		},
+		{
+			description: "RUBY_MAJOR without command-line",
+			source:      imageConfiguration{env: map[string]string{"RUBY_MAJOR": "2.7"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint ruby",
+			source:      imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint /usr/local/bin/ruby",
+			source:      imageConfiguration{entrypoint: []string{"/usr/local/bin/ruby", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args bundle exec",
+			source:      imageConfiguration{arguments: []string{"bundle", "exec", "puma"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args bin/rails",
+			source:      imageConfiguration{arguments: []string{"bin/rails", "server"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"rackup"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := rubyTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRubyTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "empty",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{},
+			result:        v1.Container{},
+			shouldErr:     true,
+		},
+		{
+			description:   "basic",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result: v1.Container{
+				Command: []string{"ruby", "-S", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "app.rb"},
+				Ports:   []v1.ContainerPort{{Name: "rdebug", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"rdebug": 1234}},
+		},
+		{
+			description: "existing port",
+			containerSpec: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}},
+			},
+			configuration: imageConfiguration{arguments: []string{"bundle", "exec", "rails", "s"}},
+			result: v1.Container{
+				Args:  []string{"bundle", "exec", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "rails", "s"},
+				Ports: []v1.ContainerPort{{Name: "http-server", ContainerPort: 8080}, {Name: "rdebug", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"rdebug": 1234}},
+		},
+		{
+			description:   "existing rdebug-ide",
+			containerSpec: v1.Container{},
+			configuration: imageConfiguration{entrypoint: []string{"rdebug-ide", "--host", "0.0.0.0", "--port", "9000", "--", "app.rb"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "rdebug", ContainerPort: 9000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"rdebug": 9000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := rubyTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
+
+func TestTransformManifestRuby(t *testing.T) {
+	tests := []struct {
+		description string
+		in          runtime.Object
+		transformed bool
+		out         runtime.Object
+	}{
+		{
+			"Pod with Ruby container",
+			&v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"bundle", "exec", "puma"},
+					}},
+				}},
+			true,
+			&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"ruby","ports":{"rdebug":1234}}}`},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:    "test",
+						Command: []string{"bundle", "exec", "rdebug-ide", "--host", "0.0.0.0", "--port", "1234", "--", "puma"},
+						Ports:   []v1.ContainerPort{{Name: "rdebug", ContainerPort: 1234}},
+					}},
+				}},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			value := test.in.DeepCopyObject()
+
+			retriever := func(image string) (imageConfiguration, error) {
+				return imageConfiguration{}, nil
+			}
+			result := transformManifest(value, retriever, "HELPERS")
+
+			t.CheckDeepEqual(test.transformed, result)
+			t.CheckDeepEqual(test.out, value)
+		})
+	}
+}
@@ -41,3 +41,17 @@ type Deployer interface {
 	// writes them to the given file path
 	Render(context.Context, io.Writer, []build.Artifact, bool, string) error
 }
+
+// Rollbacker is implemented by deployers that can restore the resources changed by their last deploy.
+type Rollbacker interface {
+	// Rollback restores the resources changed by the last call to Deploy to their previous version.
+	Rollback(context.Context, io.Writer) error
+}
+
+// Rollback restores the resources changed by the last deploy, if the deployer supports it.
+func Rollback(ctx context.Context, out io.Writer, d Deployer) error {
+	if r, ok := d.(Rollbacker); ok {
+		return r.Rollback(ctx, out)
+	}
+	return nil
+}
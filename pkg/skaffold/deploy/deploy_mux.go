@@ -67,6 +67,16 @@ func (m DeployerMux) Cleanup(ctx context.Context, w io.Writer) error {
 	return nil
 }
 
+// Rollback restores the resources of all the deployers, in the reverse order of deployment.
+func (m DeployerMux) Rollback(ctx context.Context, w io.Writer) error {
+	for i := len(m) - 1; i >= 0; i-- {
+		if err := Rollback(ctx, w, m[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m DeployerMux) Render(ctx context.Context, w io.Writer, as []build.Artifact, offline bool, filepath string) error {
 	resources, buf := []string{}, &bytes.Buffer{}
 	for _, deployer := range m {
@@ -27,8 +27,38 @@ import (
 const (
 	executableNotFound = "executable file not found"
 	notFound           = "%s not found"
+
+	// RolledBackExitCode is the exit code of skaffold when a deploy was rolled back.
+	RolledBackExitCode = 10
 )
 
+// RolledBackErr is returned when the deployed resources failed the status check
+// and were restored to their previous version.
+type RolledBackErr struct {
+	sErrors.ErrDef
+}
+
+// ExitCode lets the rollback be distinguished from other failures.
+func (e RolledBackErr) ExitCode() int { return RolledBackExitCode }
+
+// NewRolledBackErr wraps the status check error that caused a rollback.
+func NewRolledBackErr(err error) error {
+	return RolledBackErr{sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("%s. The previous version of the deployed resources was restored", err),
+			ErrCode: proto.StatusCode_DEPLOY_ROLLED_BACK,
+		})}
+}
+
+// RollbackErr represents an error while restoring the previous version of the deployed resources.
+func RollbackErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("rolling back: %s", err),
+			ErrCode: proto.StatusCode_DEPLOY_ROLLBACK_ERR,
+		})
+}
+
 // DebugHelperRetrieveErr is thrown when debug helpers could not be retrieved.
 // This error occurs in skaffold debug command when transforming the manifest before deploying.
 func DebugHelperRetrieveErr(err error) error {
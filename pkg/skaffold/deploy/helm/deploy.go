@@ -43,6 +43,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -85,6 +86,14 @@ type Deployer struct {
 	forceDeploy bool
 	enableDebug bool
 
+	// validator checks the manifests rendered by `skaffold render`.
+	// On deploy, the manifests are validated by the `skaffold filter` post-renderer.
+	validator *validate.Validator
+
+	// revisions are recorded before each deploy when rollback on failure is enabled
+	rollbackOnFailure bool
+	revisions         []releaseRevision
+
 	// bV is the helm binary version
 	bV semver.Version
 }
@@ -109,6 +118,9 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error
 		labels:      labels,
 		bV:          hv,
 		enableDebug: cfg.Mode() == config.RunModes.Debug,
+		validator:   validate.NewValidator(cfg.Pipeline().Deploy.Validate, cfg.GetWorkingDir()),
+
+		rollbackOnFailure: cfg.RollbackOnFailure(),
 	}, nil
 }
 
@@ -119,6 +131,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	var dRes []types.Artifact
 	nsMap := map[string]struct{}{}
 	valuesSet := map[string]bool{}
+	h.revisions = nil
 
 	// Deploy every release
 	for _, r := range h.Releases {
@@ -301,6 +314,16 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 		renderedManifests.Write(outBuffer.Bytes())
 	}
 
+	if h.validator != nil {
+		manifests, err := manifest.Load(bytes.NewReader(renderedManifests.Bytes()))
+		if err != nil {
+			return userErr("reading rendered manifests", err)
+		}
+		if err := h.validator.Validate(ctx, manifests, offline); err != nil {
+			return err
+		}
+	}
+
 	return manifest.Write(renderedManifests.String(), filepath, out)
 }
 
@@ -321,10 +344,15 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 	}
 
 	var installEnv []string
-	if h.enableDebug {
-		if h.bV.LT(helm31Version) {
-			return nil, fmt.Errorf("debug requires at least Helm 3.1 (current: %v)", h.bV)
-		}
+	if h.enableDebug && h.bV.LT(helm31Version) {
+		return nil, fmt.Errorf("debug requires at least Helm 3.1 (current: %v)", h.bV)
+	}
+	if h.validator != nil && h.bV.LT(helm31Version) {
+		return nil, fmt.Errorf("manifest validation requires at least Helm 3.1 (current: %v)", h.bV)
+	}
+	if h.bV.GTE(helm31Version) {
+		// rendered manifests are piped through `skaffold filter` to apply the same
+		// image, label and (when debugging) debug transforms as the other deployers
 		var binary string
 		if binary, err = osExecutable(); err != nil {
 			return nil, fmt.Errorf("cannot locate this Skaffold binary: %w", err)
@@ -341,7 +369,7 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 			defer cleanup()
 		}
 
-		cmdLine := h.generateSkaffoldDebugFilter(buildsFile)
+		cmdLine := h.generateSkaffoldFilter(buildsFile)
 
 		// need to include current environment, specifically for HOME to lookup ~/.kube/config
 		env := util.EnvSliceToMap(util.OSEnviron(), "=")
@@ -408,6 +436,12 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		return nil, userErr("release args", err)
 	}
 
+	if h.rollbackOnFailure {
+		if err := h.recordRevision(ctx, releaseName, opts.namespace, opts.upgrade); err != nil {
+			return nil, err
+		}
+	}
+
 	err = h.exec(ctx, out, r.UseHelmSecrets, installEnv, args...)
 	if err != nil {
 		return nil, userErr("install", err)
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+)
+
+// releaseRevision is the revision of a release before it was deployed.
+// A zero revision means that the release wasn't installed.
+type releaseRevision struct {
+	name      string
+	namespace string
+	revision  int
+}
+
+// recordRevision records the current revision of a release, before it is installed or upgraded.
+func (h *Deployer) recordRevision(ctx context.Context, releaseName, namespace string, installed bool) error {
+	rev := releaseRevision{name: releaseName, namespace: namespace}
+
+	if installed {
+		args := []string{"history", releaseName, "--max", "1", "-o", "json"}
+		if namespace != "" {
+			args = append(args, "--namespace", namespace)
+		}
+
+		var b bytes.Buffer
+		if err := h.exec(ctx, &b, false, nil, args...); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("getting revision of release %q: %w", releaseName, err))
+		}
+
+		var history []struct {
+			Revision int `json:"revision"`
+		}
+		if err := json.Unmarshal(b.Bytes(), &history); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("parsing history of release %q: %w", releaseName, err))
+		}
+		if len(history) > 0 {
+			rev.revision = history[len(history)-1].Revision
+		}
+	}
+
+	h.revisions = append(h.revisions, rev)
+	return nil
+}
+
+// Rollback restores the releases changed by the last deploy to their previous revision.
+// Releases that weren't installed before are uninstalled.
+func (h *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	for i := len(h.revisions) - 1; i >= 0; i-- {
+		r := h.revisions[i]
+
+		args := []string{"rollback", r.name, strconv.Itoa(r.revision)}
+		if r.revision == 0 {
+			args = []string{"uninstall", r.name}
+		}
+		if r.namespace != "" {
+			args = append(args, "--namespace", r.namespace)
+		}
+
+		if err := h.exec(ctx, out, false, nil, args...); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("restoring release %q: %w", r.name, err))
+		}
+	}
+	return nil
+}
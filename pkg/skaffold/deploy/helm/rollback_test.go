@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRollback(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunWithOutput("helm --kube-context kubecontext history skaffold-helm --max 1 -o json --namespace ns", `[{"revision": 3, "status": "deployed"}]`).
+			AndRun("helm --kube-context kubecontext uninstall other --namespace ns").
+			AndRun("helm --kube-context kubecontext rollback skaffold-helm 3 --namespace ns"))
+
+		h := &Deployer{HelmDeploy: &latest.HelmDeploy{}, kubeContext: "kubecontext"}
+		t.CheckNoError(h.recordRevision(context.Background(), "skaffold-helm", "ns", true))
+		t.CheckNoError(h.recordRevision(context.Background(), "other", "ns", false))
+		t.CheckDeepEqual([]releaseRevision{{name: "skaffold-helm", namespace: "ns", revision: 3}, {name: "other", namespace: "ns"}}, h.revisions, cmp.AllowUnexported(releaseRevision{}))
+
+		err := h.Rollback(context.Background(), ioutil.Discard)
+		t.CheckNoError(err)
+	})
+}
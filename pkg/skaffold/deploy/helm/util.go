@@ -140,11 +140,23 @@ func pairParamsToArtifacts(builds []build.Artifact, params map[string]string) (m
 	return paramToBuildResult, nil
 }
 
-func (h *Deployer) generateSkaffoldDebugFilter(buildsFile string) []string {
-	args := []string{"filter", "--debugging", "--kube-context", h.kubeContext}
+func (h *Deployer) generateSkaffoldFilter(buildsFile string) []string {
+	args := []string{"filter", "--kube-context", h.kubeContext}
+	if h.enableDebug {
+		args = append(args, "--debugging")
+	}
 	if len(buildsFile) > 0 {
 		args = append(args, "--build-artifacts", buildsFile)
 	}
+	// labels are applied by the filter so that they are also set on the pod templates
+	var keys []string
+	for k := range h.labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", k, h.labels[k]))
+	}
 	args = append(args, h.Flags.Global...)
 
 	if h.kubeConfig != "" {
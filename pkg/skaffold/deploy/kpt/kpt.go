@@ -36,8 +36,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -46,6 +48,7 @@ import (
 
 const (
 	inventoryTemplate = "inventory-template.yaml"
+	resourcesFile     = "resources.yaml"
 	kptHydrated       = ".kpt-hydrated"
 	tmpKustomizeDir   = ".kustomize"
 	kptFnAnnotation   = "config.kubernetes.io/function"
@@ -66,6 +69,9 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	globalConfig       string
+	rollbackOnFailure  bool
+	snapshot           *applyDirSnapshot
+	validator          *validate.Validator
 }
 
 // NewDeployer generates a new Deployer object contains the kptDeploy schema.
@@ -75,6 +81,8 @@ func NewDeployer(cfg types.Config, labels map[string]string) *Deployer {
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		labels:             labels,
 		globalConfig:       cfg.GlobalConfig(),
+		rollbackOnFailure:  cfg.RollbackOnFailure(),
+		validator:          validate.NewValidator(cfg.Pipeline().Deploy.Validate, cfg.GetWorkingDir()),
 	}
 }
 
@@ -148,6 +156,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, nil
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, false); err != nil {
+			return nil, err
+		}
+	}
+
 	namespaces, err := manifests.CollectNamespaces()
 	if err != nil {
 		event.DeployInfoEvent(fmt.Errorf("could not fetch deployed resource namespace. "+
@@ -159,7 +173,13 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, fmt.Errorf("getting applyDir: %w", err)
 	}
 
-	manifest.Write(manifests.String(), filepath.Join(applyDir, "resources.yaml"), out)
+	if k.rollbackOnFailure {
+		if k.snapshot, err = snapshotApplyDir(applyDir); err != nil {
+			return nil, err
+		}
+	}
+
+	manifest.Write(manifests.String(), filepath.Join(applyDir, resourcesFile), out)
 	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
 	cmd.Stdout = out
 	cmd.Stderr = out
@@ -226,7 +246,7 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 }
 
 // Render hydrates manifests using both kustomization and kpt functions.
-func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Artifact, _ bool, filepath string) error {
+func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Artifact, offline bool, filepath string) error {
 	if err := sanityCheck(k.Dir, out); err != nil {
 		return err
 	}
@@ -240,6 +260,12 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 		return err
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, offline); err != nil {
+			return err
+		}
+	}
+
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
@@ -251,7 +277,7 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 	var err error
 	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(k.globalConfig)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving debug helpers registry: %w", err)
+		return nil, deployerr.DebugHelperRetrieveErr(fmt.Errorf("retrieving debug helpers registry: %w", err))
 	}
 
 	var buf []byte
@@ -59,6 +59,7 @@ func TestKpt_Deploy(t *testing.T) {
 		description    string
 		builds         []build.Artifact
 		kpt            latest.KptDeploy
+		validate       *latest.ValidateConfig
 		kustomizations map[string]string
 		commands       util.Command
 		expected       []string
@@ -211,6 +212,29 @@ func TestKpt_Deploy(t *testing.T) {
 				AndRunOut("kpt fn run --dry-run", testPod).
 				AndRun("kpt live apply valid_path --prune-propagation-policy foo --prune-timeout bar"),
 		},
+		{
+			description: "invalid manifests are not applied",
+			kpt: latest.KptDeploy{
+				Dir: ".",
+				Live: latest.KptLive{
+					Apply: latest.KptApplyInventory{
+						Dir: "valid_path",
+					},
+				},
+			},
+			validate: &latest.ValidateConfig{
+				Schema:   util.BoolPtr(false),
+				Policies: []string{"policy.rego"},
+			},
+			commands: testutil.
+				CmdRunOut("kpt fn source .", ``).
+				AndRunOut("kpt fn run --dry-run", testPod).
+				AndRunOut("opa version", "Version: 0.25.2").
+				AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny",
+					`[{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"default"},"spec":{"containers":[{"image":"gcr.io/project/image1"}],"name":"image1"}}]`,
+					`{"result": [{"expressions": [{"value": ["Pod must be named"]}]}]}`),
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		sanityCheck = func(dir string, buf io.Writer) error { return nil }
@@ -221,7 +245,8 @@ func TestKpt_Deploy(t *testing.T) {
 			tmpDir.WriteFiles(test.kustomizations)
 
 			k := NewDeployer(&kptConfig{
-				kpt: test.kpt,
+				kpt:      test.kpt,
+				validate: test.validate,
 			}, nil)
 
 			if k.Live.Apply.Dir == "valid_path" {
@@ -1137,6 +1162,7 @@ type kptConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	workingDir            string
 	kpt                   latest.KptDeploy
+	validate              *latest.ValidateConfig
 }
 
 func (c *kptConfig) WorkingDir() string       { return c.workingDir }
@@ -1145,5 +1171,6 @@ func (c *kptConfig) GetKubeNamespace() string { return kubectl.TestNamespace }
 func (c *kptConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Deploy.DeployType.KptDeploy = &c.kpt
+	pipeline.Deploy.Validate = c.validate
 	return pipeline
 }
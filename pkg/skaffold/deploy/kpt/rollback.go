@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// applyDirSnapshot is the content of the applyDir before a deploy.
+type applyDirSnapshot struct {
+	applyDir string
+
+	// resources were applied by the previous deploy. They are nil if nothing was applied yet.
+	resources []byte
+}
+
+// snapshotApplyDir records the resources that were applied from the applyDir by the previous deploy.
+func snapshotApplyDir(applyDir string) (*applyDirSnapshot, error) {
+	resources, err := ioutil.ReadFile(filepath.Join(applyDir, resourcesFile))
+	switch {
+	case os.IsNotExist(err):
+		return &applyDirSnapshot{applyDir: applyDir}, nil
+	case err != nil:
+		return nil, deployerr.RollbackErr(fmt.Errorf("reading previous resources: %w", err))
+	default:
+		return &applyDirSnapshot{applyDir: applyDir, resources: resources}, nil
+	}
+}
+
+// Rollback applies the resources of the previous deploy again. Since `kpt live apply` prunes
+// the resources of the inventory that are not applied anymore, this restores the previous state.
+// Without a previous deploy, the resources are destroyed.
+func (k *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	s := k.snapshot
+	if s == nil {
+		return nil
+	}
+
+	var cmd *exec.Cmd
+	if s.resources == nil {
+		cmd = exec.CommandContext(ctx, "kpt", kptCommandArgs(s.applyDir, []string{"live", "destroy"}, nil, nil)...)
+	} else {
+		if err := ioutil.WriteFile(filepath.Join(s.applyDir, resourcesFile), s.resources, 0644); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("restoring previous resources: %w", err))
+		}
+		cmd = exec.CommandContext(ctx, "kpt", kptCommandArgs(s.applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return deployerr.RollbackErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestKpt_Rollback(t *testing.T) {
+	tests := []struct {
+		description string
+		previous    string
+		command     string
+	}{
+		{
+			description: "first deploy is destroyed",
+			command:     "kpt live destroy hydrated",
+		},
+		{
+			description: "previous resources are applied again",
+			previous:    "apiVersion: v1\nkind: ConfigMap\n",
+			command:     "kpt live apply hydrated",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Mkdir("hydrated").Chdir()
+			if test.previous != "" {
+				tmpDir.Write(filepath.Join("hydrated", resourcesFile), test.previous)
+			}
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(test.command))
+
+			snapshot, err := snapshotApplyDir("hydrated")
+			t.RequireNoError(err)
+
+			// The deploy replaces the resources.
+			tmpDir.Write(filepath.Join("hydrated", resourcesFile), "apiVersion: v1\nkind: Secret\n")
+
+			k := &Deployer{KptDeploy: &latest.KptDeploy{}, snapshot: snapshot}
+			err = k.Rollback(context.Background(), ioutil.Discard)
+			t.CheckNoError(err)
+
+			if test.previous != "" {
+				restored, err := ioutil.ReadFile(filepath.Join("hydrated", resourcesFile))
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.previous, string(restored))
+			}
+		})
+	}
+}
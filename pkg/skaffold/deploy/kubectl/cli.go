@@ -34,12 +34,16 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
+// FieldManager is the name of the field manager used by server-side apply.
+const FieldManager = "skaffold"
+
 // CLI holds parameters to run kubectl.
 type CLI struct {
 	*kubectl.CLI
 	Flags latest.KubectlFlags
 
 	forceDeploy      bool
+	serverSideApply  bool
 	waitForDeletions config.WaitForDeletions
 	previousApply    manifest.ManifestList
 }
@@ -82,11 +86,7 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		return nil
 	}
 
-	args := []string{"-f", "-"}
-	if c.forceDeploy {
-		args = append(args, "--force", "--grace-period=0")
-	}
-
+	args := c.applyArgs()
 	if c.Flags.DisableValidation {
 		args = append(args, "--validate=false")
 	}
@@ -98,6 +98,25 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	return nil
 }
 
+// applyArgs returns the arguments of `kubectl apply`, for either client-side or server-side apply.
+func (c *CLI) applyArgs() []string {
+	args := []string{"-f", "-"}
+
+	if c.serverSideApply {
+		args = append(args, "--server-side", "--field-manager="+FieldManager)
+		// `--force` can't be used with server-side apply. Take ownership of the conflicting fields instead.
+		if c.forceDeploy {
+			args = append(args, "--force-conflicts")
+		}
+		return args
+	}
+
+	if c.forceDeploy {
+		args = append(args, "--force", "--grace-period=0")
+	}
+	return args
+}
+
 // Kustomize runs `kubectl kustomize` with the provided args
 func (c *CLI) Kustomize(ctx context.Context, args []string) ([]byte, error) {
 	return c.RunOut(ctx, "kustomize", c.args(nil, args...)...)
@@ -177,6 +196,43 @@ func (c *CLI) WaitForDeletions(ctx context.Context, out io.Writer, manifests man
 	}
 }
 
+// CurrentColor returns the colour of the pods selected by the given Services, as set by a blue/green rollout.
+// It returns an empty string if none of the Services exists or selects a colour.
+func (c *CLI) CurrentColor(ctx context.Context, services manifest.ManifestList) (string, error) {
+	if len(services) == 0 {
+		return "", nil
+	}
+
+	buf, err := c.RunOutInput(ctx, services.Reader(), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+	if err != nil {
+		return "", strategyErr(fmt.Errorf("getting current colour of services: %w", err))
+	}
+	if len(buf) == 0 {
+		return "", nil
+	}
+
+	// A single Service is returned as is, several Services as a List.
+	type service struct {
+		Spec struct {
+			Selector map[string]string `json:"selector"`
+		} `json:"spec"`
+	}
+	var result struct {
+		service
+		Items []service `json:"items"`
+	}
+	if err := json.Unmarshal(buf, &result); err != nil {
+		return "", strategyErr(fmt.Errorf("getting current colour of services: %w", err))
+	}
+
+	for _, item := range append(result.Items, result.service) {
+		if color := item.Spec.Selector[manifest.ColorLabel]; color != "" {
+			return color, nil
+		}
+	}
+	return "", nil
+}
+
 // ReadManifests reads a list of manifests in yaml format.
 func (c *CLI) ReadManifests(ctx context.Context, manifests []string) (manifest.ManifestList, error) {
 	var list []string
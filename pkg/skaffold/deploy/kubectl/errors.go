@@ -96,3 +96,19 @@ func userErr(err error) error {
 			ErrCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
 		})
 }
+
+func strategyErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_DEPLOY_STRATEGY_ERR,
+		})
+}
+
+func pruneErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_DEPLOY_PRUNE_ERR,
+		})
+}
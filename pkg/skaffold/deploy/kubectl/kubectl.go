@@ -32,7 +32,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
@@ -53,6 +55,16 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	skipRender         bool
+	strategy           *latest.DeployStrategy
+	statusCheck        StatusCheck
+	rollbackOnFailure  bool
+	snapshot           *Snapshot
+	validator          *validate.Validator
+
+	// pruneKinds and pruneNamespaces accumulate, across dev iterations,
+	// where the resources deployed by this session can be found.
+	pruneKinds      []string
+	pruneNamespaces []string
 }
 
 // NewDeployer returns a new Deployer for a DeployConfig filled
@@ -67,18 +79,29 @@ func NewDeployer(cfg Config, labels map[string]string) (*Deployer, error) {
 		}
 	}
 
+	kubectl := NewCLI(cfg, cfg.Pipeline().Deploy.KubectlDeploy.Flags, defaultNamespace)
+	kubectl.serverSideApply = cfg.Pipeline().Deploy.KubectlDeploy.ServerSideApply
+
 	return &Deployer{
 		KubectlDeploy:      cfg.Pipeline().Deploy.KubectlDeploy,
 		workingDir:         cfg.GetWorkingDir(),
 		globalConfig:       cfg.GlobalConfig(),
 		defaultRepo:        cfg.DefaultRepo(),
-		kubectl:            NewCLI(cfg, cfg.Pipeline().Deploy.KubectlDeploy.Flags, defaultNamespace),
+		kubectl:            kubectl,
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		skipRender:         cfg.SkipRender(),
 		labels:             labels,
+		strategy:           cfg.Pipeline().Deploy.Strategy,
+		rollbackOnFailure:  cfg.RollbackOnFailure(),
+		validator:          validate.NewValidator(cfg.Pipeline().Deploy.Validate, cfg.GetWorkingDir()),
 	}, nil
 }
 
+// SetStatusCheck sets the status check that gates the rollout of a deploy strategy.
+func (k *Deployer) SetStatusCheck(statusCheck StatusCheck) {
+	k.statusCheck = statusCheck
+}
+
 // Deploy templates the provided manifests with a simple `find and replace` and
 // runs `kubectl apply` on those manifests
 func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
@@ -99,6 +122,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, nil
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, false); err != nil {
+			return nil, err
+		}
+	}
+
 	namespaces, err := manifests.CollectNamespaces()
 	if err != nil {
 		event.DeployInfoEvent(fmt.Errorf("could not fetch deployed resource namespace. "+
@@ -109,13 +138,66 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
-	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if k.rollbackOnFailure {
+		changed, err := StrategyManifests(k.strategy, manifests)
+		if err != nil {
+			return nil, err
+		}
+		if k.snapshot, err = k.kubectl.Snapshot(ctx, changed); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Rollout(ctx, textio.NewPrefixWriter(out, " - "), &k.kubectl, k.strategy, manifests, namespaces, k.statusCheck); err != nil {
 		return nil, err
 	}
 
+	if k.Prune != nil {
+		if err := k.prune(ctx, textio.NewPrefixWriter(out, " - "), manifests, namespaces); err != nil {
+			return nil, err
+		}
+	}
+
 	return namespaces, nil
 }
 
+// prune deletes the resources deployed earlier in this session that are no longer part of the manifests.
+// These resources are found by the run id label that Skaffold adds to everything it deploys.
+func (k *Deployer) prune(ctx context.Context, out io.Writer, manifests manifest.ManifestList, namespaces []string) error {
+	runID := k.labels[label.RunIDLabel]
+	if runID == "" {
+		logrus.Warnln("Skipping pruning: the deployed resources are not labelled with a run id")
+		return nil
+	}
+
+	kinds, err := PruneKinds(manifests)
+	if err != nil {
+		return err
+	}
+	for _, kind := range kinds {
+		if !util.StrSliceContains(k.pruneKinds, kind) {
+			k.pruneKinds = append(k.pruneKinds, kind)
+		}
+	}
+	for _, ns := range append([]string{""}, namespaces...) {
+		if !util.StrSliceContains(k.pruneNamespaces, ns) {
+			k.pruneNamespaces = append(k.pruneNamespaces, ns)
+		}
+	}
+
+	// Keep what a rollout with the current strategy can leave in the cluster, like the workloads of each colour.
+	keep, err := StrategyManifests(k.strategy, manifests)
+	if err != nil {
+		return err
+	}
+
+	return k.kubectl.Prune(ctx, out, keep, PruneTarget{
+		Selector:   fmt.Sprintf("%s=%s", label.RunIDLabel, runID),
+		Kinds:      k.pruneKinds,
+		Namespaces: k.pruneNamespaces,
+	}, k.Prune.DryRun)
+}
+
 func (k *Deployer) manifestFiles(manifests []string) ([]string, error) {
 	var nonURLManifests, gcsManifests []string
 	for _, manifest := range manifests {
@@ -233,6 +315,12 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 		return err
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, offline); err != nil {
+			return err
+		}
+	}
+
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
@@ -297,6 +385,14 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 	return manifests.SetLabels(k.labels)
 }
 
+// Rollback restores the resources changed by the last deploy to their previous version.
+func (k *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	if k.snapshot == nil {
+		return nil
+	}
+	return k.kubectl.Restore(ctx, textio.NewPrefixWriter(out, " - "), k.snapshot)
+}
+
 // Cleanup deletes what was deployed by calling Deploy.
 func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	manifests, err := k.readManifests(ctx, false)
@@ -304,6 +400,11 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 		return err
 	}
 
+	manifests, err = StrategyManifests(k.strategy, manifests)
+	if err != nil {
+		return err
+	}
+
 	// revert remote manifests
 	// TODO(dgageot): That seems super dangerous and I don't understand
 	// why we need to update resources just before we delete them.
@@ -106,6 +106,41 @@ func TestKubectlDeploy(t *testing.T) {
 			}},
 			waitForDeletions: true,
 		},
+		{
+			description: "deploy success (server-side apply)",
+			kubectl: latest.KubectlDeploy{
+				Manifests:       []string{"deployment.yaml"},
+				ServerSideApply: true,
+			},
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", DeploymentWebYAML).
+				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", DeploymentWebYAMLv1, "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager=skaffold"),
+			builds: []build.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
+			waitForDeletions: true,
+		},
+		{
+			description: "deploy success (server-side apply, forced)",
+			kubectl: latest.KubectlDeploy{
+				Manifests:       []string{"deployment.yaml"},
+				ServerSideApply: true,
+			},
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", DeploymentWebYAML).
+				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", DeploymentWebYAMLv1, "").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager=skaffold --force-conflicts"),
+			builds: []build.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:v1",
+			}},
+			forceDeploy:      true,
+			waitForDeletions: true,
+		},
 		{
 			description: "deploy success (kubectl v1.18)",
 			kubectl: latest.KubectlDeploy{
@@ -427,6 +462,127 @@ func TestKubectlRedeploy(t *testing.T) {
 	})
 }
 
+func TestKubectlPrune(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		const (
+			liveApp = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "leeroy-app", "namespace": "default", "uid": "1"}}`
+			liveWeb = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "leeroy-web", "namespace": "default", "uid": "2"}}`
+		)
+		tmpDir := t.NewTempDir().
+			Write("deployment-web.yaml", DeploymentWebYAML).
+			Write("deployment-app.yaml", DeploymentAppYAML).
+			Chdir()
+
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+			AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f deployment-app.yaml -f deployment-web.yaml", DeploymentAppYAML+"\n"+DeploymentWebYAML).
+			AndRun("kubectl --context kubecontext apply -f -").
+			AndRunOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveApp+`,`+liveWeb+`]}`).
+			AndRunOut("kubectl --context kubecontext get Pod -l skaffold.dev/run-id=abc --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveApp+`,`+liveWeb+`]}`).
+			AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f deployment-web.yaml", DeploymentWebYAML).
+			AndRunOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", liveWeb).
+			AndRunOut("kubectl --context kubecontext get Pod -l skaffold.dev/run-id=abc --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveApp+`,`+liveWeb+`]}`).
+			AndRunInput("kubectl --context kubecontext delete --ignore-not-found=true -f -", "apiVersion: v1\nkind: Pod\nmetadata:\n  name: leeroy-app\n  namespace: default"),
+		)
+
+		deployer, err := NewDeployer(&kubectlConfig{
+			workingDir: ".",
+			kubectl: latest.KubectlDeploy{
+				Manifests: []string{"*.yaml"},
+				Prune:     &latest.KubectlPrune{},
+			},
+		}, map[string]string{"skaffold.dev/run-id": "abc"})
+		t.RequireNoError(err)
+
+		// Deploy both manifests
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
+			{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+			{ImageName: "leeroy-app", Tag: "leeroy-app:v1"},
+		})
+		t.CheckNoError(err)
+
+		// Remove a manifest, which prunes its resource
+		t.CheckNoError(os.Remove(tmpDir.Path("deployment-app.yaml")))
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
+			{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+		})
+		t.CheckNoError(err)
+	})
+}
+
+func TestKubectlValidate(t *testing.T) {
+	const policy = `package skaffold
+
+deny[msg] {
+  pod := input[_]
+  container := pod.spec.containers[_]
+  not endswith(container.image, ":v2")
+  msg := sprintf("Pod %v: images must be tagged v2", [pod.metadata.name])
+}`
+
+	tests := []struct {
+		description string
+		tag         string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "valid manifests are applied",
+			tag:         "leeroy-web:v2",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f deployment-web.yaml", DeploymentWebYAML).
+				AndRunOut("opa version", "Version: 0.25.2").
+				AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny",
+					`[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"leeroy-web"},"spec":{"containers":[{"image":"leeroy-web:v2","name":"leeroy-web"}]}}]`,
+					`{"result": [{"expressions": [{"value": []}]}]}`).
+				AndRun("kubectl --context kubecontext apply -f -"),
+		},
+		{
+			description: "invalid manifests are not applied",
+			tag:         "leeroy-web:v1",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+				AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f deployment-web.yaml", DeploymentWebYAML).
+				AndRunOut("opa version", "Version: 0.25.2").
+				AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny",
+					`[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"leeroy-web"},"spec":{"containers":[{"image":"leeroy-web:v1","name":"leeroy-web"}]}}]`,
+					`{"result": [{"expressions": [{"value": ["Pod leeroy-web: images must be tagged v2"]}]}]}`),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().
+				Write("deployment-web.yaml", DeploymentWebYAML).
+				Write("policy.rego", policy).
+				Chdir()
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			deployer, err := NewDeployer(&kubectlConfig{
+				workingDir: ".",
+				kubectl: latest.KubectlDeploy{
+					Manifests: []string{"deployment-web.yaml"},
+				},
+				validate: &latest.ValidateConfig{
+					Schema:   util.BoolPtr(false),
+					Policies: []string{"policy.rego"},
+				},
+			}, nil)
+			t.RequireNoError(err)
+
+			_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
+				{ImageName: "leeroy-web", Tag: test.tag},
+			})
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckErrorContains("Pod leeroy-web: images must be tagged v2 (policy.rego)", err)
+			}
+		})
+	}
+}
+
 func TestKubectlWaitForDeletions(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Write("deployment-web.yaml", DeploymentWebYAML)
@@ -754,6 +910,7 @@ type kubectlConfig struct {
 	force                 bool
 	waitForDeletions      config.WaitForDeletions
 	kubectl               latest.KubectlDeploy
+	validate              *latest.ValidateConfig
 }
 
 func (c *kubectlConfig) GetKubeContext() string                    { return "kubecontext" }
@@ -766,5 +923,6 @@ func (c *kubectlConfig) WaitForDeletions() config.WaitForDeletions { return c.wa
 func (c *kubectlConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Deploy.DeployType.KubectlDeploy = &c.kubectl
+	pipeline.Deploy.Validate = c.validate
 	return pipeline
 }
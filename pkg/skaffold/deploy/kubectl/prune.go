@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// PruneTarget selects the resources that may be pruned.
+type PruneTarget struct {
+	// Selector is the label selector of the resources deployed by the current Skaffold session.
+	Selector string
+
+	// Kinds are the kinds of resources to look for, as `Kind` or `Kind.group`.
+	Kinds []string
+
+	// Namespaces are the namespaces to look into. The empty string is the default namespace.
+	Namespaces []string
+}
+
+// Prune deletes the resources matching the target that are not part of the given manifests.
+// With dryRun, it only prints the resources that would be pruned.
+func (c *CLI) Prune(ctx context.Context, out io.Writer, manifests manifest.ManifestList, target PruneTarget, dryRun bool) error {
+	if len(target.Kinds) == 0 {
+		return nil
+	}
+
+	// Find the live version of the resources to keep, with their namespace resolved.
+	keep := map[string]bool{}
+	if len(manifests) > 0 {
+		buf, err := c.RunOutInput(ctx, manifests.Reader(), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+		if err != nil {
+			return pruneErr(fmt.Errorf("getting live resources: %w", err))
+		}
+		live, err := liveObjects(buf)
+		if err != nil {
+			return pruneErr(err)
+		}
+		for _, obj := range live {
+			keep[liveObjectID(obj)] = true
+		}
+	}
+
+	var pruned manifest.ManifestList
+	var names []string
+	seen := map[string]bool{}
+	for _, ns := range target.Namespaces {
+		cmd := c.CLI.CommandWithNamespaceArg(ctx, "get", ns, c.args(nil, strings.Join(target.Kinds, ","), "-l", target.Selector, "--ignore-not-found", "-ojson")...)
+		buf, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return pruneErr(fmt.Errorf("listing resources with %q: %w", target.Selector, err))
+		}
+		live, err := liveObjects(buf)
+		if err != nil {
+			return pruneErr(err)
+		}
+
+		for _, obj := range live {
+			id := liveObjectID(obj)
+			if keep[id] || seen[id] || !prunable(obj) {
+				continue
+			}
+			seen[id] = true
+
+			m, err := yaml.Marshal(pruneReference(obj))
+			if err != nil {
+				return pruneErr(fmt.Errorf("marshalling yaml: %w", err))
+			}
+			pruned = append(pruned, m)
+			names = append(names, describe(obj))
+		}
+	}
+
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+	if dryRun {
+		for _, name := range names {
+			fmt.Fprintf(out, "%s would be pruned (dry run)\n", name)
+		}
+		return nil
+	}
+
+	if err := c.Run(ctx, pruned.Reader(), out, "delete", c.args(c.Flags.Delete, "--ignore-not-found=true", "-f", "-")...); err != nil {
+		return pruneErr(fmt.Errorf("kubectl delete: %w", err))
+	}
+	return nil
+}
+
+// PruneKinds lists the kinds of the given manifests, as `Kind` or `Kind.group`.
+func PruneKinds(manifests manifest.ManifestList) ([]string, error) {
+	var kinds []string
+	seen := map[string]bool{}
+	for _, m := range manifests {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return nil, pruneErr(fmt.Errorf("reading Kubernetes YAML: %w", err))
+		}
+		kind, _ := obj["kind"].(string)
+		if kind == "" {
+			continue
+		}
+		apiVersion, _ := obj["apiVersion"].(string)
+		if i := strings.Index(apiVersion, "/"); i != -1 {
+			kind += "." + apiVersion[:i]
+		}
+		if !seen[kind] {
+			seen[kind] = true
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds, nil
+}
+
+// prunable returns false for the resources that are managed by another resource, like the Pods of a Deployment,
+// or that are already being deleted.
+func prunable(obj map[string]interface{}) bool {
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	if owners, _ := metadata["ownerReferences"].([]interface{}); len(owners) > 0 {
+		return false
+	}
+	if deletion, _ := metadata["deletionTimestamp"].(string); deletion != "" {
+		return false
+	}
+	return true
+}
+
+// pruneReference returns the minimal version of a live object that `kubectl delete -f` needs.
+func pruneReference(obj map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if live, ok := obj["metadata"].(map[string]interface{}); ok {
+		metadata["name"] = live["name"]
+		if ns, found := live["namespace"]; found {
+			metadata["namespace"] = ns
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": obj["apiVersion"],
+		"kind":       obj["kind"],
+		"metadata":   metadata,
+	}
+}
+
+// liveObjectID identifies a live object, even if several objects of different kinds or namespaces have the same name.
+func liveObjectID(obj map[string]interface{}) string {
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		if uid, _ := metadata["uid"].(string); uid != "" {
+			return uid
+		}
+	}
+	apiVersion, _ := obj["apiVersion"].(string)
+	return apiVersion + "/" + kindName(obj) + "@" + namespaceOf(obj)
+}
+
+func describe(obj map[string]interface{}) string {
+	if ns := namespaceOf(obj); ns != "" {
+		return fmt.Sprintf("%s (%s)", kindName(obj), ns)
+	}
+	return kindName(obj)
+}
+
+func namespaceOf(obj map[string]interface{}) string {
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	ns, _ := metadata["namespace"].(string)
+	return ns
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPrune(t *testing.T) {
+	const (
+		web       = "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"
+		liveWeb   = `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "namespace": "default", "uid": "1"}}`
+		liveOld   = `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "old", "namespace": "default", "uid": "2"}}`
+		liveSvc   = `{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "old", "namespace": "other", "uid": "3"}}`
+		liveOwned = `{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "web-1234", "namespace": "default", "uid": "4", "ownerReferences": [{"kind": "ReplicaSet"}]}}`
+		selector  = "skaffold.dev/run-id=abc"
+	)
+	target := PruneTarget{
+		Selector:   selector,
+		Kinds:      []string{"Deployment.apps", "Service", "Pod"},
+		Namespaces: []string{"", "other"},
+	}
+
+	tests := []struct {
+		description string
+		dryRun      bool
+		commands    util.Command
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "nothing to prune",
+			commands: testutil.
+				CmdRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", web, liveWeb).
+				AndRunOut("kubectl --context kubecontext get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveWeb+`,`+liveOwned+`]}`).
+				AndRunOut("kubectl --context kubecontext --namespace other get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", ""),
+		},
+		{
+			description: "prune removed resources",
+			commands: testutil.
+				CmdRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", web, liveWeb).
+				AndRunOut("kubectl --context kubecontext get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveWeb+`,`+liveOld+`]}`).
+				AndRunOut("kubectl --context kubecontext --namespace other get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", liveSvc).
+				AndRunInput("kubectl --context kubecontext delete --ignore-not-found=true -f -",
+					"apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: old\n  namespace: default\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: old\n  namespace: other"),
+		},
+		{
+			description: "dry run",
+			dryRun:      true,
+			commands: testutil.
+				CmdRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", web, liveWeb).
+				AndRunOut("kubectl --context kubecontext get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", `{"kind": "List", "items": [`+liveWeb+`,`+liveOld+`]}`).
+				AndRunOut("kubectl --context kubecontext --namespace other get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", liveSvc),
+			expected: "Deployment/old (default) would be pruned (dry run)\nService/old (other) would be pruned (dry run)\n",
+		},
+		{
+			description: "listing fails",
+			commands: testutil.
+				CmdRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", web, liveWeb).
+				AndRunOutErr("kubectl --context kubecontext get Deployment.apps,Service,Pod -l "+selector+" --ignore-not-found -ojson", "", errors.New("forbidden")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			cli := NewCLI(&kubectlConfig{}, latest.KubectlFlags{}, "")
+
+			var out bytes.Buffer
+			err := cli.Prune(context.Background(), &out, manifest.ManifestList{[]byte(web)}, target, test.dryRun)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func TestPruneKinds(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		manifests := manifest.ManifestList{
+			[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"),
+			[]byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: web"),
+			[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app"),
+			[]byte("apiVersion: networking.k8s.io/v1\nkind: Ingress\nmetadata:\n  name: web"),
+		}
+
+		kinds, err := PruneKinds(manifests)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"Deployment.apps", "Service", "Ingress.networking.k8s.io"}, kinds)
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// Snapshot is the live version of the resources about to be changed by a deploy.
+type Snapshot struct {
+	// previous are the live versions of the resources that already existed.
+	previous manifest.ManifestList
+
+	// created are the resources that didn't exist yet.
+	created manifest.ManifestList
+}
+
+// Snapshot records the live version of the given resources so that they can be restored.
+func (c *CLI) Snapshot(ctx context.Context, manifests manifest.ManifestList) (*Snapshot, error) {
+	live, err := c.getLive(ctx, manifests)
+	if err != nil {
+		return nil, deployerr.RollbackErr(err)
+	}
+
+	var s Snapshot
+	existing := map[string]bool{}
+	for _, obj := range live {
+		existing[objectKey(obj, "")] = true
+
+		prev, err := yaml.Marshal(cleanLiveObject(obj))
+		if err != nil {
+			return nil, deployerr.RollbackErr(fmt.Errorf("marshalling yaml: %w", err))
+		}
+		s.previous = append(s.previous, prev)
+	}
+
+	namespace := c.defaultNamespace()
+	for _, m := range manifests {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return nil, deployerr.RollbackErr(fmt.Errorf("reading Kubernetes YAML: %w", err))
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		// Cluster-scoped resources are listed without a namespace, whatever the manifest says.
+		if !existing[objectKey(obj, namespace)] && !existing[clusterScopedKey(obj)] {
+			s.created = append(s.created, m)
+		}
+	}
+
+	return &s, nil
+}
+
+// Restore applies the previous version of the resources recorded in the snapshot
+// and deletes the resources that didn't exist.
+func (c *CLI) Restore(ctx context.Context, out io.Writer, s *Snapshot) error {
+	// The next deploy must apply all the manifests again.
+	c.previousApply = nil
+
+	if len(s.previous) > 0 {
+		if err := c.Run(ctx, s.previous.Reader(), out, "apply", c.args(c.Flags.Apply, c.applyArgs()...)...); err != nil {
+			return deployerr.RollbackErr(fmt.Errorf("kubectl apply: %w", err))
+		}
+	}
+
+	if len(s.created) > 0 {
+		if err := c.Delete(ctx, out, s.created); err != nil {
+			return deployerr.RollbackErr(err)
+		}
+	}
+
+	return nil
+}
+
+// getLive returns the live version of the given resources.
+// If some kinds are unknown to the cluster, for example because their CRD isn't installed yet,
+// the resources are fetched one by one and those of unknown kinds are considered as not existing.
+func (c *CLI) getLive(ctx context.Context, manifests manifest.ManifestList) ([]map[string]interface{}, error) {
+	buf, err := c.RunOutInput(ctx, manifests.Reader(), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+	if err == nil {
+		return liveObjects(buf)
+	}
+	if !isUnknownKind(err) {
+		return nil, fmt.Errorf("getting live resources: %w", err)
+	}
+
+	var live []map[string]interface{}
+	for _, m := range manifests {
+		buf, err := c.RunOutInput(ctx, bytes.NewReader(m), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+		if err != nil {
+			if isUnknownKind(err) {
+				continue
+			}
+			return nil, fmt.Errorf("getting live resources: %w", err)
+		}
+
+		objs, err := liveObjects(buf)
+		if err != nil {
+			return nil, err
+		}
+		live = append(live, objs...)
+	}
+	return live, nil
+}
+
+// isUnknownKind checks if kubectl failed because a kind, or its API group, isn't served by the cluster.
+func isUnknownKind(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "no matches for kind") ||
+		strings.Contains(msg, "the server doesn't have a resource type") ||
+		strings.Contains(msg, "the server could not find the requested resource")
+}
+
+// liveObjects parses the output of `kubectl get -ojson`: a single object or a List.
+func liveObjects(buf []byte) ([]map[string]interface{}, error) {
+	if len(buf) == 0 {
+		return nil, nil
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(buf, &obj); err != nil {
+		return nil, fmt.Errorf("parsing live resources: %w", err)
+	}
+	if obj["kind"] != "List" {
+		return []map[string]interface{}{obj}, nil
+	}
+
+	items, _ := obj["items"].([]interface{})
+	var objs []map[string]interface{}
+	for _, item := range items {
+		if o, ok := item.(map[string]interface{}); ok {
+			objs = append(objs, o)
+		}
+	}
+	return objs, nil
+}
+
+// cleanLiveObject removes the fields set by the cluster so that the object can be applied again.
+func cleanLiveObject(obj map[string]interface{}) map[string]interface{} {
+	delete(obj, "status")
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid"} {
+			delete(metadata, field)
+		}
+	}
+	return obj
+}
+
+// defaultNamespace is the namespace of the resources that don't set one: the deployer's namespace
+// or else the namespace of the kube-context.
+func (c *CLI) defaultNamespace() string {
+	if c.Namespace != "" {
+		return c.Namespace
+	}
+	if cfg, err := kubectx.CurrentConfig(); err == nil {
+		if kc, found := cfg.Contexts[c.KubeContext]; found && kc.Namespace != "" {
+			return kc.Namespace
+		}
+	}
+	return "default"
+}
+
+// objectKey identifies a resource by kind, name and namespace.
+// The namespace of resources that don't set one is defaultNamespace.
+func objectKey(obj map[string]interface{}, defaultNamespace string) string {
+	namespace := namespaceOf(obj)
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return kindName(obj) + "@" + namespace
+}
+
+// clusterScopedKey is the key of a resource listed without a namespace.
+func clusterScopedKey(obj map[string]interface{}) string {
+	return kindName(obj) + "@"
+}
+
+func kindName(obj map[string]interface{}) string {
+	kind, _ := obj["kind"].(string)
+	var name string
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		name, _ = metadata["name"].(string)
+	}
+	return kind + "/" + name
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSnapshotAndRestore(t *testing.T) {
+	const (
+		deployment = "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"
+		service    = "apiVersion: v1\nkind: Service\nmetadata:\n  name: web"
+	)
+
+	tests := []struct {
+		description string
+		live        string
+		commands    util.Command
+	}{
+		{
+			description: "nothing existed",
+			live:        "",
+			commands:    testutil.CmdRunInput("kubectl --context kubecontext delete --ignore-not-found=true -f -", deployment+"\n---\n"+service),
+		},
+		{
+			description: "deployment existed",
+			live: `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "uid": "1234", "resourceVersion": "42"},` +
+				` "spec": {"replicas": 2}, "status": {"replicas": 2}}`,
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\nspec:\n  replicas: 2").
+				AndRunInput("kubectl --context kubecontext delete --ignore-not-found=true -f -", service),
+		},
+		{
+			description: "both existed",
+			live: `{"kind": "List", "items": [` +
+				`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web"}},` +
+				`{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "web"}}]}`,
+			commands: testutil.CmdRunInput("kubectl --context kubecontext apply -f -", deployment+"\n---\n"+service),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			manifests := manifest.ManifestList{[]byte(deployment), []byte(service)}
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", test.live))
+			cli := NewCLI(&kubectlConfig{}, latest.KubectlFlags{}, "")
+
+			snapshot, err := cli.Snapshot(context.Background(), manifests)
+			t.RequireNoError(err)
+
+			t.Override(&util.DefaultExecCommand, test.commands)
+			err = cli.Restore(context.Background(), ioutil.Discard, snapshot)
+			t.CheckNoError(err)
+		})
+	}
+}
+
+func TestSnapshotNamespaces(t *testing.T) {
+	const (
+		webInA    = "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n  namespace: a"
+		webInB    = "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"
+		namespace = "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: a"
+		// The web deployment only exists in namespace a, and namespaces are cluster-scoped.
+		live = `{"kind": "List", "items": [` +
+			`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web", "namespace": "a"}},` +
+			`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "a"}}]}`
+	)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&kubectx.CurrentConfig, func() (clientcmdapi.Config, error) {
+			return clientcmdapi.Config{Contexts: map[string]*clientcmdapi.Context{"kubecontext": {Namespace: "b"}}}, nil
+		})
+		manifests := manifest.ManifestList{[]byte(webInA), []byte(webInB), []byte(namespace)}
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", live))
+		cli := NewCLI(&kubectlConfig{}, latest.KubectlFlags{}, "")
+
+		snapshot, err := cli.Snapshot(context.Background(), manifests)
+		t.RequireNoError(err)
+
+		t.CheckDeepEqual(manifest.ManifestList{[]byte(webInB)}, snapshot.created)
+		t.CheckDeepEqual(2, len(snapshot.previous))
+	})
+}
+
+func TestSnapshotUnknownKind(t *testing.T) {
+	const (
+		crd        = "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n  name: widgets.example.com"
+		widget     = "apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: web"
+		deployment = "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"
+		get        = "kubectl --context kubecontext get -f - --ignore-not-found -ojson"
+	)
+
+	tests := []struct {
+		description string
+		commands    util.Command
+		shouldErr   bool
+		created     manifest.ManifestList
+		previous    int
+	}{
+		{
+			description: "CRD not installed yet",
+			commands: testutil.
+				CmdRunOutErr(get, "", errors.New(`error: unable to recognize "STDIN": no matches for kind "Widget" in version "example.com/v1"`)).
+				AndRunInputOut(get, crd, "").
+				AndRunOutErr(get, "", errors.New(`error: unable to recognize "STDIN": no matches for kind "Widget" in version "example.com/v1"`)).
+				AndRunInputOut(get, deployment, `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "web"}}`),
+			created:  manifest.ManifestList{[]byte(crd), []byte(widget)},
+			previous: 1,
+		},
+		{
+			description: "other error",
+			commands:    testutil.CmdRunOutErr(get, "", errors.New("error: connection refused")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			manifests := manifest.ManifestList{[]byte(crd), []byte(widget), []byte(deployment)}
+			t.Override(&util.DefaultExecCommand, test.commands)
+			cli := NewCLI(&kubectlConfig{}, latest.KubectlFlags{}, "")
+
+			snapshot, err := cli.Snapshot(context.Background(), manifests)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.created, snapshot.created)
+				t.CheckDeepEqual(test.previous, len(snapshot.previous))
+			}
+		})
+	}
+}
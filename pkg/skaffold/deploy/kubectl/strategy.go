@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	blue  = "blue"
+	green = "green"
+)
+
+// StatusCheck waits for the resources deployed in the given namespaces to stabilize.
+type StatusCheck func(ctx context.Context, out io.Writer, namespaces []string) error
+
+// cluster is the part of the kubectl CLI used to roll out manifests.
+type cluster interface {
+	Apply(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error
+	Delete(ctx context.Context, out io.Writer, manifests manifest.ManifestList) error
+	CurrentColor(ctx context.Context, services manifest.ManifestList) (string, error)
+}
+
+// Rollout applies the manifests following the deploy strategy.
+// Without a strategy, all the manifests are applied at once.
+func Rollout(ctx context.Context, out io.Writer, cli cluster, strategy *latest.DeployStrategy, manifests manifest.ManifestList, namespaces []string, statusCheck StatusCheck) error {
+	if statusCheck == nil {
+		statusCheck = func(context.Context, io.Writer, []string) error { return nil }
+	}
+
+	switch {
+	case strategy != nil && strategy.Canary != nil:
+		return canaryRollout(ctx, out, cli, strategy.Canary, manifests, namespaces, statusCheck)
+	case strategy != nil && strategy.BlueGreen != nil:
+		return blueGreenRollout(ctx, out, cli, manifests, namespaces, statusCheck)
+	default:
+		return cli.Apply(ctx, out, manifests)
+	}
+}
+
+// canaryRollout deploys a scaled down copy of the workloads first and only applies
+// the manifests once the copy has passed the status check. The copy is then deleted.
+func canaryRollout(ctx context.Context, out io.Writer, cli cluster, canary *latest.CanaryStrategy, manifests manifest.ManifestList, namespaces []string, statusCheck StatusCheck) error {
+	copies, err := manifests.Canary(canary.Workloads, canary.Suffix, canary.Percent)
+	if err != nil {
+		return strategyErr(err)
+	}
+	if len(copies) == 0 {
+		logrus.Warnln("No workload to deploy a canary for, applying the manifests directly")
+		return cli.Apply(ctx, out, manifests)
+	}
+
+	color.Default.Fprintf(out, "Deploying canary with %d%% of the replicas...\n", canary.Percent)
+	if err := cli.Apply(ctx, out, copies); err != nil {
+		return err
+	}
+
+	if err := statusCheck(ctx, out, namespaces); err != nil {
+		color.Default.Fprintln(out, "Canary failed, removing it")
+		if err := cli.Delete(ctx, out, copies); err != nil {
+			logrus.Warnln("Unable to remove the canary:", err)
+		}
+		return err
+	}
+
+	color.Default.Fprintln(out, "Canary is healthy, promoting")
+	if err := cli.Apply(ctx, out, manifests); err != nil {
+		return err
+	}
+	return cli.Delete(ctx, out, copies)
+}
+
+// blueGreenRollout deploys the workloads with the colour that isn't live, switches the Services
+// to that colour once the workloads have passed the status check and deletes the previous colour.
+func blueGreenRollout(ctx context.Context, out io.Writer, cli cluster, manifests manifest.ManifestList, namespaces []string, statusCheck StatusCheck) error {
+	next, err := manifests.Colored(blue)
+	if err != nil {
+		return strategyErr(err)
+	}
+
+	current, err := cli.CurrentColor(ctx, next.Services)
+	if err != nil {
+		return err
+	}
+	nextColor := blue
+	if current == blue {
+		nextColor = green
+		if next, err = manifests.Colored(green); err != nil {
+			return strategyErr(err)
+		}
+	}
+
+	color.Default.Fprintf(out, "Deploying %s workloads...\n", nextColor)
+	if err := cli.Apply(ctx, out, append(next.Others, next.Workloads...)); err != nil {
+		return err
+	}
+
+	if err := statusCheck(ctx, out, namespaces); err != nil {
+		color.Default.Fprintf(out, "The %s workloads failed, removing them\n", nextColor)
+		if err := cli.Delete(ctx, out, next.Workloads); err != nil {
+			logrus.Warnf("Unable to remove the %s workloads: %v", nextColor, err)
+		}
+		return err
+	}
+
+	color.Default.Fprintf(out, "Switching services to %s\n", nextColor)
+	if err := cli.Apply(ctx, out, next.Services); err != nil {
+		return err
+	}
+
+	if current == "" {
+		// Remove the workloads of an earlier deploy without a strategy, if any.
+		return cli.Delete(ctx, out, next.Uncolored)
+	}
+	previous, err := manifests.Colored(current)
+	if err != nil {
+		return strategyErr(err)
+	}
+	return cli.Delete(ctx, out, previous.Workloads)
+}
+
+// StrategyManifests returns all the manifests that a rollout with the given strategy can leave
+// in the cluster, so that they can be cleaned up.
+func StrategyManifests(strategy *latest.DeployStrategy, manifests manifest.ManifestList) (manifest.ManifestList, error) {
+	all := append(manifest.ManifestList{}, manifests...)
+
+	switch {
+	case strategy != nil && strategy.Canary != nil:
+		copies, err := manifests.Canary(strategy.Canary.Workloads, strategy.Canary.Suffix, strategy.Canary.Percent)
+		if err != nil {
+			return nil, strategyErr(err)
+		}
+		all = append(all, copies...)
+
+	case strategy != nil && strategy.BlueGreen != nil:
+		for _, c := range []string{blue, green} {
+			colored, err := manifests.Colored(c)
+			if err != nil {
+				return nil, strategyErr(err)
+			}
+			all = append(all, colored.Workloads...)
+		}
+	}
+
+	return all, nil
+}
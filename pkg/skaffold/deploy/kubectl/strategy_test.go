@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const strategyManifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+`
+
+// fakeCluster records the names of the resources that are applied and deleted.
+type fakeCluster struct {
+	color   string
+	actions []string
+}
+
+func (c *fakeCluster) Apply(_ context.Context, _ io.Writer, manifests manifest.ManifestList) error {
+	c.actions = append(c.actions, "apply "+names(manifests))
+	return nil
+}
+
+func (c *fakeCluster) Delete(_ context.Context, _ io.Writer, manifests manifest.ManifestList) error {
+	c.actions = append(c.actions, "delete "+names(manifests))
+	return nil
+}
+
+func (c *fakeCluster) CurrentColor(context.Context, manifest.ManifestList) (string, error) {
+	return c.color, nil
+}
+
+func names(manifests manifest.ManifestList) string {
+	var names []string
+	for _, m := range manifests {
+		for _, line := range strings.Split(string(m), "\n") {
+			if strings.HasPrefix(line, "  name: ") {
+				names = append(names, strings.TrimPrefix(line, "  name: "))
+			}
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func TestRollout(t *testing.T) {
+	tests := []struct {
+		description  string
+		strategy     *latest.DeployStrategy
+		currentColor string
+		statusErr    error
+		expected     []string
+		shouldErr    bool
+	}{
+		{
+			description: "no strategy",
+			expected:    []string{"apply web,web"},
+		},
+		{
+			description: "canary promoted",
+			strategy:    &latest.DeployStrategy{Canary: &latest.CanaryStrategy{Suffix: "canary", Percent: 10}},
+			expected:    []string{"apply web-canary", "status check", "apply web,web", "delete web-canary"},
+		},
+		{
+			description: "canary failed",
+			strategy:    &latest.DeployStrategy{Canary: &latest.CanaryStrategy{Suffix: "canary", Percent: 10}},
+			statusErr:   errors.New("crash loop"),
+			expected:    []string{"apply web-canary", "status check", "delete web-canary"},
+			shouldErr:   true,
+		},
+		{
+			description: "first blue/green",
+			strategy:    &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			expected:    []string{"apply web-blue", "status check", "apply web", "delete web"},
+		},
+		{
+			description:  "blue/green switch",
+			strategy:     &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			currentColor: "blue",
+			expected:     []string{"apply web-green", "status check", "apply web", "delete web-blue"},
+		},
+		{
+			description:  "blue/green failed",
+			strategy:     &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			currentColor: "green",
+			statusErr:    errors.New("crash loop"),
+			expected:     []string{"apply web-blue", "status check", "delete web-blue"},
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var manifests manifest.ManifestList
+			manifests.Append([]byte(strategyManifests))
+			cluster := &fakeCluster{color: test.currentColor}
+			statusCheck := func(context.Context, io.Writer, []string) error {
+				cluster.actions = append(cluster.actions, "status check")
+				return test.statusErr
+			}
+
+			err := Rollout(context.Background(), ioutil.Discard, cluster, test.strategy, manifests, nil, statusCheck)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, cluster.actions)
+		})
+	}
+}
+
+func TestRolloutFromPlainDeploy(t *testing.T) {
+	var manifests manifest.ManifestList
+	manifests.Append([]byte(strategyManifests))
+	cluster := &fakeCluster{}
+	statusCheck := func(context.Context, io.Writer, []string) error { return nil }
+
+	err := Rollout(context.Background(), ioutil.Discard, cluster, nil, manifests, nil, statusCheck)
+	testutil.CheckError(t, false, err)
+
+	err = Rollout(context.Background(), ioutil.Discard, cluster, &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}}, manifests, nil, statusCheck)
+	testutil.CheckErrorAndDeepEqual(t, false, err, []string{"apply web,web", "apply web-blue", "apply web", "delete web"}, cluster.actions)
+}
+
+func TestStrategyManifests(t *testing.T) {
+	tests := []struct {
+		description string
+		strategy    *latest.DeployStrategy
+		expected    string
+	}{
+		{
+			description: "no strategy",
+			expected:    "web,web",
+		},
+		{
+			description: "canary",
+			strategy:    &latest.DeployStrategy{Canary: &latest.CanaryStrategy{Suffix: "canary", Percent: 10}},
+			expected:    "web,web,web-canary",
+		},
+		{
+			description: "blue/green",
+			strategy:    &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			expected:    "web,web,web-blue,web-green",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var manifests manifest.ManifestList
+			manifests.Append([]byte(strategyManifests))
+
+			all, err := StrategyManifests(test.strategy, manifests)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, names(all))
+		})
+	}
+}
+
+func TestCurrentColor(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		expected    string
+	}{
+		{
+			description: "no service",
+			output:      "",
+		},
+		{
+			description: "single service",
+			output:      `{"kind": "Service", "spec": {"selector": {"app": "web", "skaffold.dev/color": "green"}}}`,
+			expected:    "green",
+		},
+		{
+			description: "list of services",
+			output:      `{"kind": "List", "items": [{"spec": {"selector": {"app": "web"}}}, {"spec": {"selector": {"skaffold.dev/color": "blue"}}}]}`,
+			expected:    "blue",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			services := manifest.ManifestList{[]byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: web")}
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", test.output))
+			cli := NewCLI(&kubectlConfig{}, latest.KubectlFlags{}, "")
+
+			color, err := cli.CurrentColor(context.Background(), services)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, color)
+		})
+	}
+}
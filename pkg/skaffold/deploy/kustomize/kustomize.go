@@ -32,6 +32,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/validate"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -98,6 +99,11 @@ type Deployer struct {
 	labels              map[string]string
 	globalConfig        string
 	useKubectlKustomize bool
+	strategy            *latest.DeployStrategy
+	statusCheck         kubectl.StatusCheck
+	rollbackOnFailure   bool
+	snapshot            *kubectl.Snapshot
+	validator           *validate.Validator
 }
 
 func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error) {
@@ -121,9 +127,17 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error
 		globalConfig:        cfg.GlobalConfig(),
 		labels:              labels,
 		useKubectlKustomize: useKubectlKustomize,
+		strategy:            cfg.Pipeline().Deploy.Strategy,
+		rollbackOnFailure:   cfg.RollbackOnFailure(),
+		validator:           validate.NewValidator(cfg.Pipeline().Deploy.Validate, cfg.GetWorkingDir()),
 	}, nil
 }
 
+// SetStatusCheck sets the status check that gates the rollout of a deploy strategy.
+func (k *Deployer) SetStatusCheck(statusCheck kubectl.StatusCheck) {
+	k.statusCheck = statusCheck
+}
+
 // Check for existence of kustomize binary in user's PATH
 func kustomizeBinaryExists() bool {
 	_, err := exec.LookPath("kustomize")
@@ -152,6 +166,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, nil
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, false); err != nil {
+			return nil, err
+		}
+	}
+
 	namespaces, err := manifests.CollectNamespaces()
 	if err != nil {
 		event.DeployInfoEvent(fmt.Errorf("could not fetch deployed resource namespace. "+
@@ -162,7 +182,17 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
-	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+	if k.rollbackOnFailure {
+		changed, err := kubectl.StrategyManifests(k.strategy, manifests)
+		if err != nil {
+			return nil, err
+		}
+		if k.snapshot, err = k.kubectl.Snapshot(ctx, changed); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := kubectl.Rollout(ctx, textio.NewPrefixWriter(out, " - "), &k.kubectl, k.strategy, manifests, namespaces, k.statusCheck); err != nil {
 		return nil, err
 	}
 
@@ -201,6 +231,14 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 	return manifests.SetLabels(k.labels)
 }
 
+// Rollback restores the resources changed by the last deploy to their previous version.
+func (k *Deployer) Rollback(ctx context.Context, out io.Writer) error {
+	if k.snapshot == nil {
+		return nil
+	}
+	return k.kubectl.Restore(ctx, textio.NewPrefixWriter(out, " - "), k.snapshot)
+}
+
 // Cleanup deletes what was deployed by calling Deploy.
 func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	manifests, err := k.readManifests(ctx)
@@ -208,6 +246,11 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 		return err
 	}
 
+	manifests, err = kubectl.StrategyManifests(k.strategy, manifests)
+	if err != nil {
+		return err
+	}
+
 	if err := k.kubectl.Delete(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return err
 	}
@@ -233,6 +276,13 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 	if err != nil {
 		return err
 	}
+
+	if k.validator != nil {
+		if err := k.validator.Validate(ctx, manifests, offline); err != nil {
+			return err
+		}
+	}
+
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
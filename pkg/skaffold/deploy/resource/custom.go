@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// customResource holds the fields of a custom resource that are
+// needed to check its status. Most operators follow the Kubernetes
+// API conventions and report readiness with `status.conditions`.
+type customResource struct {
+	Status struct {
+		Conditions []condition `json:"conditions"`
+	} `json:"status"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// checkConditionStatus checks that the configured condition of a custom resource is true.
+func (r *Resource) checkConditionStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	var cr customResource
+	if ae, ok := r.getObject(ctx, kubeCtl, &cr); !ok {
+		return ae
+	}
+	return parseConditionStatus(r.condition, cr)
+}
+
+func parseConditionStatus(conditionType string, cr customResource) proto.ActionableErr {
+	for _, c := range cr.Status.Conditions {
+		if c.Type != conditionType {
+			continue
+		}
+		if c.Status == "True" {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: fmt.Sprintf("condition %s is true\n", conditionType),
+			}
+		}
+
+		var details []string
+		for _, s := range []string{c.Reason, c.Message} {
+			if s != "" {
+				details = append(details, s)
+			}
+		}
+		msg := fmt.Sprintf("waiting for condition %s", conditionType)
+		if len(details) > 0 {
+			msg += ": " + strings.Join(details, ": ")
+		}
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+			Message: msg + "\n",
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+		Message: fmt.Sprintf("waiting for condition %s\n", conditionType),
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCheckConditionStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get certificate.cert-manager.io cert --namespace test -o json"
+	tests := []struct {
+		description string
+		condition   string
+		output      string
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "condition true",
+			condition:   "Ready",
+			output:      `{"status":{"conditions":[{"type":"Ready","status":"True"}]}}`,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: "condition Ready is true\n"},
+		},
+		{
+			description: "condition false with reason",
+			condition:   "Ready",
+			output:      `{"status":{"conditions":[{"type":"Ready","status":"False","reason":"Pending","message":"Issuing certificate"}]}}`,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING, Message: "waiting for condition Ready: Pending: Issuing certificate\n"},
+		},
+		{
+			description: "custom condition",
+			condition:   "Issued",
+			output:      `{"status":{"conditions":[{"type":"Ready","status":"True"},{"type":"Issued","status":"True"}]}}`,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: "condition Issued is true\n"},
+		},
+		{
+			description: "no status",
+			condition:   "Ready",
+			output:      `{}`,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING, Message: "waiting for condition Ready\n"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(getCmd, test.output))
+
+			r := NewResource("cert", "certificate.cert-manager.io", "test", 0).WithReadyCondition(test.condition)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expected, r.status.ae)
+		})
+	}
+}
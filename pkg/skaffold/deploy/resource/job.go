@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// checkJobStatus checks that a job ran to completion.
+func (r *Resource) checkJobStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	var job batchv1.Job
+	if ae, ok := r.getObject(ctx, kubeCtl, &job); !ok {
+		return ae
+	}
+	return parseJobStatus(job)
+}
+
+func parseJobStatus(job batchv1.Job) proto.ActionableErr {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "job completed\n",
+			}
+		case batchv1.JobFailed:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+				Message: fmt.Sprintf("job failed: %s\n", c.Message),
+			}
+		}
+	}
+
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		Message: fmt.Sprintf("waiting for job to complete: %d of %d completions, %d active\n", job.Status.Succeeded, completions, job.Status.Active),
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseJobStatus(t *testing.T) {
+	three := int32(3)
+	tests := []struct {
+		description string
+		job         batchv1.Job
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "job complete",
+			job: batchv1.Job{Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: "job completed\n"},
+		},
+		{
+			description: "job failed",
+			job: batchv1.Job{Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit"}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED, Message: "job failed: Job has reached the specified backoff limit\n"},
+		},
+		{
+			description: "job running",
+			job: batchv1.Job{
+				Spec:   batchv1.JobSpec{Completions: &three},
+				Status: batchv1.JobStatus{Succeeded: 1, Active: 2},
+			},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING, Message: "waiting for job to complete: 1 of 3 completions, 2 active\n"},
+		},
+		{
+			description: "condition not true",
+			job: batchv1.Job{Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionFalse}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING, Message: "waiting for job to complete: 0 of 1 completions, 0 active\n"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, parseJobStatus(test.job))
+		})
+	}
+}
+
+func TestCheckJobStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get job migrate --namespace test -o json"
+	tests := []struct {
+		description  string
+		commands     util.Command
+		expectedCode proto.StatusCode
+		complete     bool
+	}{
+		{
+			description:  "job complete",
+			commands:     testutil.CmdRunOut(getCmd, `{"status":{"conditions":[{"type":"Complete","status":"True"}]}}`),
+			expectedCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			complete:     true,
+		},
+		{
+			description:  "job pending",
+			commands:     testutil.CmdRunOut(getCmd, `{"status":{"active":1}}`),
+			expectedCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		},
+		{
+			description:  "invalid output",
+			commands:     testutil.CmdRunOut(getCmd, `not json`),
+			expectedCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			complete:     true,
+		},
+		{
+			description:  "connection error",
+			commands:     testutil.CmdRunOutErr(getCmd, "", errors.New("Unable to connect to the server")),
+			expectedCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("migrate", TypeJob, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedCode, r.statusCode)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// checkPodStatus checks that a standalone pod is ready or ran to completion.
+func (r *Resource) checkPodStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	var pod corev1.Pod
+	if ae, ok := r.getObject(ctx, kubeCtl, &pod); !ok {
+		return ae
+	}
+	return parsePodStatus(pod)
+}
+
+func parsePodStatus(pod corev1.Pod) proto.ActionableErr {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "pod completed\n",
+		}
+	case corev1.PodFailed:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_POD_FAILED,
+			Message: fmt.Sprintf("pod failed: %s\n", pod.Status.Message),
+		}
+	case corev1.PodRunning:
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+				return proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+					Message: "pod is ready\n",
+				}
+			}
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_POD_PENDING,
+		Message: "waiting for pod to be ready\n",
+	}
+}
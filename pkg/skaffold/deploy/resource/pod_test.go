@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParsePodStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		status      corev1.PodStatus
+		expected    proto.StatusCode
+	}{
+		{
+			description: "pod succeeded",
+			status:      corev1.PodStatus{Phase: corev1.PodSucceeded},
+			expected:    proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "pod failed",
+			status:      corev1.PodStatus{Phase: corev1.PodFailed},
+			expected:    proto.StatusCode_STATUSCHECK_POD_FAILED,
+		},
+		{
+			description: "pod running and ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+			expected: proto.StatusCode_STATUSCHECK_SUCCESS,
+		},
+		{
+			description: "pod running but not ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+			},
+			expected: proto.StatusCode_STATUSCHECK_POD_PENDING,
+		},
+		{
+			description: "pod pending",
+			status:      corev1.PodStatus{Phase: corev1.PodPending},
+			expected:    proto.StatusCode_STATUSCHECK_POD_PENDING,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ae := parsePodStatus(corev1.Pod{Status: test.status})
+
+			t.CheckDeepEqual(test.expected, ae.ErrCode)
+		})
+	}
+}
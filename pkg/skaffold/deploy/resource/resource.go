@@ -18,6 +18,7 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -31,9 +32,20 @@ import (
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
+// Type is the type of a Kubernetes resource checked by the status check.
+// Custom resources use their fully qualified resource name, e.g. `certificate.cert-manager.io`.
+type Type string
+
+// Types of resources with a built-in status check.
+const (
+	TypeDeployment  Type = "deployment"
+	TypeStatefulSet Type = "statefulset"
+	TypeDaemonSet   Type = "daemonset"
+	TypeJob         Type = "job"
+	TypePod         Type = "pod"
+)
+
 const (
-	deploymentType          = "deployment"
-	rollOutSuccess          = "successfully rolled out"
 	connectionErrMsg        = "Unable to connect to the server"
 	killedErrMsg            = "signal: killed"
 	defaultPodCheckDeadline = 30 * time.Second
@@ -43,7 +55,7 @@ const (
 )
 
 var (
-	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
+	msgKubectlKilled     = "kubectl command interrupted\n"
 	MsgKubectlConnection = "kubectl connection error\n"
 
 	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
@@ -52,12 +64,25 @@ var (
 		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
 		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
 	}
+
+	retryableStatusCodes = map[proto.StatusCode]struct{}{
+		proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR:      {},
+		proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING:  {},
+		proto.StatusCode_STATUSCHECK_STATEFULSET_ROLLOUT_PENDING: {},
+		proto.StatusCode_STATUSCHECK_DAEMONSET_ROLLOUT_PENDING:   {},
+		proto.StatusCode_STATUSCHECK_JOB_PENDING:                 {},
+		proto.StatusCode_STATUSCHECK_POD_PENDING:                 {},
+		proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING:     {},
+	}
 )
 
-type Deployment struct {
+// Resource is a Kubernetes resource whose status is checked after a deploy,
+// along with the pods it manages.
+type Resource struct {
 	name         string
 	namespace    string
-	rType        string
+	rType        Type
+	condition    string
 	status       Status
 	statusCode   proto.StatusCode
 	done         bool
@@ -66,113 +91,144 @@ type Deployment struct {
 	podValidator diag.Diagnose
 }
 
-func (d *Deployment) Deadline() time.Duration {
-	return d.deadline
+func (r *Resource) Deadline() time.Duration {
+	return r.deadline
 }
 
-func (d *Deployment) UpdateStatus(ae proto.ActionableErr) {
+func (r *Resource) UpdateStatus(ae proto.ActionableErr) {
 	updated := newStatus(ae)
-	if d.status.Equal(updated) {
-		d.status.changed = false
+	if r.status.Equal(updated) {
+		r.status.changed = false
 		return
 	}
-	d.status = updated
-	d.statusCode = updated.ActionableError().ErrCode
-	d.status.changed = true
+	r.status = updated
+	r.statusCode = updated.ActionableError().ErrCode
+	r.status.changed = true
 	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
-		d.done = true
+		r.done = true
 	}
 }
 
-func NewDeployment(name string, ns string, deadline time.Duration) *Deployment {
-	return &Deployment{
+func NewResource(name string, rType Type, ns string, deadline time.Duration) *Resource {
+	return &Resource{
 		name:         name,
 		namespace:    ns,
-		rType:        deploymentType,
+		rType:        rType,
 		status:       newStatus(proto.ActionableErr{}),
 		deadline:     deadline,
 		podValidator: diag.New(nil),
 	}
 }
 
-func (d *Deployment) WithValidator(pd diag.Diagnose) *Deployment {
-	d.podValidator = pd
-	return d
+func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
+	r.podValidator = pd
+	return r
+}
+
+// WithReadyCondition sets the status condition that marks a custom resource as ready.
+func (r *Resource) WithReadyCondition(condition string) *Resource {
+	r.condition = condition
+	return r
 }
 
-func (d *Deployment) CheckStatus(ctx context.Context, cfg kubectl.Config) {
+func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 	kubeCtl := kubectl.NewCLI(cfg, "")
 
-	b, err := kubeCtl.RunOut(ctx, "rollout", "status", "deployment", d.name, "--namespace", d.namespace, "--watch=false")
+	var ae proto.ActionableErr
+	switch r.rType {
+	case TypeDeployment, TypeStatefulSet, TypeDaemonSet:
+		ae = r.checkRolloutStatus(ctx, kubeCtl)
+	case TypeJob:
+		ae = r.checkJobStatus(ctx, kubeCtl)
+	case TypePod:
+		ae = r.checkPodStatus(ctx, kubeCtl)
+	default:
+		ae = r.checkConditionStatus(ctx, kubeCtl)
+	}
 	if ctx.Err() != nil {
 		return
 	}
 
-	details := d.cleanupStatus(string(b))
+	r.UpdateStatus(ae)
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+}
 
-	ae := parseKubectlRolloutError(details, err)
-	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
-		ae.Message = fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %v", d.deadline, err)
+// getObject fetches the resource with `kubectl get` and decodes it into obj.
+func (r *Resource) getObject(ctx context.Context, kubeCtl *kubectl.CLI, obj interface{}) (proto.ActionableErr, bool) {
+	b, err := kubeCtl.RunOut(ctx, "get", string(r.rType), r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return r.withKilledMessage(parseKubectlError(err), err), false
 	}
+	if err := json.Unmarshal(b, obj); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("could not parse %s: %s\n", r, err),
+		}, false
+	}
+	return proto.ActionableErr{}, true
+}
 
-	d.UpdateStatus(ae)
-	if err := d.fetchPods(ctx); err != nil {
-		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+func (r *Resource) withKilledMessage(ae proto.ActionableErr, err error) proto.ActionableErr {
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
+		ae.Message = fmt.Sprintf("received Ctrl-C or %s could not stabilize within %v: %v", r, r.deadline, err)
 	}
+	return ae
 }
 
-func (d *Deployment) String() string {
-	if d.namespace == "default" {
-		return fmt.Sprintf("%s/%s", d.rType, d.name)
+func (r *Resource) String() string {
+	if r.namespace == "default" {
+		return fmt.Sprintf("%s/%s", r.rType, r.name)
 	}
 
-	return fmt.Sprintf("%s:%s/%s", d.namespace, d.rType, d.name)
+	return fmt.Sprintf("%s:%s/%s", r.namespace, r.rType, r.name)
 }
 
-func (d *Deployment) Name() string {
-	return d.name
+func (r *Resource) Name() string {
+	return r.name
 }
 
-func (d *Deployment) Status() Status {
-	return d.status
+func (r *Resource) Status() Status {
+	return r.status
 }
 
-func (d *Deployment) IsStatusCheckCompleteOrCancelled() bool {
-	return d.done || d.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
+func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
+	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
 }
 
-func (d *Deployment) StatusMessage() string {
-	for _, p := range d.pods {
+func (r *Resource) StatusMessage() string {
+	for _, p := range r.pods {
 		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 			return fmt.Sprintf("%s\n", s.Message)
 		}
 	}
-	return d.status.String()
+	return r.status.String()
 }
 
-func (d *Deployment) MarkComplete() {
-	d.done = true
+func (r *Resource) MarkComplete() {
+	r.done = true
 }
 
-// ReportSinceLastUpdated returns a string representing deployment status along with tab header
+// ReportSinceLastUpdated returns a string representing resource status along with tab header
 // e.g.
 //  - testNs:deployment/leeroy-app: waiting for rollout to complete. (1/2) pending
 //      - testNs:pod/leeroy-app-xvbg : error pulling container image
-func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
-	if d.status.reported && !d.status.changed {
+func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
+	if r.status.reported && !r.status.changed {
 		return ""
 	}
-	d.status.reported = true
-	if d.status.String() == "" {
+	r.status.reported = true
+	if r.status.String() == "" {
 		return ""
 	}
 	var result strings.Builder
 	// Pod container statuses can be empty.
 	// This can happen when
-	// 1. No pods have been scheduled for the deployment
+	// 1. No pods have been scheduled for the resource
 	// 2. All containers are in running phase with no errors.
-	// In such case, avoid printing any status update for the deployment.
-	for _, p := range d.pods {
+	// In such case, avoid printing any status update for the resource.
+	for _, p := range r.pods {
 		if s := p.ActionableError().Message; s != "" {
 			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
 			// if logs are muted, write container logs to file and last 3 lines to
@@ -192,15 +248,12 @@ func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
 			writeTrimLines(trimLines)
 		}
 	}
-	return fmt.Sprintf("%s %s: %s%s", tabHeader, d, d.StatusMessage(), result.String())
+	return fmt.Sprintf("%s %s: %s%s", tabHeader, r, r.StatusMessage(), result.String())
 }
 
-func (d *Deployment) cleanupStatus(msg string) string {
-	clean := strings.ReplaceAll(msg, `deployment "`+d.Name()+`" `, "")
-	if len(clean) > 0 {
-		clean = strings.ToLower(clean[0:1]) + clean[1:]
-	}
-	return clean
+func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
+	_, retryable := retryableStatusCodes[statusCode]
+	return !retryable
 }
 
 // parses out connection error
@@ -211,18 +264,8 @@ func (d *Deployment) cleanupStatus(msg string) string {
 // $kubectl logs testPod  -f
 // 2020/06/18 17:28:31 service is running
 // Killed: 9
-func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
+func parseKubectlError(err error) proto.ActionableErr {
 	switch {
-	case err == nil && strings.Contains(details, rollOutSuccess):
-		return proto.ActionableErr{
-			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
-			Message: details,
-		}
-	case err == nil:
-		return proto.ActionableErr{
-			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
-			Message: details,
-		}
 	case strings.Contains(err.Error(), connectionErrMsg):
 		return proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
@@ -241,15 +284,14 @@ func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 	}
 }
 
-func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
-	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
-		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING
-}
-
 // HasEncounteredUnrecoverableError goes through all pod statuses and return true
 // if any cannot be recovered
-func (d *Deployment) HasEncounteredUnrecoverableError() bool {
-	for _, p := range d.pods {
+func (r *Resource) HasEncounteredUnrecoverableError() bool {
+	if r.rType == TypeJob {
+		// Failed job pods are retried up to the job's backoff limit. The job conditions tell when it gives up.
+		return false
+	}
+	for _, p := range r.pods {
 		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
 			return true
 		}
@@ -257,20 +299,20 @@ func (d *Deployment) HasEncounteredUnrecoverableError() bool {
 	return false
 }
 
-func (d *Deployment) fetchPods(ctx context.Context) error {
+func (r *Resource) fetchPods(ctx context.Context) error {
 	timeoutContext, cancel := context.WithTimeout(ctx, defaultPodCheckDeadline)
 	defer cancel()
-	pods, err := d.podValidator.Run(timeoutContext)
+	pods, err := r.podValidator.Run(timeoutContext)
 	if err != nil {
 		return err
 	}
 
 	newPods := map[string]validator.Resource{}
-	d.status.changed = false
+	r.status.changed = false
 	for _, p := range pods {
-		originalPod, found := d.pods[p.String()]
+		originalPod, found := r.pods[p.String()]
 		if !found || originalPod.StatusUpdated(p) {
-			d.status.changed = true
+			r.status.changed = true
 			switch p.ActionableError().ErrCode {
 			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
 				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
@@ -281,33 +323,33 @@ func (d *Deployment) fetchPods(ctx context.Context) error {
 		}
 		newPods[p.String()] = p
 	}
-	d.pods = newPods
+	r.pods = newPods
 	return nil
 }
 
-// StatusCode() returns the deployment status code if the status check is cancelled
-// or if no pod data exists for this deployment.
+// StatusCode() returns the resource status code if the status check is cancelled
+// or if no pod data exists for this resource.
 // If pods are fetched, this function returns the error code a pod container encountered.
-func (d *Deployment) StatusCode() proto.StatusCode {
-	// do not process pod status codes if another deployment failed
+func (r *Resource) StatusCode() proto.StatusCode {
+	// do not process pod status codes if another resource failed
 	// or the user aborted the run.
-	if d.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
-		return d.statusCode
+	if r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return r.statusCode
 	}
-	for _, p := range d.pods {
+	for _, p := range r.pods {
 		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
 			return s
 		}
 	}
-	return d.statusCode
+	return r.statusCode
 }
 
-func (d *Deployment) WithPodStatuses(scs []proto.StatusCode) *Deployment {
-	d.pods = map[string]validator.Resource{}
+func (r *Resource) WithPodStatuses(scs []proto.StatusCode) *Resource {
+	r.pods = map[string]validator.Resource{}
 	for i, s := range scs {
-		name := fmt.Sprintf("%s-%d", d.name, i)
-		d.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
+		name := fmt.Sprintf("%s-%d", r.name, i)
+		r.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
 			proto.ActionableErr{Message: "pod failed", ErrCode: s}, nil)
 	}
-	return d
+	return r
 }
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+var (
+	// Messages printed by `kubectl rollout status` once a rollout is complete.
+	// Deployments and daemonsets are "successfully rolled out" while statefulsets
+	// report either a "rolling update complete" or a "partitioned roll out complete".
+	rolloutSuccessMessages = []string{"successfully rolled out", "rolling update complete", "roll out complete"}
+
+	rolloutPendingCodes = map[Type]proto.StatusCode{
+		TypeDeployment:  proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+		TypeStatefulSet: proto.StatusCode_STATUSCHECK_STATEFULSET_ROLLOUT_PENDING,
+		TypeDaemonSet:   proto.StatusCode_STATUSCHECK_DAEMONSET_ROLLOUT_PENDING,
+	}
+)
+
+// checkRolloutStatus checks the status of deployments, statefulsets and daemonsets with `kubectl rollout status`.
+func (r *Resource) checkRolloutStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "rollout", "status", string(r.rType), r.name, "--namespace", r.namespace, "--watch=false")
+	details := r.cleanupStatus(string(b))
+
+	return r.withKilledMessage(parseKubectlRolloutError(r.rType, details, err), err)
+}
+
+func (r *Resource) cleanupStatus(msg string) string {
+	clean := msg
+	// daemonsets are reported as `daemon set "name"`
+	for _, prefix := range []string{string(r.rType), "daemon set"} {
+		clean = strings.ReplaceAll(clean, fmt.Sprintf(`%s "%s" `, prefix, r.Name()), "")
+	}
+	if len(clean) > 0 {
+		clean = strings.ToLower(clean[0:1]) + clean[1:]
+	}
+	return clean
+}
+
+func parseKubectlRolloutError(rType Type, details string, err error) proto.ActionableErr {
+	switch {
+	case err == nil && isRolloutComplete(details):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: details,
+		}
+	case err == nil:
+		return proto.ActionableErr{
+			ErrCode: rolloutPendingCodes[rType],
+			Message: details,
+		}
+	default:
+		return parseKubectlError(err)
+	}
+}
+
+func isRolloutComplete(details string) bool {
+	for _, msg := range rolloutSuccessMessages {
+		if strings.Contains(details, msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCheckRolloutStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		rType           Type
+		output          string
+		expectedCode    proto.StatusCode
+		expectedDetails string
+	}{
+		{
+			description:     "statefulset rolling update complete",
+			rType:           TypeStatefulSet,
+			output:          "partitioned roll out complete: 2 new pods have been updated...",
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "partitioned roll out complete: 2 new pods have been updated...",
+		},
+		{
+			description:     "statefulset pending",
+			rType:           TypeStatefulSet,
+			output:          "Waiting for 1 pods to be ready...",
+			expectedCode:    proto.StatusCode_STATUSCHECK_STATEFULSET_ROLLOUT_PENDING,
+			expectedDetails: "waiting for 1 pods to be ready...",
+		},
+		{
+			description:     "daemonset rolled out",
+			rType:           TypeDaemonSet,
+			output:          `daemon set "res" successfully rolled out`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "successfully rolled out",
+		},
+		{
+			description:     "daemonset pending",
+			rType:           TypeDaemonSet,
+			output:          `Waiting for daemon set "res" rollout to finish: 0 of 1 updated pods are available...`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_DAEMONSET_ROLLOUT_PENDING,
+			expectedDetails: "waiting for rollout to finish: 0 of 1 updated pods are available...",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status "+string(test.rType)+" res --namespace test --watch=false",
+				test.output,
+			))
+
+			r := NewResource("res", test.rType, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedCode, r.status.ae.ErrCode)
+			t.CheckDeepEqual(test.expectedDetails, r.status.ae.Message)
+		})
+	}
+}
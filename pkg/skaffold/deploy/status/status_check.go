@@ -18,6 +18,7 @@ package status
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -76,7 +78,7 @@ type Checker interface {
 	Check(context.Context, io.Writer) error
 }
 
-// statusChecker runs status checks for pods and deployments
+// statusChecker runs status checks for pods, workloads and custom resources
 type statusChecker struct {
 	cfg             Config
 	labeller        *label.DefaultLabeller
@@ -84,7 +86,7 @@ type statusChecker struct {
 	muteLogs        bool
 }
 
-// NewStatusChecker returns a status checker which runs checks on deployments and pods.
+// NewStatusChecker returns a status checker which runs checks on workloads, custom resources and pods.
 func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
@@ -94,7 +96,7 @@ func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	}
 }
 
-// Run runs the status checks on deployments and pods deployed in current skaffold dev iteration.
+// Run runs the status checks on resources and pods deployed in current skaffold dev iteration.
 func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 	event.StatusCheckEventStarted()
 	errCode, err := s.statusCheck(ctx, out)
@@ -108,50 +110,83 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, 0)
-	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
-			getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds))
-		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
-		}
-		deployments = append(deployments, newDeployments...)
+	resources, errCode, err := s.getResources(ctx, client)
+	if err != nil {
+		return errCode, err
 	}
 
 	var wg sync.WaitGroup
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for _, d := range deployments {
+	for _, r := range resources {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
-			pollDeploymentStatus(ctx, s.cfg, r)
+			pollResourceStatus(ctx, s.cfg, r)
 			rcCopy := c.markProcessed(r.Status().Error())
 			s.printStatusCheckSummary(out, r, rcCopy)
-			// if one deployment fails, cancel status checks for all deployments.
+			// if one resource fails, cancel status checks for all resources.
 			if r.Status().Error() != nil && r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 				cancel()
 			}
-		}(d)
+		}(r)
 	}
 
-	// Retrieve pending deployments statuses
+	// Retrieve pending resources statuses
 	go func() {
-		s.printDeploymentStatus(ctx, out, deployments)
+		s.printResourceStatus(ctx, out, resources)
 	}()
 
-	// Wait for all deployment statuses to be fetched
+	// Wait for all resource statuses to be fetched
 	wg.Wait()
 	cancel()
-	return getSkaffoldDeployStatus(c, deployments)
+	return getSkaffoldDeployStatus(c, resources)
+}
+
+type resourceFetcher func(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error)
+
+// getResources lists the resources deployed in the current run whose status should be checked.
+func (s statusChecker) getResources(ctx context.Context, client kubernetes.Interface) ([]*resource.Resource, proto.StatusCode, error) {
+	fetchers := []struct {
+		fetch   resourceFetcher
+		errCode proto.StatusCode
+	}{
+		{fetch: getDeployments, errCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
+		{fetch: getStatefulSets, errCode: proto.StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR},
+		{fetch: getDaemonSets, errCode: proto.StatusCode_STATUSCHECK_DAEMONSET_FETCH_ERR},
+		{fetch: getJobs, errCode: proto.StatusCode_STATUSCHECK_JOB_FETCH_ERR},
+		{fetch: getStandalonePods, errCode: proto.StatusCode_STATUSCHECK_POD_FETCH_ERR},
+	}
+
+	deadline := getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds)
+	kubeCtl := pkgkubectl.NewCLI(s.cfg, "")
+	resources := make([]*resource.Resource, 0)
+	for _, n := range s.cfg.GetNamespaces() {
+		for _, f := range fetchers {
+			fetched, err := f.fetch(ctx, client, n, s.labeller, deadline)
+			if err != nil {
+				return nil, f.errCode, err
+			}
+			resources = append(resources, fetched...)
+		}
+
+		for _, cr := range s.cfg.Pipeline().Deploy.StatusCheckCustomResources {
+			fetched, err := getCustomResources(ctx, kubeCtl, cr, n, s.labeller, deadline)
+			if err != nil {
+				return nil, proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR, err
+			}
+			resources = append(resources, fetched...)
+		}
+	}
+	return resources, proto.StatusCode_STATUSCHECK_SUCCESS, nil
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -159,7 +194,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -167,20 +202,123 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		pd := podValidator(client, d.Namespace, l, d.Spec.Template.Labels)
+		deployments[i] = resource.NewResource(d.Name, resource.TypeDeployment, d.Namespace, deadline).WithValidator(pd)
+	}
+	return deployments, nil
+}
+
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+	statefulSets := make([]*resource.Resource, 0, len(sets.Items))
+	for _, s := range sets.Items {
+		// `kubectl rollout status` only supports the RollingUpdate strategy.
+		if s.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			logrus.Debugf("skipping status check for statefulset %s with %s update strategy", s.Name, s.Spec.UpdateStrategy.Type)
+			continue
 		}
+		pd := podValidator(client, s.Namespace, l, s.Spec.Template.Labels)
+		statefulSets = append(statefulSets, resource.NewResource(s.Name, resource.TypeStatefulSet, s.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return statefulSets, nil
+}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
 	}
-	return deployments, nil
+
+	daemonSets := make([]*resource.Resource, 0, len(sets.Items))
+	for _, d := range sets.Items {
+		// `kubectl rollout status` only supports the RollingUpdate strategy.
+		if d.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			logrus.Debugf("skipping status check for daemonset %s with %s update strategy", d.Name, d.Spec.UpdateStrategy.Type)
+			continue
+		}
+		pd := podValidator(client, d.Namespace, l, d.Spec.Template.Labels)
+		daemonSets = append(daemonSets, resource.NewResource(d.Name, resource.TypeDaemonSet, d.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return daemonSets, nil
+}
+
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+
+	jobs := make([]*resource.Resource, len(list.Items))
+	for i, j := range list.Items {
+		pd := podValidator(client, j.Namespace, l, j.Spec.Template.Labels)
+		jobs[i] = resource.NewResource(j.Name, resource.TypeJob, j.Namespace, deadlineDuration).WithValidator(pd)
+	}
+	return jobs, nil
+}
+
+// getStandalonePods returns the pods deployed in the current run that are not managed by a controller.
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch pods: %w", err)
+	}
+
+	pods := make([]*resource.Resource, 0, len(list.Items))
+	for _, p := range list.Items {
+		if len(p.OwnerReferences) > 0 {
+			continue
+		}
+		pd := podValidator(client, p.Namespace, l, p.Labels)
+		pods = append(pods, resource.NewResource(p.Name, resource.TypePod, p.Namespace, deadlineDuration).WithValidator(pd))
+	}
+	return pods, nil
+}
+
+// getCustomResources lists custom resources with `kubectl get` since their types aren't known to the Kubernetes client.
+func getCustomResources(ctx context.Context, kubeCtl *pkgkubectl.CLI, cr latest.CustomResourceStatusCheck, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	rType := resource.Type(strings.ToLower(fmt.Sprintf("%s.%s", cr.Kind, cr.Group)))
+	b, err := kubeCtl.RunOut(ctx, "get", string(rType), "-l", l.RunIDSelector(), "--namespace", ns, "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", rType, err)
+	}
+
+	var list metav1.PartialObjectMetadataList
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", rType, err)
+	}
+
+	resources := make([]*resource.Resource, len(list.Items))
+	for i, o := range list.Items {
+		resources[i] = resource.NewResource(o.Name, rType, o.Namespace, deadlineDuration).WithReadyCondition(cr.Condition)
+	}
+	return resources, nil
+}
+
+// podValidator diagnoses the pods with the given labels that were deployed in the current run.
+func podValidator(client kubernetes.Interface, ns string, l *label.DefaultLabeller, podLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range podLabels {
+		pd = pd.WithLabel(k, v)
+	}
+	return pd
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
+func pollResourceStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
@@ -220,9 +358,9 @@ func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resourc
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed > 0 {
-		err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+		err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 		for _, r := range rs {
 			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
 				return r.StatusCode(), err
@@ -239,11 +377,11 @@ func getDeadline(d int) time.Duration {
 	return defaultStatusCheckDeadline
 }
 
-func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
-		// another deployment failed
+		// another resource failed
 		return
 	}
 	event.ResourceStatusCheckEventCompleted(r.String(), ae)
@@ -263,15 +401,15 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 	fmt.Fprintln(out, status)
 }
 
-// printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+// printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
+func (s statusChecker) printResourceStatus(ctx context.Context, out io.Writer, resources []*resource.Resource) {
 	for {
 		var allDone bool
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allDone = s.printStatus(deployments, out)
+			allDone = s.printStatus(resources, out)
 		}
 		if allDone {
 			return
@@ -279,9 +417,9 @@ func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer,
 	}
 }
 
-func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s statusChecker) printStatus(resources []*resource.Resource, out io.Writer) bool {
 	allDone := true
-	for _, r := range deployments {
+	for _, r := range resources {
 		if r.IsStatusCheckCompleteOrCancelled() {
 			continue
 		}
@@ -296,7 +434,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 
 func getPendingMessage(pending int32, total int) string {
 	if pending > 0 {
-		return fmt.Sprintf(" [%d/%d deployment(s) still pending]", pending, total)
+		return fmt.Sprintf(" [%d/%d resource(s) still pending]", pending, total)
 	}
 	return ""
 }
@@ -31,6 +31,7 @@ type Config interface {
 	GlobalConfig() string
 	DefaultRepo() *string
 	SkipRender() bool
+	RollbackOnFailure() bool
 }
 
 // Artifact contains all information about a completed deployment
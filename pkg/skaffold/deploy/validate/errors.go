@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+const opaInstallLink = "https://www.openpolicyagent.org/docs/latest/#running-opa"
+
+func schemaErr(violations []string) error {
+	return sErrors.NewErrorWithStatusCode(
+		proto.ActionableErr{
+			Message: fmt.Sprintf("manifests don't match the OpenAPI schema:\n - %s", strings.Join(violations, "\n - ")),
+			ErrCode: proto.StatusCode_DEPLOY_MANIFEST_SCHEMA_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_MANIFEST_SCHEMA,
+					Action:         "Fix the fields reported above, or set `deploy.validate.schema` to false to skip schema validation",
+				},
+			},
+		})
+}
+
+func policyErr(violations []string) error {
+	return sErrors.NewErrorWithStatusCode(
+		proto.ActionableErr{
+			Message: fmt.Sprintf("manifests violate the validation policies:\n - %s", strings.Join(violations, "\n - ")),
+			ErrCode: proto.StatusCode_DEPLOY_MANIFEST_POLICY_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_MANIFEST_POLICY_VIOLATION,
+					Action:         "Fix the manifests reported above, or update the policies listed in `deploy.validate.policies`",
+				},
+			},
+		})
+}
+
+func clusterSchemaErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_CLUSTER_CONNECTION,
+					Action:         "Check your connection for the cluster",
+				},
+			},
+		})
+}
+
+func opaNotFoundErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("evaluating Rego policies requires opa: %s", err),
+			ErrCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_INSTALL_OPA,
+					Action:         fmt.Sprintf("Please install opa via %s", opaInstallLink),
+				},
+			},
+		})
+}
+
+func validationErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+		})
+}
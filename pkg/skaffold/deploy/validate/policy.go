@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// checkOpa fails early, before the manifests are validated against the schema, when `opa` is not installed.
+func checkOpa(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "opa", "version")
+	if _, err := util.RunCmdOut(cmd); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return opaNotFoundErr(err)
+		}
+		return validationErr(fmt.Errorf("running opa: %w", err))
+	}
+	return nil
+}
+
+// validatePolicies checks the manifests against the policy files.
+// Policy files are read on every call so that they can be edited during `skaffold dev`.
+func (v *Validator) validatePolicies(ctx context.Context, objects []object) error {
+	var violations []string
+
+	for _, policy := range v.policies {
+		found, err := evalRegoPolicy(ctx, policy, objects)
+		if err != nil {
+			return err
+		}
+
+		for _, violation := range found {
+			violations = append(violations, fmt.Sprintf("%s (%s)", violation, filepath.Base(policy)))
+		}
+	}
+
+	if len(violations) > 0 {
+		return policyErr(violations)
+	}
+	return nil
+}
+
+// evalRegoPolicy runs `opa` once to evaluate the `deny` rules of package `skaffold`
+// against the list of all the manifests, passed as `input`.
+func evalRegoPolicy(ctx context.Context, path string, objects []object) ([]string, error) {
+	fields := make([]map[string]interface{}, len(objects))
+	for i, o := range objects {
+		fields[i] = o.fields
+	}
+	input, err := json.Marshal(fields)
+	if err != nil {
+		return nil, validationErr(err)
+	}
+
+	cmd := exec.CommandContext(ctx, "opa", "eval", "--format=json", "--stdin-input", "--data", path, "data.skaffold.deny")
+	cmd.Stdin = bytes.NewReader(input)
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, validationErr(fmt.Errorf("evaluating policy %q: %w", path, err))
+	}
+
+	denied, err := parseDenials(out)
+	if err != nil {
+		return nil, validationErr(fmt.Errorf("evaluating policy %q: %w", path, err))
+	}
+	return denied, nil
+}
+
+// parseDenials reads the messages of the `deny` rules from the output of `opa eval`.
+func parseDenials(out []byte) ([]string, error) {
+	var result struct {
+		Result []struct {
+			Expressions []struct {
+				Value interface{} `json:"value"`
+			} `json:"expressions"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, fmt.Errorf("parsing opa output: %w", err)
+	}
+
+	var messages []string
+	for _, r := range result.Result {
+		for _, e := range r.Expressions {
+			values, ok := e.Value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("the deny rule must produce a set of messages, got %v", e.Value)
+			}
+			for _, value := range values {
+				if message, ok := value.(string); ok {
+					messages = append(messages, message)
+				} else {
+					messages = append(messages, fmt.Sprint(value))
+				}
+			}
+		}
+	}
+	return messages, nil
+}
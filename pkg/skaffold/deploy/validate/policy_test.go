@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	latestDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      containers:
+      - name: web
+        image: web:latest`
+	pinnedDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  template:
+    spec:
+      containers:
+      - name: api
+        image: api:v1
+        resources:
+          limits:
+            cpu: 1`
+)
+
+const opaVersion = "Version: 0.25.2"
+
+func TestValidateRegoPolicies(t *testing.T) {
+	const (
+		input  = `[{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web"},"spec":{"template":{"spec":{"containers":[{"image":"web:latest","name":"web"}]}}}},{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api"},"spec":{"template":{"spec":{"containers":[{"image":"api:v1","name":"api","resources":{"limits":{"cpu":1}}}]}}}}]`
+		denied = `{"result": [{"expressions": [{"value": ["Deployment web: image web:latest uses the latest tag"], "text": "data.skaffold.deny"}]}]}`
+	)
+
+	tests := []struct {
+		description string
+		commands    util.Command
+		shouldErr   bool
+		expected    []string
+	}{
+		{
+			description: "no violation",
+			commands:    testutil.CmdRunOut("opa version", opaVersion).AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny", input, `{"result": [{"expressions": [{"value": []}]}]}`),
+		},
+		{
+			description: "no deny rule",
+			commands:    testutil.CmdRunOut("opa version", opaVersion).AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny", input, `{}`),
+		},
+		{
+			description: "violation",
+			commands:    testutil.CmdRunOut("opa version", opaVersion).AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny", input, denied),
+			shouldErr:   true,
+			expected:    []string{"Deployment web: image web:latest uses the latest tag (policy.rego)"},
+		},
+		{
+			description: "opa not installed",
+			commands:    testutil.CmdRunOutErr("opa version", "", fmt.Errorf("starting command: %w", exec.ErrNotFound)),
+			shouldErr:   true,
+			expected:    []string{"evaluating Rego policies requires opa", "Please install opa"},
+		},
+		{
+			description: "deny is not a set",
+			commands:    testutil.CmdRunOut("opa version", opaVersion).AndRunInputOut("opa eval --format=json --stdin-input --data policy.rego data.skaffold.deny", input, `{"result": [{"expressions": [{"value": true}]}]}`),
+			shouldErr:   true,
+			expected:    []string{"the deny rule must produce a set of messages"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			validator := NewValidator(&latest.ValidateConfig{
+				Schema:   util.BoolPtr(false),
+				Policies: []string{"policy.rego"},
+			}, "")
+			err := validator.Validate(context.Background(), manifest.ManifestList{[]byte(latestDeployment), []byte(pinnedDeployment)}, false)
+
+			t.CheckError(test.shouldErr, err)
+			for _, expected := range test.expected {
+				t.CheckErrorContains(expected, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegoPoliciesRunOnce(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		const (
+			input  = `[{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}},{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}]`
+			denied = `{"result": [{"expressions": [{"value": ["ConfigMap a: must be labelled", "ConfigMap b: must be labelled"]}]}]}`
+		)
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("opa version", opaVersion).
+			AndRunInputOut("opa eval --format=json --stdin-input --data labels.rego data.skaffold.deny", input, denied).
+			AndRunInputOut("opa eval --format=json --stdin-input --data images.rego data.skaffold.deny", input, `{"result": [{"expressions": [{"value": []}]}]}`))
+
+		validator := NewValidator(&latest.ValidateConfig{
+			Schema:   util.BoolPtr(false),
+			Policies: []string{"labels.rego", "images.rego"},
+		}, "")
+		err := validator.Validate(context.Background(), manifest.ManifestList{
+			[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a"),
+			[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b"),
+		}, false)
+
+		t.CheckErrorContains("manifests violate the validation policies:\n - ConfigMap a: must be labelled (labels.rego)\n - ConfigMap b: must be labelled (labels.rego)", err)
+	})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/googleapis/gnostic/compiler"
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"github.com/sirupsen/logrus"
+	yamlv2 "gopkg.in/yaml.v2"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/kubectl/pkg/util/openapi/validation"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+// OfflineSchema returns the OpenAPI document, in JSON, used to validate
+// manifests when Skaffold can't connect to a cluster.
+// It's set by the skaffold command to read the schema bundled with the binary.
+var OfflineSchema func() ([]byte, error)
+
+// for tests
+var clusterSchema = fetchClusterSchema
+
+func fetchClusterSchema() (*openapi_v2.Document, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, err
+	}
+	return client.Discovery().OpenAPISchema()
+}
+
+func parseSchema(spec []byte) (*openapi_v2.Document, error) {
+	var info yamlv2.MapSlice
+	if err := yamlv2.Unmarshal(spec, &info); err != nil {
+		return nil, err
+	}
+	return openapi_v2.NewDocument(info, compiler.NewContext("$root", nil))
+}
+
+// openAPIResources returns the schemas of the Kubernetes resources,
+// from the cluster or bundled with Skaffold in offline mode.
+// It returns nil if there's no schema to validate against.
+func (v *Validator) openAPIResources(offline bool) (openapi.Resources, error) {
+	if resources, found := v.resources[offline]; found {
+		return resources, nil
+	}
+
+	var (
+		doc *openapi_v2.Document
+		err error
+	)
+	if offline {
+		if OfflineSchema == nil {
+			logrus.Warnln("Skipping schema validation: no offline schema is available")
+			v.resources[offline] = nil
+			return nil, nil
+		}
+
+		var spec []byte
+		if spec, err = OfflineSchema(); err == nil {
+			doc, err = parseSchema(spec)
+		}
+		if err != nil {
+			return nil, validationErr(fmt.Errorf("reading the offline OpenAPI schema: %w", err))
+		}
+	} else {
+		if doc, err = clusterSchema(); err != nil {
+			return nil, clusterSchemaErr(fmt.Errorf("getting the OpenAPI schema of the cluster: %w", err))
+		}
+	}
+
+	resources, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, validationErr(fmt.Errorf("reading the OpenAPI schema: %w", err))
+	}
+
+	v.resources[offline] = resources
+	return resources, nil
+}
+
+// validateSchema checks the manifests against the OpenAPI schema.
+// Resources unknown to the schema, like most custom resources, are skipped.
+func (v *Validator) validateSchema(objects []object, offline bool) error {
+	resources, err := v.openAPIResources(offline)
+	if err != nil || resources == nil {
+		return err
+	}
+
+	validator := validation.NewSchemaValidation(resources)
+
+	var violations []string
+	for _, o := range objects {
+		err := validator.ValidateBytes(o.raw)
+		if err == nil {
+			continue
+		}
+
+		if agg, ok := err.(utilerrors.Aggregate); ok {
+			for _, e := range agg.Errors() {
+				violations = append(violations, fmt.Sprintf("%s: %s", o, e))
+			}
+		} else {
+			violations = append(violations, fmt.Sprintf("%s: %s", o, err))
+		}
+	}
+
+	if len(violations) > 0 {
+		return schemaErr(violations)
+	}
+	return nil
+}
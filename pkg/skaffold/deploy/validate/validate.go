@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Validator checks the rendered manifests before they are applied.
+type Validator struct {
+	schema   bool
+	policies []string
+
+	// resources caches the OpenAPI schemas, keyed by offline mode.
+	resources map[bool]openapi.Resources
+}
+
+// NewValidator returns a Validator for the given configuration,
+// or nil if the manifests shouldn't be validated.
+func NewValidator(cfg *latest.ValidateConfig, workingDir string) *Validator {
+	if cfg == nil {
+		return nil
+	}
+
+	var policies []string
+	for _, policy := range cfg.Policies {
+		if !filepath.IsAbs(policy) {
+			policy = filepath.Join(workingDir, policy)
+		}
+		policies = append(policies, policy)
+	}
+
+	return &Validator{
+		schema:    cfg.Schema == nil || *cfg.Schema,
+		policies:  policies,
+		resources: map[bool]openapi.Resources{},
+	}
+}
+
+// object is a manifest decoded into the types produced by encoding/json.
+type object struct {
+	raw    []byte
+	fields map[string]interface{}
+}
+
+func (o object) String() string {
+	kind, _ := o.fields["kind"].(string)
+	metadata, _ := o.fields["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	return fmt.Sprintf("%s %q", kind, name)
+}
+
+// Validate checks every manifest against the OpenAPI schema of the cluster
+// and the configured policies. In offline mode, the schema bundled with
+// Skaffold is used instead of the cluster's.
+func (v *Validator) Validate(ctx context.Context, manifests manifest.ManifestList, offline bool) error {
+	if len(v.policies) > 0 {
+		if err := checkOpa(ctx); err != nil {
+			return err
+		}
+	}
+
+	objects, err := decode(manifests)
+	if err != nil {
+		return validationErr(err)
+	}
+
+	if v.schema {
+		if err := v.validateSchema(objects, offline); err != nil {
+			return err
+		}
+	}
+
+	return v.validatePolicies(ctx, objects)
+}
+
+func decode(manifests manifest.ManifestList) ([]object, error) {
+	var objects []object
+	for _, m := range manifests {
+		j, err := yaml.ToJSON(m)
+		if err != nil {
+			return nil, fmt.Errorf("reading manifest: %w", err)
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(j, &fields); err != nil {
+			return nil, fmt.Errorf("reading manifest: %w", err)
+		}
+		if fields == nil {
+			continue
+		}
+
+		objects = append(objects, object{raw: m, fields: fields})
+	}
+	return objects, nil
+}
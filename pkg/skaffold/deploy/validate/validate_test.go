@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	validConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value`
+	invalidConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  labelz:
+    app: web
+data:
+- key`
+	customResource = `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+spec:
+  anything: goes`
+)
+
+func testSchema() ([]byte, error) {
+	return ioutil.ReadFile("testdata/swagger.json")
+}
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   manifest.ManifestList
+		offline     bool
+		shouldErr   bool
+		expected    []string
+	}{
+		{
+			description: "valid manifests",
+			manifests:   manifest.ManifestList{[]byte(validConfigMap), []byte(customResource)},
+		},
+		{
+			description: "valid manifests offline",
+			manifests:   manifest.ManifestList{[]byte(validConfigMap)},
+			offline:     true,
+		},
+		{
+			description: "invalid manifest",
+			manifests:   manifest.ManifestList{[]byte(invalidConfigMap)},
+			shouldErr:   true,
+			expected: []string{
+				"manifests don't match the OpenAPI schema",
+				`ConfigMap "config": ValidationError(ConfigMap.data): invalid type for io.k8s.api.core.v1.ConfigMap.data: got "array", expected "map"`,
+				`ConfigMap "config": ValidationError(ConfigMap.metadata): unknown field "labelz" in io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta`,
+				"set `deploy.validate.schema` to false",
+			},
+		},
+		{
+			description: "invalid manifest offline",
+			manifests:   manifest.ManifestList{[]byte(invalidConfigMap)},
+			offline:     true,
+			shouldErr:   true,
+			expected:    []string{`unknown field "labelz"`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fetched := 0
+			t.Override(&clusterSchema, func() (*openapi_v2.Document, error) {
+				fetched++
+				spec, err := testSchema()
+				if err != nil {
+					return nil, err
+				}
+				return parseSchema(spec)
+			})
+			t.Override(&OfflineSchema, testSchema)
+
+			validator := NewValidator(&latest.ValidateConfig{}, ".")
+			err := validator.Validate(context.Background(), test.manifests, test.offline)
+			// The schema is only fetched once.
+			err2 := validator.Validate(context.Background(), test.manifests, test.offline)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckError(test.shouldErr, err2)
+			for _, expected := range test.expected {
+				t.CheckErrorContains(expected, err)
+			}
+			if !test.offline {
+				t.CheckDeepEqual(1, fetched)
+			}
+		})
+	}
+}
+
+func TestValidateSchemaErrors(t *testing.T) {
+	testutil.Run(t, "cluster unreachable", func(t *testutil.T) {
+		t.Override(&clusterSchema, func() (*openapi_v2.Document, error) {
+			return nil, errors.New("connection refused")
+		})
+
+		err := NewValidator(&latest.ValidateConfig{}, ".").Validate(context.Background(), manifest.ManifestList{[]byte(validConfigMap)}, false)
+
+		t.CheckErrorContains("getting the OpenAPI schema of the cluster: connection refused", err)
+		t.CheckErrorContains("Check your connection for the cluster", err)
+	})
+
+	testutil.Run(t, "no offline schema", func(t *testutil.T) {
+		t.Override(&OfflineSchema, (func() ([]byte, error))(nil))
+
+		err := NewValidator(&latest.ValidateConfig{}, ".").Validate(context.Background(), manifest.ManifestList{[]byte(invalidConfigMap)}, true)
+
+		t.CheckNoError(err)
+	})
+
+	testutil.Run(t, "schema disabled", func(t *testutil.T) {
+		t.Override(&clusterSchema, func() (*openapi_v2.Document, error) {
+			return nil, errors.New("should not be called")
+		})
+
+		err := NewValidator(&latest.ValidateConfig{Schema: util.BoolPtr(false)}, ".").Validate(context.Background(), manifest.ManifestList{[]byte(invalidConfigMap)}, false)
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestNewValidator(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.CheckNil(NewValidator(nil, "."))
+
+		validator := NewValidator(&latest.ValidateConfig{Policies: []string{"policies/no-latest.rego", "/abs/policy.rego"}}, "/project")
+
+		t.CheckDeepEqual(true, validator.schema)
+		t.CheckDeepEqual([]string{"/project/policies/no-latest.rego", "/abs/policy.rego"}, validator.policies)
+	})
+}
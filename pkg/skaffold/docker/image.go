@@ -85,6 +85,8 @@ type BuildOptions struct {
 	Tag            string
 	Mode           config.RunMode
 	ExtraBuildArgs map[string]*string
+	// Platform is the target platform of the image, like `linux/arm64`. Defaults to the daemon's platform.
+	Platform string
 }
 
 type localDaemon struct {
@@ -213,6 +215,7 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 		ForceRemove: l.forceRemove,
 		NetworkMode: strings.ToLower(a.NetworkMode),
 		NoCache:     a.NoCache,
+		Platform:    opts.Platform,
 	})
 	if err != nil {
 		return "", fmt.Errorf("docker build: %w", err)
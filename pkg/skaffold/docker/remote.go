@@ -21,8 +21,11 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/sirupsen/logrus"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
@@ -33,6 +36,7 @@ var (
 	RemoteDigest = getRemoteDigest
 	remoteImage  = remote.Image
 	remoteIndex  = remote.Index
+	writeIndex   = remote.WriteIndex
 )
 
 func AddRemoteTag(src, target string, cfg Config) error {
@@ -50,6 +54,41 @@ func AddRemoteTag(src, target string, cfg Config) error {
 	return remote.Write(targetRef, img, remote.WithAuthFromKeychain(primaryKeychain))
 }
 
+// WriteRemoteImage pushes an image to a remote tag.
+func WriteRemoteImage(tag string, img v1.Image, cfg Config) error {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(ref, img, remote.WithAuthFromKeychain(primaryKeychain))
+}
+
+// WriteRemoteIndex pushes an image index to a remote tag and returns its digest.
+func WriteRemoteIndex(tag string, idx v1.ImageIndex, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writeIndex(ref, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, tag, err)
+	}
+
+	return digest(idx)
+}
+
+// RetrievePlatformImage retrieves a remote image. When the reference points to an image index,
+// the image built for the given platform is selected.
+func RetrievePlatformImage(identifier string, p v1.Platform, cfg Config) (v1.Image, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteImage(ref, remote.WithAuthFromKeychain(primaryKeychain), remote.WithPlatform(p))
+}
+
 func getRemoteDigest(identifier string, cfg Config) (string, error) {
 	idx, err := getRemoteIndex(identifier, cfg)
 	if err == nil {
@@ -64,6 +103,44 @@ func getRemoteDigest(identifier string, cfg Config) (string, error) {
 	return digest(img)
 }
 
+// PlatformImage is a pushed image built for a single platform.
+type PlatformImage struct {
+	Platform v1.Platform
+	Image    string
+}
+
+// CreateManifestList assembles images built for different platforms into an OCI image index,
+// pushes it to the given tag and returns its digest.
+func CreateManifestList(tag string, images []PlatformImage, cfg Config) (string, error) {
+	targetRef, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	var adds []mutate.IndexAddendum
+	for _, image := range images {
+		img, err := getRemoteImage(image.Image, cfg)
+		if err != nil {
+			return "", fmt.Errorf("getting image %q: %w", image.Image, err)
+		}
+
+		platform := image.Platform
+		adds = append(adds, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: &platform,
+			},
+		})
+	}
+
+	idx := mutate.IndexMediaType(mutate.AppendManifests(empty.Index, adds...), types.OCIImageIndex)
+	if err := writeIndex(targetRef, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, tag, err)
+	}
+
+	return digest(idx)
+}
+
 // RetrieveRemoteConfig retrieves the remote config file for an image
 func RetrieveRemoteConfig(identifier string, cfg Config) (*v1.ConfigFile, error) {
 	img, err := getRemoteImage(identifier, cfg)
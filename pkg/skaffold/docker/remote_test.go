@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 
@@ -151,6 +152,50 @@ func TestRemoteDigest(t *testing.T) {
 	}
 }
 
+func TestCreateManifestList(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		images := map[string]v1.Image{}
+		for _, image := range []string{"index.docker.io/library/image:v1_linux_amd64", "index.docker.io/library/image:v1_linux_arm64"} {
+			img, err := random.Image(10, 1)
+			t.RequireNoError(err)
+			images[image] = img
+		}
+		t.Override(&remoteImage, func(ref name.Reference, options ...remote.Option) (v1.Image, error) {
+			if img, found := images[ref.Name()]; found {
+				return img, nil
+			}
+			return nil, fmt.Errorf("not found: %s", ref.Name())
+		})
+		var pushed v1.ImageIndex
+		t.Override(&writeIndex, func(ref name.Reference, idx v1.ImageIndex, options ...remote.Option) error {
+			t.CheckDeepEqual("index.docker.io/library/image:v1", ref.Name())
+			pushed = idx
+			return nil
+		})
+
+		digest, err := CreateManifestList("image:v1", []PlatformImage{
+			{Platform: v1.Platform{OS: "linux", Architecture: "amd64"}, Image: "image:v1_linux_amd64"},
+			{Platform: v1.Platform{OS: "linux", Architecture: "arm64"}, Image: "image:v1_linux_arm64"},
+		}, &mockConfig{})
+		t.CheckNoError(err)
+
+		expectedDigest, err := pushed.Digest()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expectedDigest.String(), digest)
+
+		mediaType, err := pushed.MediaType()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(types.OCIImageIndex, mediaType)
+
+		manifest, err := pushed.IndexManifest()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(manifest.Manifests))
+		t.CheckDeepEqual("arm64", manifest.Manifests[1].Platform.Architecture)
+		armDigest, _ := images["index.docker.io/library/image:v1_linux_arm64"].Digest()
+		t.CheckDeepEqual(armDigest, manifest.Manifests[1].Digest)
+	})
+}
+
 type fakeImage struct {
 	v1.Image
 	Reference name.Reference
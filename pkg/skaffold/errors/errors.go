@@ -30,11 +30,13 @@ const (
 	// These are phases in a Skaffolld
 	Init        = Phase("Init")
 	Build       = Phase("Build")
+	Test        = Phase("Test")
 	Deploy      = Phase("Deploy")
 	StatusCheck = Phase("StatusCheck")
 	FileSync    = Phase("FileSync")
 	DevInit     = Phase("DevInit")
 	Cleanup     = Phase("Cleanup")
+	PortForward = Phase("PortForward")
 
 	// Report issue text
 	reportIssueText = "If above error is unexpected, please open an issue https://github.com/GoogleContainerTools/skaffold/issues/new to report this error"
@@ -141,6 +143,11 @@ var allErrors = map[Phase][]problem{
 		errCode:    proto.StatusCode_BUILD_UNKNOWN,
 		suggestion: reportIssueSuggestion,
 	}),
+	Test: {{
+		regexp:     re(".*"),
+		errCode:    proto.StatusCode_TEST_UNKNOWN,
+		suggestion: reportIssueSuggestion,
+	}},
 	Init: append(knownInitProblems, problem{
 		regexp:     re(".*"),
 		errCode:    proto.StatusCode_INIT_UNKNOWN,
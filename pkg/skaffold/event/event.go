@@ -17,16 +17,20 @@ limitations under the License.
 package event
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	//nolint:golint,staticcheck
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/sirupsen/logrus"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
@@ -37,22 +41,29 @@ import (
 )
 
 const (
-	NotStarted = "Not Started"
-	InProgress = "In Progress"
-	Complete   = "Complete"
-	Failed     = "Failed"
-	Info       = "Information"
-	Started    = "Started"
-	Succeeded  = "Succeeded"
-	Terminated = "Terminated"
-	Canceled   = "Canceled"
+	NotStarted  = "Not Started"
+	InProgress  = "In Progress"
+	Complete    = "Complete"
+	Failed      = "Failed"
+	Info        = "Information"
+	Started     = "Started"
+	Succeeded   = "Succeeded"
+	Terminated  = "Terminated"
+	Canceled    = "Canceled"
+	RolledBack  = "Rolled Back"
+	Reconnected = "Reconnected"
 )
 
+// logEventBuffer is how many application log lines can wait for the event listeners.
+// Further lines are dropped so that slow listeners never hold up the log output.
+const logEventBuffer = 1000
+
 var handler = newHandler()
 
 func newHandler() *eventHandler {
 	h := &eventHandler{
 		eventChan: make(chan firedEvent),
+		logChan:   make(chan firedEvent, logEventBuffer),
 	}
 	go func() {
 		for {
@@ -60,6 +71,11 @@ func newHandler() *eventHandler {
 			h.handleExec(ev)
 		}
 	}()
+	go func() {
+		for ev := range h.logChan {
+			h.handleExec(ev)
+		}
+	}()
 	return h
 }
 
@@ -71,6 +87,11 @@ type eventHandler struct {
 	stateLock sync.Mutex
 	eventChan chan firedEvent
 	listeners []*listener
+
+	// logChan queues application log lines, numbered per container.
+	logChan    chan firedEvent
+	logSeq     map[string]int64
+	logSeqLock sync.Mutex
 }
 
 type firedEvent struct {
@@ -115,19 +136,30 @@ func (ev *eventHandler) getState() proto.State {
 func (ev *eventHandler) logEvent(entry proto.LogEntry) {
 	ev.logLock.Lock()
 
+	ev.notifyListeners(&entry)
+	ev.eventLog = append(ev.eventLog, entry)
+
+	ev.logLock.Unlock()
+}
+
+// streamEvent sends an entry to the current listeners, without keeping it in the event log.
+func (ev *eventHandler) streamEvent(entry proto.LogEntry) {
+	ev.logLock.Lock()
+	ev.notifyListeners(&entry)
+	ev.logLock.Unlock()
+}
+
+func (ev *eventHandler) notifyListeners(entry *proto.LogEntry) {
 	for _, listener := range ev.listeners {
 		if listener.closed {
 			continue
 		}
 
-		if err := listener.callback(&entry); err != nil {
+		if err := listener.callback(entry); err != nil {
 			listener.errors <- err
 			listener.closed = true
 		}
 	}
-	ev.eventLog = append(ev.eventLog, entry)
-
-	ev.logLock.Unlock()
 }
 
 func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error {
@@ -188,6 +220,7 @@ func emptyStateWithArtifacts(builds map[string]string, metadata *proto.Metadata,
 // InitializeState instantiates the global state of the skaffold runner, as well as the event log.
 func InitializeState(c latest.Pipeline, kc string, autoBuild, autoDeploy, autoSync bool) {
 	handler.setState(emptyState(c, kc, autoBuild, autoDeploy, autoSync))
+	handler.resetApplicationLogs()
 }
 
 // DeployInProgress notifies that a deployment has been started.
@@ -207,6 +240,18 @@ func DeployFailed(err error) {
 		ActionableErr: aiErr})
 }
 
+// DeployRolledBack notifies that the deployed resources failed the status check and were restored to their previous version.
+func DeployRolledBack(err error) {
+	aiErr := sErrors.ActionableErr(sErrors.Deploy, err)
+	handler.stateLock.Lock()
+	handler.state.DeployState.StatusCode = aiErr.ErrCode
+	handler.stateLock.Unlock()
+	handler.handleDeployEvent(&proto.DeployEvent{Status: RolledBack,
+		Err:           err.Error(),
+		ErrCode:       aiErr.ErrCode,
+		ActionableErr: aiErr})
+}
+
 // DeployEvent notifies that a deployment of non fatal interesting errors during deploy.
 func DeployInfoEvent(err error) {
 	handler.handleDeployEvent(&proto.DeployEvent{Status: Info, Err: err.Error()})
@@ -302,6 +347,11 @@ func DeployComplete() {
 	handler.handleDeployEvent(&proto.DeployEvent{Status: Complete})
 }
 
+// BuildCacheMiss notifies that an artifact wasn't found in the cache, with the inputs that changed since its last build.
+func BuildCacheMiss(imageName string, reasons []string) {
+	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: NotStarted, CacheMissReasons: reasons})
+}
+
 // BuildInProgress notifies that a build has been started.
 func BuildInProgress(imageName string) {
 	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: InProgress})
@@ -328,6 +378,25 @@ func BuildComplete(imageName string) {
 	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: Complete})
 }
 
+// TestInProgress notifies that a test has been started on an artifact.
+func TestInProgress(imageName, testName string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Name: testName, Status: InProgress})
+}
+
+// TestFailed notifies that a test has failed.
+func TestFailed(imageName, testName string, err error) {
+	handler.handleTestEvent(&proto.TestEvent{
+		Artifact:      imageName,
+		Name:          testName,
+		Status:        Failed,
+		ActionableErr: sErrors.ActionableErr(sErrors.Test, err)})
+}
+
+// TestSucceeded notifies that a test has succeeded.
+func TestSucceeded(imageName, testName string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Name: testName, Status: Succeeded})
+}
+
 // DevLoopInProgress notifies that a dev loop has been started.
 func DevLoopInProgress(i int) {
 	handler.handleDevLoopEvent(&proto.DevLoopEvent{Iteration: int32(i), Status: InProgress})
@@ -388,9 +457,56 @@ func FileSyncSucceeded(fileCount int, image string) {
 	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Succeeded})
 }
 
+// FileSyncPodSynced notifies that files have been synced to a single container.
+func FileSyncPodSynced(fileCount int, image, podName, namespace, containerName string, duration time.Duration) {
+	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: InProgress,
+		PodSync: &proto.PodSync{PodName: podName, Namespace: namespace, ContainerName: containerName, DurationMs: duration.Milliseconds()}})
+}
+
 // PortForwarded notifies that a remote port has been forwarded locally.
 func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
-	event := proto.PortEvent{
+	event := portEvent(localPort, remotePort, podName, containerName, namespace, portName, resourceType, resourceName, address)
+	event.Status = Succeeded
+	handler.handlePortEvent(event)
+}
+
+// PortForwardReconnected notifies that a forwarded port was reconnected, usually to a pod that replaced the previous one.
+func PortForwardReconnected(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+	event := portEvent(localPort, remotePort, podName, containerName, namespace, portName, resourceType, resourceName, address)
+	event.Status = Reconnected
+	handler.handlePortEvent(event)
+}
+
+// PortForwardFailed notifies that a remote port couldn't be forwarded locally.
+func PortForwardFailed(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string, err error) {
+	event := portEvent(localPort, remotePort, podName, containerName, namespace, portName, resourceType, resourceName, address)
+	event.Status = Failed
+	event.ActionableErr = sErrors.ActionableErr(sErrors.PortForward, err)
+	handler.handlePortEvent(event)
+}
+
+// ApplicationLog notifies that a deployed container printed a log line.
+// It never blocks: lines are dropped when the listeners fall behind.
+func ApplicationLog(podName, containerName, namespace, image, timestamp, level, message string) {
+	handler.handleApplicationLog(&proto.ApplicationLogEvent{
+		PodName:       podName,
+		ContainerName: containerName,
+		Namespace:     namespace,
+		Image:         image,
+		Timestamp:     timestamp,
+		Level:         level,
+		Message:       message,
+	})
+}
+
+// ApplicationLogEnded notifies that the log stream of a container has ended.
+// The numbering of its lines starts over if it's streamed again.
+func ApplicationLogEnded(podName, containerName, namespace string) {
+	handler.handleApplicationLogEnded(logKey(namespace, podName, containerName))
+}
+
+func portEvent(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) *proto.PortEvent {
+	event := &proto.PortEvent{
 		LocalPort:     localPort,
 		PodName:       podName,
 		ContainerName: containerName,
@@ -408,11 +524,7 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 	if remotePort.Type == util.Int {
 		event.RemotePort = int32(remotePort.IntVal)
 	}
-	handler.handle(&proto.Event{
-		EventType: &proto.Event_PortEvent{
-			PortEvent: &event,
-		},
-	})
+	return event
 }
 
 // DebuggingContainerStarted notifies that a debuggable container has appeared.
@@ -481,6 +593,14 @@ func (ev *eventHandler) handleResourceStatusCheckEvent(e *proto.ResourceStatusCh
 	})
 }
 
+func (ev *eventHandler) handlePortEvent(e *proto.PortEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_PortEvent{
+			PortEvent: e,
+		},
+	})
+}
+
 func (ev *eventHandler) handleBuildEvent(e *proto.BuildEvent) {
 	ev.handle(&proto.Event{
 		EventType: &proto.Event_BuildEvent{
@@ -489,6 +609,14 @@ func (ev *eventHandler) handleBuildEvent(e *proto.BuildEvent) {
 	})
 }
 
+func (ev *eventHandler) handleTestEvent(e *proto.TestEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_TestEvent{
+			TestEvent: e,
+		},
+	})
+}
+
 func (ev *eventHandler) handleDevLoopEvent(e *proto.DevLoopEvent) {
 	ev.handle(&proto.Event{
 		EventType: &proto.Event_DevLoopEvent{
@@ -520,6 +648,42 @@ func LogMetaEvent() {
 	})
 }
 
+// handleApplicationLog numbers a log line within its container's log and queues it.
+// The listeners can tell dropped lines by the gaps in the sequence numbers.
+func (ev *eventHandler) handleApplicationLog(e *proto.ApplicationLogEvent) {
+	ev.logSeqLock.Lock()
+	defer ev.logSeqLock.Unlock()
+
+	if ev.logSeq == nil {
+		ev.logSeq = map[string]int64{}
+	}
+	key := logKey(e.Namespace, e.PodName, e.ContainerName)
+	ev.logSeq[key]++
+	e.Sequence = ev.logSeq[key]
+
+	select {
+	case ev.logChan <- firedEvent{event: &proto.Event{EventType: &proto.Event_ApplicationLogEvent{ApplicationLogEvent: e}}, ts: ptypes.TimestampNow()}:
+	default:
+		logrus.Tracef("dropping log event of %s: event listeners are falling behind", key)
+	}
+}
+
+func (ev *eventHandler) handleApplicationLogEnded(key string) {
+	ev.logSeqLock.Lock()
+	delete(ev.logSeq, key)
+	ev.logSeqLock.Unlock()
+}
+
+func (ev *eventHandler) resetApplicationLogs() {
+	ev.logSeqLock.Lock()
+	ev.logSeq = nil
+	ev.logSeqLock.Unlock()
+}
+
+func logKey(namespace, podName, containerName string) string {
+	return namespace + "/" + podName + "/" + containerName
+}
+
 func (ev *eventHandler) handle(event *proto.Event) {
 	go func(t *timestamp.Timestamp) {
 		ev.eventChan <- firedEvent{
@@ -542,6 +706,10 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		ev.state.BuildState.Artifacts[be.Artifact] = be.Status
 		ev.stateLock.Unlock()
 		switch be.Status {
+		case NotStarted:
+			if len(be.CacheMissReasons) > 0 {
+				logEntry.Entry = fmt.Sprintf("Cache miss for artifact %s: %s", be.Artifact, strings.Join(be.CacheMissReasons, ", "))
+			}
 		case InProgress:
 			logEntry.Entry = fmt.Sprintf("Build started for artifact %s", be.Artifact)
 		case Complete:
@@ -564,6 +732,8 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		case Failed:
 			logEntry.Entry = "Deploy failed"
 			// logEntry.Err = de.Err
+		case RolledBack:
+			logEntry.Entry = "Deploy rolled back"
 		default:
 		}
 	case *proto.Event_PortEvent:
@@ -571,7 +741,14 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		ev.stateLock.Lock()
 		ev.state.ForwardedPorts[pe.LocalPort] = pe
 		ev.stateLock.Unlock()
-		logEntry.Entry = fmt.Sprintf("Forwarding container %s to local port %d", pe.ContainerName, pe.LocalPort)
+		switch pe.Status {
+		case Reconnected:
+			logEntry.Entry = fmt.Sprintf("Reconnected forwarding of %s/%s to local port %d", pe.ResourceType, pe.ResourceName, pe.LocalPort)
+		case Failed:
+			logEntry.Entry = fmt.Sprintf("Port forwarding of %s/%s to local port %d failed", pe.ResourceType, pe.ResourceName, pe.LocalPort)
+		default:
+			logEntry.Entry = fmt.Sprintf("Forwarding container %s to local port %d", pe.ContainerName, pe.LocalPort)
+		}
 	case *proto.Event_StatusCheckEvent:
 		se := e.StatusCheckEvent
 		ev.stateLock.Lock()
@@ -612,6 +789,10 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		ev.stateLock.Unlock()
 		switch fse.Status {
 		case InProgress:
+			if ps := fse.PodSync; ps != nil {
+				logEntry.Entry = fmt.Sprintf("File sync to %s/%s/%s took %dms for %s", ps.Namespace, ps.PodName, ps.ContainerName, ps.DurationMs, fseImage)
+				break
+			}
 			logEntry.Entry = fmt.Sprintf("File sync started for %d files for %s", fseFileCount, fseImage)
 		case Succeeded:
 			logEntry.Entry = fmt.Sprintf("File sync succeeded for %d files for %s", fseFileCount, fseImage)
@@ -619,6 +800,16 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 			logEntry.Entry = fmt.Sprintf("File sync failed for %d files for %s", fseFileCount, fseImage)
 		default:
 		}
+	case *proto.Event_TestEvent:
+		te := e.TestEvent
+		switch te.Status {
+		case InProgress:
+			logEntry.Entry = fmt.Sprintf("Test %s started for %s", te.Name, te.Artifact)
+		case Succeeded:
+			logEntry.Entry = fmt.Sprintf("Test %s succeeded for %s", te.Name, te.Artifact)
+		case Failed:
+			logEntry.Entry = fmt.Sprintf("Test %s failed for %s", te.Name, te.Artifact)
+		}
 	case *proto.Event_DebuggingContainerEvent:
 		de := e.DebuggingContainerEvent
 		ev.stateLock.Lock()
@@ -652,6 +843,10 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		case Failed:
 			logEntry.Entry = fmt.Sprintf("Update failed with error code %v", de.Err.ErrCode)
 		}
+	case *proto.Event_ApplicationLogEvent:
+		logEntry.Entry = e.ApplicationLogEvent.Message
+		ev.streamEvent(*logEntry)
+		return
 	default:
 		return
 	}
@@ -670,6 +865,14 @@ func ResetStateOnBuild() {
 	handler.setState(newState)
 }
 
+// ResetStateOnConfigReload resets the build state and metadata to match the reloaded pipeline
+func ResetStateOnConfigReload(p latest.Pipeline, kubeContext string) {
+	state := handler.getState()
+	autoBuild, autoDeploy, autoSync := state.BuildState.AutoTrigger, state.DeployState.AutoTrigger, state.FileSyncState.AutoTrigger
+	newState := emptyState(p, kubeContext, autoBuild, autoDeploy, autoSync)
+	handler.setState(newState)
+}
+
 // ResetStateOnDeploy resets the deploy, sync and status check state
 func ResetStateOnDeploy() {
 	newState := handler.getState()
@@ -760,3 +963,31 @@ func SaveEventsToFile(fp string) error {
 	handler.logLock.Unlock()
 	return nil
 }
+
+// LoadEventsFromFile reads an event log saved with SaveEventsToFile
+func LoadEventsFromFile(fp string) ([]*proto.LogEntry, error) {
+	f, err := os.Open(fp)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", fp, err)
+	}
+	defer f.Close()
+
+	var entries []*proto.LogEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		entry := &proto.LogEntry{}
+		if err := jsonpb.UnmarshalString(line, entry); err != nil {
+			return nil, fmt.Errorf("unmarshalling event: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fp, err)
+	}
+	return entries, nil
+}
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -65,6 +66,100 @@ func TestGetLogEvents(t *testing.T) {
 	}
 }
 
+func TestApplicationLog(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	received := make(chan *proto.LogEntry, 1)
+	go handler.forEachEvent(func(e *proto.LogEntry) error {
+		received <- e
+		return nil
+	})
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		return len(handler.listeners) == 1
+	})
+
+	ApplicationLog("pod", "container", "ns", "image", "2021-01-12T10:00:00Z", "info", "hello")
+
+	entry := <-received
+	testutil.CheckDeepEqual(t, "hello", entry.Entry)
+	testutil.CheckDeepEqual(t, "pod", entry.Event.GetApplicationLogEvent().PodName)
+	testutil.CheckDeepEqual(t, "info", entry.Event.GetApplicationLogEvent().Level)
+	testutil.CheckDeepEqual(t, int64(1), entry.Event.GetApplicationLogEvent().Sequence)
+
+	// Application logs are not replayed to new listeners.
+	handler.logLock.Lock()
+	testutil.CheckDeepEqual(t, 0, len(handler.eventLog))
+	handler.logLock.Unlock()
+}
+
+func TestApplicationLogEnded(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	InitializeState(latest.Pipeline{}, "test", true, true, true)
+
+	ApplicationLog("pod", "container", "ns", "image", "2021-01-12T10:00:00Z", "info", "hello")
+	ApplicationLog("other", "container", "ns", "image", "2021-01-12T10:00:00Z", "info", "hello")
+	testutil.CheckDeepEqual(t, 2, len(handler.logSeq))
+
+	ApplicationLogEnded("pod", "container", "ns")
+	testutil.CheckDeepEqual(t, map[string]int64{"ns/other/container": 1}, handler.logSeq)
+
+	InitializeState(latest.Pipeline{}, "test", true, true, true)
+	testutil.CheckDeepEqual(t, 0, len(handler.logSeq))
+}
+
+func TestApplicationLogSlowListener(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	unblock := make(chan bool)
+	var lock sync.Mutex
+	var sequences []int64
+	go handler.forEachEvent(func(e *proto.LogEntry) error {
+		<-unblock
+		lock.Lock()
+		sequences = append(sequences, e.Event.GetApplicationLogEvent().Sequence)
+		lock.Unlock()
+		return nil
+	})
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		return len(handler.listeners) == 1
+	})
+
+	// The listener is stuck: lines that don't fit in the buffer are dropped instead of blocking.
+	for i := 0; i < 2*logEventBuffer; i++ {
+		ApplicationLog("pod", "container", "ns", "image", "2021-01-12T10:00:00Z", "info", "hello")
+	}
+	close(unblock)
+
+	wait(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(sequences) >= logEventBuffer
+	})
+	lock.Lock()
+	defer lock.Unlock()
+	testutil.CheckDeepEqual(t, int64(1), sequences[0])
+	for i := 1; i < len(sequences); i++ {
+		if sequences[i] <= sequences[i-1] {
+			t.Fatalf("log events out of order: %d after %d", sequences[i], sequences[i-1])
+		}
+	}
+	if len(sequences) >= 2*logEventBuffer {
+		t.Fatalf("expected log events to be dropped, got %d", len(sequences))
+	}
+}
+
 func TestGetState(t *testing.T) {
 	ev := newHandler()
 	ev.state = emptyState(latest.Pipeline{}, "test", true, true, true)
@@ -103,6 +198,20 @@ func TestDeployFailed(t *testing.T) {
 	})
 }
 
+func TestDeployRolledBack(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	wait(t, func() bool { return handler.getState().DeployState.Status == NotStarted })
+	DeployRolledBack(sErrors.NewError(errors.New("crash loop"), proto.ActionableErr{Message: "crash loop", ErrCode: proto.StatusCode_DEPLOY_ROLLED_BACK}))
+	wait(t, func() bool {
+		dState := handler.getState().DeployState
+		return dState.Status == RolledBack && dState.StatusCode == proto.StatusCode_DEPLOY_ROLLED_BACK
+	})
+}
+
 func TestDeployComplete(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -182,6 +291,39 @@ func TestPortForwarded(t *testing.T) {
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] != nil })
 }
 
+func TestPortForwardReconnected(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "service", "svc", "127.0.0.1")
+	wait(t, func() bool {
+		return handler.getState().ForwardedPorts[8080] != nil && handler.getState().ForwardedPorts[8080].Status == Succeeded
+	})
+
+	PortForwardReconnected(8080, schemautil.FromInt(8888), "pod2", "container", "ns", "portname", "service", "svc", "127.0.0.1")
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe.Status == Reconnected && pe.PodName == "pod2"
+	})
+}
+
+func TestPortForwardFailed(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] == nil })
+	PortForwardFailed(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "service", "svc", "127.0.0.1",
+		sErrors.NewError(errors.New("lost connection"), proto.ActionableErr{Message: "lost connection", ErrCode: proto.StatusCode_PORTFORWARD_CONNECTION_ERR}))
+	wait(t, func() bool {
+		pe := handler.getState().ForwardedPorts[8080]
+		return pe != nil && pe.Status == Failed && pe.ActionableErr.ErrCode == proto.StatusCode_PORTFORWARD_CONNECTION_ERR
+	})
+}
+
 func TestStatusCheckEventStarted(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -301,6 +443,52 @@ func TestFileSyncSucceeded(t *testing.T) {
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == Succeeded })
 }
 
+func TestFileSyncPodSynced(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	lastEntry := func() proto.LogEntry {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return proto.LogEntry{}
+		}
+		return handler.eventLog[len(handler.eventLog)-1]
+	}
+
+	FileSyncPodSynced(5, "image", "pod", "ns", "container", 1500*time.Millisecond)
+	wait(t, func() bool { return lastEntry().Entry == "File sync to ns/pod/container took 1500ms for image" })
+	wait(t, func() bool { return handler.getState().FileSyncState.Status == InProgress })
+}
+
+func TestTestEvents(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	lastEntry := func() proto.LogEntry {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return proto.LogEntry{}
+		}
+		return handler.eventLog[len(handler.eventLog)-1]
+	}
+
+	TestInProgress("img", "unit")
+	wait(t, func() bool { return lastEntry().Entry == "Test unit started for img" })
+	TestSucceeded("img", "unit")
+	wait(t, func() bool { return lastEntry().Entry == "Test unit succeeded for img" })
+	TestFailed("img", "unit", errors.New("BUG"))
+	wait(t, func() bool {
+		te := lastEntry().Event.GetTestEvent()
+		return te.GetStatus() == Failed && te.GetActionableErr().GetErrCode() == proto.StatusCode_TEST_UNKNOWN
+	})
+}
+
 func TestDebuggingContainer(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -598,3 +786,32 @@ func TestSaveEventsToFile(t *testing.T) {
 	testutil.CheckDeepEqual(t, 1, buildCompleteEvent)
 	testutil.CheckDeepEqual(t, 1, devLoopCompleteEvent)
 }
+
+func TestLoadEventsFromFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("events.log", `{"timestamp":"2020-12-01T10:00:00Z","event":{"devLoopEvent":{"iteration":1,"status":"In Progress"}},"entry":"Update initiated"}
+
+{"timestamp":"2020-12-01T10:00:03Z","event":{"buildEvent":{"artifact":"img","status":"Complete"}},"entry":"Build completed for artifact img"}
+`)
+
+		entries, err := LoadEventsFromFile(tmpDir.Path("events.log"))
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(entries))
+		t.CheckDeepEqual(int32(1), entries[0].GetEvent().GetDevLoopEvent().GetIteration())
+		t.CheckDeepEqual("img", entries[1].GetEvent().GetBuildEvent().GetArtifact())
+		t.CheckDeepEqual("Build completed for artifact img", entries[1].Entry)
+	})
+}
+
+func TestLoadEventsFromFileErrors(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("events.log", "not json\n")
+
+		_, err := LoadEventsFromFile(tmpDir.Path("events.log"))
+		t.CheckErrorContains("unmarshalling event", err)
+
+		_, err = LoadEventsFromFile(tmpDir.Path("missing.log"))
+		t.CheckErrorContains("opening", err)
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// BuildRunner creates the runner of the build hooks of an artifact.
+// Build hooks run on the host, in the artifact's build context.
+func BuildRunner(h latest.BuildHooks, opts BuildEnvOpts) Runner {
+	newHooks := func(hs []latest.HostHook) []hook {
+		var hooks []hook
+		for _, cfg := range hs {
+			hooks = append(hooks, hostHook{cfg: cfg, dir: opts.BuildContext, env: opts.env()})
+		}
+		return hooks
+	}
+
+	return runner{
+		preHooks:  newHooks(h.PreHooks),
+		postHooks: newHooks(h.PostHooks),
+		pre:       preBuild,
+		post:      postBuild,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerHook runs a command, with `kubectl exec`, in every running container
+// that matches its selector.
+type containerHook struct {
+	command  []string
+	cfg      Config
+	selector func(v1.Pod, v1.Container) bool
+}
+
+func (h containerHook) run(ctx context.Context, out io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return listPodsErr(err)
+	}
+
+	cli := kubectl.NewCLI(h.cfg, "")
+	for _, ns := range h.cfg.GetNamespaces() {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return listPodsErr(err)
+		}
+
+		for _, p := range pods.Items {
+			if p.Status.Phase != v1.PodRunning {
+				continue
+			}
+
+			for _, c := range p.Spec.Containers {
+				if !h.selector(p, c) {
+					continue
+				}
+
+				args := append([]string{p.Name, "--namespace", p.Namespace, "-c", c.Name, "--"}, h.command...)
+				cmd := cli.Command(ctx, "exec", args...)
+				cmd.Stdout = out
+				cmd.Stderr = out
+				if err := util.RunCmd(cmd); err != nil {
+					return hookErr(ctx, h.command, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// imageSelector selects the containers running the given image.
+func imageSelector(image string) func(v1.Pod, v1.Container) bool {
+	return func(_ v1.Pod, c v1.Container) bool {
+		return c.Image == image
+	}
+}
+
+// nameSelector selects the containers whose pod and container names match the given patterns.
+// An empty container name pattern matches all the containers of a pod.
+func nameSelector(podName, containerName string) func(v1.Pod, v1.Container) bool {
+	return func(p v1.Pod, c v1.Container) bool {
+		if matched, _ := filepath.Match(podName, p.Name); !matched {
+			return false
+		}
+		if containerName == "" {
+			return true
+		}
+		matched, _ := filepath.Match(containerName, c.Name)
+		return matched
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncContainerHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(
+				pod("web-1", v1.PodRunning, "web", "gcr.io/web:tag"),
+				pod("web-2", v1.PodPending, "web", "gcr.io/web:tag"),
+				pod("db-1", v1.PodRunning, "db", "gcr.io/db:tag"),
+			), nil
+		})
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("kubectl --context kubecontext exec web-1 --namespace ns -c web -- npm run build"))
+
+		r := SyncRunner(&mockConfig{namespaces: []string{"ns"}}, latest.SyncHooks{
+			PostHooks: []latest.SyncHookItem{{ContainerHook: &latest.ContainerHook{Command: []string{"npm", "run", "build"}}}},
+		}, SyncEnvOpts{Image: "gcr.io/web:tag"})
+
+		t.CheckNoError(r.RunPreHooks(context.Background(), &bytes.Buffer{}))
+		t.CheckNoError(r.RunPostHooks(context.Background(), &bytes.Buffer{}))
+	})
+}
+
+func TestDeployContainerHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(
+				pod("web-1", v1.PodRunning, "web", "gcr.io/web:tag"),
+				pod("db-1", v1.PodRunning, "db", "gcr.io/db:tag"),
+				pod("db-2", v1.PodRunning, "db", "gcr.io/db:tag"),
+			), nil
+		})
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("kubectl --context kubecontext exec db-1 --namespace ns -c db -- ./seed.sh").
+			AndRun("kubectl --context kubecontext exec db-2 --namespace ns -c db -- ./seed.sh"))
+
+		r := DeployRunner(&mockConfig{namespaces: []string{"ns"}}, latest.DeployHooks{
+			PostHooks: []latest.DeployHookItem{{ContainerHook: &latest.NamedContainerHook{
+				ContainerHook: latest.ContainerHook{Command: []string{"./seed.sh"}},
+				PodName:       "db-*",
+				ContainerName: "db",
+			}}},
+		}, DeployEnvOpts{})
+
+		t.CheckNoError(r.RunPostHooks(context.Background(), &bytes.Buffer{}))
+	})
+}
+
+func pod(name string, phase v1.PodPhase, container, image string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Status:     v1.PodStatus{Phase: phase},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: container, Image: image}},
+		},
+	}
+}
+
+type mockConfig struct {
+	namespaces []string
+}
+
+func (c *mockConfig) GetKubeContext() string   { return "kubecontext" }
+func (c *mockConfig) GetKubeConfig() string    { return "" }
+func (c *mockConfig) GetKubeNamespace() string { return "" }
+func (c *mockConfig) GetNamespaces() []string  { return c.namespaces }
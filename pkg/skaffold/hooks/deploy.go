@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// DeployRunner creates the runner of the deploy hooks.
+// Container hooks run in the containers selected by their pod and container names.
+func DeployRunner(cfg Config, h latest.DeployHooks, opts DeployEnvOpts) Runner {
+	newHooks := func(items []latest.DeployHookItem) []hook {
+		var hooks []hook
+		for _, item := range items {
+			switch {
+			case item.HostHook != nil:
+				hooks = append(hooks, hostHook{cfg: *item.HostHook, env: opts.env()})
+			case item.ContainerHook != nil:
+				c := item.ContainerHook
+				hooks = append(hooks, containerHook{command: c.Command, cfg: cfg, selector: nameSelector(c.PodName, c.ContainerName)})
+			}
+		}
+		return hooks
+	}
+
+	return runner{
+		preHooks:  newHooks(h.PreHooks),
+		postHooks: newHooks(h.PostHooks),
+		pre:       preDeploy,
+		post:      postDeploy,
+	}
+}
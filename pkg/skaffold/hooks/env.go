@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// BuildEnvOpts contains the environment variables passed to build hooks.
+type BuildEnvOpts struct {
+	Image        string
+	PushImage    bool
+	ImageRepo    string
+	ImageTag     string
+	BuildContext string
+}
+
+// SyncEnvOpts contains the environment variables passed to sync hooks.
+type SyncEnvOpts struct {
+	Image                string
+	BuildContext         string
+	FilesAddedOrModified []string
+	FilesDeleted         []string
+	KubeContext          string
+	Namespaces           []string
+}
+
+// DeployEnvOpts contains the environment variables passed to deploy hooks.
+type DeployEnvOpts struct {
+	RunID       string
+	KubeContext string
+	Namespaces  []string
+}
+
+// NewBuildEnvOpts creates the environment of the build hooks of an artifact.
+func NewBuildEnvOpts(a *latest.Artifact, image string, pushImage bool) (BuildEnvOpts, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return BuildEnvOpts{}, fmt.Errorf("parsing image %q: %w", image, err)
+	}
+	w, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return BuildEnvOpts{}, fmt.Errorf("determining build context for %q: %w", a.ImageName, err)
+	}
+
+	return BuildEnvOpts{
+		Image:        image,
+		PushImage:    pushImage,
+		ImageRepo:    ref.Repo,
+		ImageTag:     ref.Tag,
+		BuildContext: w,
+	}, nil
+}
+
+func (o BuildEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_PUSH_IMAGE=" + strconv.FormatBool(o.PushImage),
+		"SKAFFOLD_IMAGE_REPO=" + o.ImageRepo,
+		"SKAFFOLD_IMAGE_TAG=" + o.ImageTag,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+	}
+}
+
+func (o SyncEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+		"SKAFFOLD_FILES_ADDED_OR_MODIFIED=" + strings.Join(o.FilesAddedOrModified, ","),
+		"SKAFFOLD_FILES_DELETED=" + strings.Join(o.FilesDeleted, ","),
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + strings.Join(o.Namespaces, ","),
+	}
+}
+
+func (o DeployEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_RUN_ID=" + o.RunID,
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + strings.Join(o.Namespaces, ","),
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+func hookErr(ctx context.Context, command []string, err error) error {
+	cmd := strings.Join(command, " ")
+	if ctx.Err() != nil {
+		return sErrors.NewError(err,
+			proto.ActionableErr{
+				Message: fmt.Sprintf("hook %q was cancelled", cmd),
+				ErrCode: proto.StatusCode_HOOK_CANCELLED_ERR,
+			})
+	}
+	if _, ok := err.(*exec.ExitError); ok {
+		return sErrors.NewError(err,
+			proto.ActionableErr{
+				Message: fmt.Sprintf("hook %q failed: %s", cmd, err),
+				ErrCode: proto.StatusCode_HOOK_NON_ZERO_EXIT_ERR,
+			})
+	}
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("running hook %q: %s", cmd, err),
+			ErrCode: proto.StatusCode_HOOK_EXECUTION_ERR,
+		})
+}
+
+func listPodsErr(err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("listing pods to run container hooks: %s", err),
+			ErrCode: proto.StatusCode_HOOK_LIST_PODS_ERR,
+		})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+)
+
+// runner is the Runner implementation shared by all the phases.
+type runner struct {
+	preHooks  []hook
+	postHooks []hook
+	pre       phase
+	post      phase
+}
+
+func (r runner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return run(ctx, out, r.pre, r.preHooks)
+}
+
+func (r runner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return run(ctx, out, r.post, r.postHooks)
+}
+
+func run(ctx context.Context, out io.Writer, p phase, hooks []hook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Starting %s hooks...\n", p)
+	w := newPrefixWriter(out, fmt.Sprintf("[%s] ", p))
+	for _, h := range hooks {
+		err := h.run(ctx, w)
+		w.Flush()
+		if err != nil {
+			return err
+		}
+	}
+	color.Default.Fprintf(out, "Completed %s hooks\n", p)
+	return nil
+}
+
+// prefixWriter prefixes every line written to the underlying writer.
+type prefixWriter struct {
+	out    io.Writer
+	prefix string
+	buf    bytes.Buffer
+}
+
+func newPrefixWriter(out io.Writer, prefix string) *prefixWriter {
+	return &prefixWriter{out: out, prefix: prefix}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := w.buf.Next(i + 1)
+		if _, err := fmt.Fprintf(w.out, "%s%s", w.prefix, line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Flush writes any incomplete last line.
+func (w *prefixWriter) Flush() {
+	if w.buf.Len() > 0 {
+		fmt.Fprintf(w.out, "%s%s\n", w.prefix, w.buf.Bytes())
+		w.buf.Reset()
+	}
+}
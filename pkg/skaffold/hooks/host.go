@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"runtime"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// hostHook runs a command on the host machine.
+type hostHook struct {
+	cfg latest.HostHook
+	dir string
+	env []string
+}
+
+func (h hostHook) run(ctx context.Context, out io.Writer) error {
+	if len(h.cfg.OS) > 0 && !util.StrSliceContains(h.cfg.OS, runtime.GOOS) {
+		logrus.Debugf("Skipping hook %v: it only runs on %v", h.cfg.Command, h.cfg.OS)
+		return nil
+	}
+
+	cmd := exec.CommandContext(ctx, h.cfg.Command[0], h.cfg.Command[1:]...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Dir = h.dir
+	cmd.Env = append(h.env, util.OSEnviron()...)
+
+	if err := util.RunCmd(cmd); err != nil {
+		return hookErr(ctx, h.cfg.Command, err)
+	}
+	return nil
+}
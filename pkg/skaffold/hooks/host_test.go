@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return []string{"PATH=/bin"} })
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunEnv("protoc --go_out=. api.proto", []string{
+				"SKAFFOLD_IMAGE=gcr.io/foo/img:tag",
+				"SKAFFOLD_PUSH_IMAGE=true",
+				"SKAFFOLD_IMAGE_REPO=gcr.io/foo",
+				"SKAFFOLD_IMAGE_TAG=tag",
+				"SKAFFOLD_BUILD_CONTEXT=/workspace",
+				"PATH=/bin",
+			}).
+			AndRunWithOutput("echo done", "done\n"))
+
+		r := BuildRunner(latest.BuildHooks{
+			PreHooks:  []latest.HostHook{{Command: []string{"protoc", "--go_out=.", "api.proto"}}},
+			PostHooks: []latest.HostHook{{Command: []string{"echo", "done"}}},
+		}, BuildEnvOpts{
+			Image:        "gcr.io/foo/img:tag",
+			PushImage:    true,
+			ImageRepo:    "gcr.io/foo",
+			ImageTag:     "tag",
+			BuildContext: "/workspace",
+		})
+
+		var out bytes.Buffer
+		t.CheckNoError(r.RunPreHooks(context.Background(), &out))
+		t.CheckNoError(r.RunPostHooks(context.Background(), &out))
+		t.CheckDeepEqual("Starting pre-build hooks...\nCompleted pre-build hooks\nStarting post-build hooks...\n[post-build] done\nCompleted post-build hooks\n", out.String())
+	})
+}
+
+func TestHostHookOS(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("echo "+runtime.GOOS))
+
+		r := BuildRunner(latest.BuildHooks{
+			PreHooks: []latest.HostHook{
+				{Command: []string{"echo", "other"}, OS: []string{"other"}},
+				{Command: []string{"echo", runtime.GOOS}, OS: []string{"other", runtime.GOOS}},
+			},
+		}, BuildEnvOpts{})
+
+		t.CheckNoError(r.RunPreHooks(context.Background(), &bytes.Buffer{}))
+	})
+}
+
+func TestHostHookError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr("missing-cmd", errors.New("not found")))
+
+		r := BuildRunner(latest.BuildHooks{
+			PreHooks: []latest.HostHook{{Command: []string{"missing-cmd"}}},
+		}, BuildEnvOpts{})
+		err := r.RunPreHooks(context.Background(), &bytes.Buffer{})
+
+		t.CheckErrorContains(`running hook "missing-cmd": not found`, err)
+		t.CheckDeepEqual(proto.StatusCode_HOOK_EXECUTION_ERR, err.(sErrors.Error).StatusCode())
+	})
+}
+
+func TestPrefixWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		w := newPrefixWriter(&out, "[hook] ")
+
+		w.Write([]byte("first\nsec"))
+		w.Write([]byte("ond\nlast"))
+		w.Flush()
+
+		t.CheckDeepEqual("[hook] first\n[hook] second\n[hook] last\n", out.String())
+	})
+}
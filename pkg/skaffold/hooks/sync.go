@@ -0,0 +1,45 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// SyncRunner creates the runner of the sync hooks of an artifact.
+// Container hooks run in every container of the synced image.
+func SyncRunner(cfg Config, h latest.SyncHooks, opts SyncEnvOpts) Runner {
+	newHooks := func(items []latest.SyncHookItem) []hook {
+		var hooks []hook
+		for _, item := range items {
+			switch {
+			case item.HostHook != nil:
+				hooks = append(hooks, hostHook{cfg: *item.HostHook, dir: opts.BuildContext, env: opts.env()})
+			case item.ContainerHook != nil:
+				hooks = append(hooks, containerHook{command: item.ContainerHook.Command, cfg: cfg, selector: imageSelector(opts.Image)})
+			}
+		}
+		return hooks
+	}
+
+	return runner{
+		preHooks:  newHooks(h.PreHooks),
+		postHooks: newHooks(h.PostHooks),
+		pre:       preSync,
+		post:      postSync,
+	}
+}
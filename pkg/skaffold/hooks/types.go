@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+)
+
+// Runner runs the lifecycle hooks defined for a phase.
+type Runner interface {
+	// RunPreHooks runs the hooks that are executed before the phase.
+	RunPreHooks(ctx context.Context, out io.Writer) error
+	// RunPostHooks runs the hooks that are executed after the phase.
+	RunPostHooks(ctx context.Context, out io.Writer) error
+}
+
+// Config holds the parameters needed to run hooks in containers.
+type Config interface {
+	kubectl.Config
+
+	GetNamespaces() []string
+}
+
+type hook interface {
+	run(ctx context.Context, out io.Writer) error
+}
+
+type phase string
+
+var (
+	preBuild   phase = "pre-build"
+	postBuild  phase = "post-build"
+	preSync    phase = "pre-sync"
+	postSync   phase = "post-sync"
+	preDeploy  phase = "pre-deploy"
+	postDeploy phase = "post-deploy"
+)
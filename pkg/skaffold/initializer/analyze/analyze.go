@@ -68,6 +68,11 @@ func (a *ProjectAnalysis) ChartPaths() []string {
 	return a.helmAnalyzer.chartPaths
 }
 
+// ChartValuesFiles returns the additional values files found next to each chart, keyed on chart path.
+func (a *ProjectAnalysis) ChartValuesFiles() map[string][]string {
+	return a.helmAnalyzer.chartValuesFiles()
+}
+
 func (a *ProjectAnalysis) analyzers() []analyzer {
 	return []analyzer{
 		a.kubeAnalyzer,
@@ -89,6 +94,9 @@ func NewAnalyzer(c config.Config) *ProjectAnalysis {
 			enableJibInit:        c.EnableJibInit,
 			enableJibGradleInit:  c.EnableJibGradleInit,
 			enableBuildpacksInit: c.EnableBuildpacksInit,
+			enableBazelInit:      c.EnableBazelInit,
+			enableKoInit:         c.EnableKoInit,
+			enableCustomInit:     c.EnableCustomInit,
 			buildpacksBuilder:    c.BuildpacksBuilder,
 		},
 		configAnalyzer: &skaffoldConfigAnalyzer{
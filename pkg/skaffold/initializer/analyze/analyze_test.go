@@ -144,6 +144,31 @@ func TestAnalyze(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			description: "should detect bazel targets, go main packages and custom build scripts",
+			filesWithContents: map[string]string{
+				"WORKSPACE":        emptyFile,
+				"cmd/app/main.go":  "package main\n\nfunc main() {}\n",
+				"cmd/app/flags.go": "package main\n",
+				"app/BUILD.bazel":  "container_image(\n    name = \"image\",\n)\n",
+				"app/Dockerfile":   emptyFile,
+				"web/Makefile":     "image:\n\tdocker build -t $(IMAGE) .\n",
+				"scripts/build.sh": "docker build -t $IMAGE .",
+				"scripts/test.sh":  "go test ./...",
+			},
+			config: initconfig.Config{
+				EnableBazelInit:  true,
+				EnableKoInit:     true,
+				EnableCustomInit: true,
+			},
+			expectedBuilders: []builder{
+				{name: "Bazel", path: "app/BUILD.bazel"},
+				{name: "Docker", path: "app/Dockerfile"},
+				{name: "Ko", path: "cmd/app/main.go"},
+				{name: "Custom", path: "scripts/build.sh"},
+				{name: "Custom", path: "web/Makefile"},
+			},
+		},
 		{
 			description: "skip validating nested jib configs",
 			filesWithContents: map[string]string{
@@ -409,6 +434,30 @@ deploy:
 	}
 }
 
+func TestAnalyzeHelmCharts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().WriteFiles(map[string]string{
+			"charts/app/Chart.yaml":       "",
+			"charts/app/values.yaml":      "",
+			"charts/app/values-dev.yaml":  "",
+			"charts/app/values.prod.yml":  "",
+			"charts/app/templates/a.yaml": "",
+			"charts/db/Chart.yaml":        "",
+			"values-other.yaml":           "",
+		}).Chdir()
+
+		a := NewAnalyzer(initconfig.Config{SkipBuild: true})
+		err := a.Analyze(".")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"charts/app/Chart.yaml", "charts/db/Chart.yaml"}, a.ChartPaths())
+		t.CheckDeepEqual(map[string][]string{
+			"charts/app/Chart.yaml": {"charts/app/values-dev.yaml", "charts/app/values.prod.yml"},
+			"charts/db/Chart.yaml":  nil,
+		}, a.ChartValuesFiles())
+	})
+}
+
 func fakeValidateDockerfile(path string) bool {
 	return strings.Contains(strings.ToLower(path), "dockerfile")
 }
@@ -20,8 +20,11 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/build"
 )
@@ -31,6 +34,9 @@ type builderAnalyzer struct {
 	enableJibInit        bool
 	enableJibGradleInit  bool
 	enableBuildpacksInit bool
+	enableBazelInit      bool
+	enableKoInit         bool
+	enableCustomInit     bool
 	findBuilders         bool
 	buildpacksBuilder    string
 	foundBuilders        []build.InitBuilder
@@ -95,5 +101,28 @@ func (a *builderAnalyzer) detectBuilders(path string, detectJib bool) ([]build.I
 		}
 	}
 
+	if a.enableBazelInit {
+		// Check for Bazel container_image targets
+		for _, builder := range bazel.Validate(path) {
+			results = append(results, builder)
+		}
+	}
+
+	if a.enableKoInit {
+		// Check for Go main packages
+		if ko.Validate(path) {
+			results = append(results, ko.ArtifactConfig{
+				File: path,
+			})
+		}
+	}
+
+	if a.enableCustomInit {
+		// Check for Makefiles and scripts that build images
+		for _, builder := range custom.Validate(path) {
+			results = append(results, builder)
+		}
+	}
+
 	return results, searchSubDirectories
 }
@@ -17,18 +17,48 @@ limitations under the License.
 package analyze
 
 import (
+	"path/filepath"
+	"strings"
+
 	deploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 )
 
 // helmAnalyzer is a Visitor during the directory analysis that finds helm charts
+// and the values files next to them.
 type helmAnalyzer struct {
 	directoryAnalyzer
-	chartPaths []string
+	chartPaths  []string
+	valuesFiles map[string][]string // keyed on directory
 }
 
 func (h *helmAnalyzer) analyzeFile(filePath string) error {
-	if deploy.IsHelmChart(filePath) {
+	switch {
+	case deploy.IsHelmChart(filePath):
 		h.chartPaths = append(h.chartPaths, filePath)
+	case isValuesFile(filePath):
+		if h.valuesFiles == nil {
+			h.valuesFiles = map[string][]string{}
+		}
+		dir := filepath.Dir(filePath)
+		h.valuesFiles[dir] = append(h.valuesFiles[dir], filePath)
 	}
 	return nil
 }
+
+// chartValuesFiles returns the additional values files found next to each chart, keyed on chart path.
+func (h *helmAnalyzer) chartValuesFiles() map[string][]string {
+	valuesFiles := map[string][]string{}
+	for _, chart := range h.chartPaths {
+		valuesFiles[chart] = h.valuesFiles[filepath.Dir(chart)]
+	}
+	return valuesFiles
+}
+
+// isValuesFile matches files like `values-dev.yaml` or `values.prod.yaml`.
+// The chart's default `values.yaml` is left out since Helm always reads it.
+func isValuesFile(filePath string) bool {
+	base := filepath.Base(filePath)
+	ext := filepath.Ext(base)
+	return strings.HasPrefix(base, "values") && (ext == ".yaml" || ext == ".yml") &&
+		base != "values.yaml" && base != "values.yml"
+}
@@ -21,8 +21,11 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/initializer/prompt"
@@ -282,6 +285,9 @@ func TestProcessCliArtifacts(t *testing.T) {
 				`{"builder":"Jib Gradle Plugin","payload":{"path":"/path/to/build.gradle"},"image":"image2", "context":"path/to/jib/workspace"}`,
 				`{"builder":"Jib Maven Plugin","payload":{"path":"/path/to/pom.xml","project":"project-name","image":"testImage"},"image":"image3"}`,
 				`{"builder":"Buildpacks","payload":{"path":"/path/to/package.json"},"image":"image4"}`,
+				`{"builder":"Bazel","payload":{"path":"/path/to/BUILD","target":"//app:image.tar"},"image":"image5"}`,
+				`{"builder":"Ko","payload":{"path":"/path/to/main.go"},"image":"image7"}`,
+				`{"builder":"Custom","payload":{"path":"/path/to/Makefile","buildCommand":"make image"},"image":"image6"}`,
 			},
 			expectedInfos: []ArtifactInfo{
 				{
@@ -302,6 +308,18 @@ func TestProcessCliArtifacts(t *testing.T) {
 					Builder:   buildpacks.ArtifactConfig{File: "/path/to/package.json"},
 					ImageName: "image4",
 				},
+				{
+					Builder:   bazel.ArtifactConfig{File: "/path/to/BUILD", Target: "//app:image.tar"},
+					ImageName: "image5",
+				},
+				{
+					Builder:   ko.ArtifactConfig{File: "/path/to/main.go"},
+					ImageName: "image7",
+				},
+				{
+					Builder:   custom.ArtifactConfig{File: "/path/to/Makefile", BuildCommand: "make image"},
+					ImageName: "image6",
+				},
 			},
 		},
 	}
@@ -32,6 +32,9 @@ type Config struct {
 	EnableJibInit            bool // TODO: Remove this parameter
 	EnableJibGradleInit      bool
 	EnableBuildpacksInit     bool
+	EnableBazelInit          bool
+	EnableKoInit             bool
+	EnableCustomInit         bool
 	EnableNewInitFormat      bool
 	EnableManifestGeneration bool
 	Opts                     config.SkaffoldOptions
@@ -45,6 +45,8 @@ func (s stubDeploymentInitializer) Validate() error {
 	panic("no thanks")
 }
 
+func (s stubDeploymentInitializer) SetBuiltImages([]string) {}
+
 func (s stubDeploymentInitializer) AddManifestForImage(string, string) {
 	panic("don't call me")
 }
@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// helm implements deploymentInitializer for the helm deployer.
+type helm struct {
+	charts      []chart
+	images      []string        // the images referenced in the charts' values
+	builtImages map[string]bool // the images built by skaffold
+}
+
+// chart is a helm chart found in the project.
+type chart struct {
+	name        string
+	path        string
+	valuesFiles []string
+	images      []valuesImage
+}
+
+// valuesImage is an image reference found in a chart's values.
+type valuesImage struct {
+	// key is the path of the value, like `frontend.image`.
+	key string
+	// image is the referenced image, without its tag.
+	image string
+	// convention is true when the value follows the helm convention of
+	// separate `repository` and `tag` values.
+	convention bool
+	// registry is true when the value also has a separate `registry` value.
+	registry bool
+}
+
+// newHelmInitializer returns a helm config generator.
+func newHelmInitializer(chartValuesFiles map[string][]string) *helm {
+	var chartPaths []string
+	for chartPath := range chartValuesFiles {
+		chartPaths = append(chartPaths, chartPath)
+	}
+	sort.Strings(chartPaths)
+
+	h := &helm{}
+	for _, chartPath := range chartPaths {
+		dir := filepath.Dir(chartPath)
+		c := chart{
+			name:        chartName(chartPath),
+			path:        dir,
+			valuesFiles: chartValuesFiles[chartPath],
+		}
+
+		seen := map[string]bool{}
+		for _, file := range append([]string{filepath.Join(dir, "values.yaml")}, c.valuesFiles...) {
+			images, err := parseImagesFromValues(file)
+			if err != nil {
+				logrus.Debugf("skipping values file %s: %s", file, err)
+				continue
+			}
+			for _, image := range images {
+				if seen[image.key] {
+					continue
+				}
+				seen[image.key] = true
+				c.images = append(c.images, image)
+				h.images = append(h.images, image.image)
+			}
+		}
+
+		h.charts = append(h.charts, c)
+	}
+	return h
+}
+
+// chartName reads the name of the chart, defaulting to the name of its directory.
+func chartName(chartPath string) string {
+	name := filepath.Base(filepath.Dir(chartPath))
+	if name == "." || name == string(filepath.Separator) {
+		name = ""
+	}
+
+	buf, err := ioutil.ReadFile(chartPath)
+	if err != nil {
+		return name
+	}
+	var metadata struct {
+		Name string `yaml:"name"`
+	}
+	if err := yaml.Unmarshal(buf, &metadata); err != nil || metadata.Name == "" {
+		return name
+	}
+	return metadata.Name
+}
+
+// parseImagesFromValues finds the image references in a values file: string values
+// whose key ends with `image`, or maps with a `repository` value under such a key.
+func parseImagesFromValues(file string) ([]valuesImage, error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(buf, &values); err != nil {
+		return nil, err
+	}
+	return findImages("", values), nil
+}
+
+func findImages(prefix string, values map[string]interface{}) []valuesImage {
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var images []valuesImage
+	for _, k := range keys {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		isImageKey := strings.HasSuffix(strings.ToLower(k), "image")
+
+		switch v := values[k].(type) {
+		case string:
+			if !isImageKey {
+				continue
+			}
+			if image, ok := imageName(v); ok {
+				images = append(images, valuesImage{key: key, image: image})
+			}
+
+		case map[string]interface{}:
+			repository, hasRepository := v["repository"].(string)
+			if !isImageKey || !hasRepository {
+				images = append(images, findImages(key, v)...)
+				continue
+			}
+			registry, hasRegistry := v["registry"].(string)
+			if hasRegistry && registry != "" {
+				repository = registry + "/" + repository
+			}
+			if image, ok := imageName(repository); ok {
+				images = append(images, valuesImage{key: key, image: image, convention: true, registry: hasRegistry})
+			}
+		}
+	}
+	return images
+}
+
+// imageName strips the tag of an image reference.
+func imageName(value string) (string, bool) {
+	ref, err := docker.ParseReference(value)
+	if err != nil || ref.Digest != "" {
+		return "", false
+	}
+	return ref.BaseName, true
+}
+
+// deployConfig implements the Initializer interface and generates
+// a helm deployment config, with a release for each chart.
+func (h *helm) DeployConfig() (latest.DeployConfig, []latest.Profile) {
+	var releases []latest.HelmRelease
+	for _, c := range h.charts {
+		release := latest.HelmRelease{
+			Name:        c.name,
+			ChartPath:   c.path,
+			ValuesFiles: c.valuesFiles,
+		}
+
+		overrides := util.FlatMap{}
+		var conventions []valuesImage
+		for _, image := range c.images {
+			if !h.builtImages[image.image] {
+				continue
+			}
+			if image.convention {
+				conventions = append(conventions, image)
+			} else {
+				overrides[image.key] = image.image
+			}
+		}
+
+		// The image strategy applies to all the overrides of a release.
+		if len(overrides) == 0 && len(conventions) > 0 {
+			explicitRegistry := true
+			for _, image := range conventions {
+				overrides[image.key] = image.image
+				explicitRegistry = explicitRegistry && image.registry
+			}
+			release.ImageStrategy.HelmConventionConfig = &latest.HelmConventionConfig{ExplicitRegistry: explicitRegistry}
+		} else {
+			for _, image := range conventions {
+				warnings.Printf("Skipping image value %s of chart %s: a release can't mix image references with separate repository and tag values", image.key, c.name)
+			}
+		}
+
+		if len(overrides) > 0 {
+			release.ArtifactOverrides = overrides
+		}
+		releases = append(releases, release)
+	}
+
+	return latest.DeployConfig{
+		DeployType: latest.DeployType{
+			HelmDeploy: &latest.HelmDeploy{
+				Releases: releases,
+			},
+		},
+	}, nil
+}
+
+// GetImages implements the Initializer interface and lists all the
+// images referenced in the charts' values.
+func (h *helm) GetImages() []string {
+	return h.images
+}
+
+// Validate implements the Initializer interface.
+// Charts that don't reference images can still be deployed.
+func (h *helm) Validate() error {
+	return nil
+}
+
+// we don't generate k8s manifests for a helm deploy
+func (h *helm) AddManifestForImage(string, string) {}
+
+// SetBuiltImages implements the Initializer interface and records the images
+// built by skaffold, which are overridden in the releases.
+func (h *helm) SetBuiltImages(images []string) {
+	h.builtImages = map[string]bool{}
+	for _, image := range images {
+		h.builtImages[image] = true
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGenerateHelmPipeline(t *testing.T) {
+	tests := []struct {
+		description      string
+		files            map[string]string
+		chartValuesFiles map[string][]string
+		builtImages      []string
+		expectedImages   []string
+		expectedReleases []latest.HelmRelease
+	}{
+		{
+			description: "image reference",
+			files: map[string]string{
+				"charts/app/Chart.yaml":  "apiVersion: v2\nname: app\nversion: 0.1.0",
+				"charts/app/values.yaml": "image: gcr.io/project/app:v1\nreplicaCount: 1",
+			},
+			chartValuesFiles: map[string][]string{"charts/app/Chart.yaml": nil},
+			builtImages:      []string{"gcr.io/project/app"},
+			expectedImages:   []string{"gcr.io/project/app"},
+			expectedReleases: []latest.HelmRelease{{
+				Name:              "app",
+				ChartPath:         "charts/app",
+				ArtifactOverrides: util.FlatMap{"image": "gcr.io/project/app"},
+			}},
+		},
+		{
+			description: "nested image references",
+			files: map[string]string{
+				"Chart.yaml":  "apiVersion: v2\nname: shop\nversion: 0.1.0",
+				"values.yaml": "frontend:\n  image: frontend:v1\nbackend:\n  workerImage: worker\n  name: backend",
+			},
+			chartValuesFiles: map[string][]string{"Chart.yaml": nil},
+			builtImages:      []string{"frontend", "worker"},
+			expectedImages:   []string{"worker", "frontend"},
+			expectedReleases: []latest.HelmRelease{{
+				Name:              "shop",
+				ChartPath:         ".",
+				ArtifactOverrides: util.FlatMap{"frontend.image": "frontend", "backend.workerImage": "worker"},
+			}},
+		},
+		{
+			description: "repository and tag",
+			files: map[string]string{
+				"chart/Chart.yaml":  "apiVersion: v2\nname: app\nversion: 0.1.0",
+				"chart/values.yaml": "image:\n  repository: gcr.io/project/app\n  tag: v1",
+			},
+			chartValuesFiles: map[string][]string{"chart/Chart.yaml": nil},
+			builtImages:      []string{"gcr.io/project/app"},
+			expectedImages:   []string{"gcr.io/project/app"},
+			expectedReleases: []latest.HelmRelease{{
+				Name:              "app",
+				ChartPath:         "chart",
+				ArtifactOverrides: util.FlatMap{"image": "gcr.io/project/app"},
+				ImageStrategy: latest.HelmImageStrategy{HelmImageConfig: latest.HelmImageConfig{
+					HelmConventionConfig: &latest.HelmConventionConfig{},
+				}},
+			}},
+		},
+		{
+			description: "explicit registry",
+			files: map[string]string{
+				"chart/Chart.yaml":  "apiVersion: v2\nname: app\nversion: 0.1.0",
+				"chart/values.yaml": "image:\n  registry: gcr.io\n  repository: project/app\n  tag: v1",
+			},
+			chartValuesFiles: map[string][]string{"chart/Chart.yaml": nil},
+			builtImages:      []string{"gcr.io/project/app"},
+			expectedImages:   []string{"gcr.io/project/app"},
+			expectedReleases: []latest.HelmRelease{{
+				Name:              "app",
+				ChartPath:         "chart",
+				ArtifactOverrides: util.FlatMap{"image": "gcr.io/project/app"},
+				ImageStrategy: latest.HelmImageStrategy{HelmImageConfig: latest.HelmImageConfig{
+					HelmConventionConfig: &latest.HelmConventionConfig{ExplicitRegistry: true},
+				}},
+			}},
+		},
+		{
+			description: "values files and images that aren't built",
+			files: map[string]string{
+				"web/Chart.yaml":       "apiVersion: v2\nname: web\nversion: 0.1.0",
+				"web/values.yaml":      "image: web\ndatabaseImage: postgres:13",
+				"web/values-dev.yaml":  "image: web:dev\ndebugImage: debugger",
+				"web/values-prod.yaml": "replicaCount: 3",
+			},
+			chartValuesFiles: map[string][]string{"web/Chart.yaml": {"web/values-dev.yaml", "web/values-prod.yaml"}},
+			builtImages:      []string{"web", "debugger"},
+			expectedImages:   []string{"postgres", "web", "debugger"},
+			expectedReleases: []latest.HelmRelease{{
+				Name:              "web",
+				ChartPath:         "web",
+				ValuesFiles:       []string{"web/values-dev.yaml", "web/values-prod.yaml"},
+				ArtifactOverrides: util.FlatMap{"image": "web", "debugImage": "debugger"},
+			}},
+		},
+		{
+			description: "several charts",
+			files: map[string]string{
+				"b/Chart.yaml":  "apiVersion: v2\nversion: 0.1.0",
+				"b/values.yaml": "image: b",
+				"a/Chart.yaml":  "apiVersion: v2\nname: first\nversion: 0.1.0",
+			},
+			chartValuesFiles: map[string][]string{"b/Chart.yaml": nil, "a/Chart.yaml": nil},
+			builtImages:      []string{"b"},
+			expectedImages:   []string{"b"},
+			expectedReleases: []latest.HelmRelease{
+				{Name: "first", ChartPath: "a"},
+				{Name: "b", ChartPath: "b", ArtifactOverrides: util.FlatMap{"image": "b"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Chdir()
+			for path, contents := range test.files {
+				tmpDir.Write(path, contents)
+			}
+
+			h := newHelmInitializer(test.chartValuesFiles)
+			h.SetBuiltImages(test.builtImages)
+			deployConfig, profiles := h.DeployConfig()
+
+			t.CheckDeepEqual(test.expectedImages, h.GetImages())
+			t.CheckDeepEqual(&latest.HelmDeploy{Releases: test.expectedReleases}, deployConfig.HelmDeploy)
+			t.CheckEmpty(profiles)
+			t.CheckNoError(h.Validate())
+		})
+	}
+}
@@ -32,6 +32,8 @@ type Initializer interface {
 	Validate() error
 	// AddManifestForImage adds a provided manifest for a given image to the initializer
 	AddManifestForImage(string, string)
+	// SetBuiltImages lists the images that are built by the generated artifacts
+	SetBuiltImages([]string)
 }
 
 type cliDeployInit struct {
@@ -61,6 +63,8 @@ func (c *cliDeployInit) Validate() error {
 
 func (c *cliDeployInit) AddManifestForImage(string, string) {}
 
+func (c *cliDeployInit) SetBuiltImages([]string) {}
+
 type emptyDeployInit struct {
 }
 
@@ -78,15 +82,20 @@ func (e *emptyDeployInit) Validate() error {
 
 func (e *emptyDeployInit) AddManifestForImage(string, string) {}
 
+func (e *emptyDeployInit) SetBuiltImages([]string) {}
+
 // if any CLI manifests are provided, we always use those as part of a kubectl deploy first
+// if not, then if helm charts are found, we deploy them with helm
 // if not, then if a kustomization yaml is found, we use that next
 // otherwise, default to a kubectl deploy.
-func NewInitializer(manifests, bases, kustomizations []string, c config.Config) Initializer {
+func NewInitializer(manifests, bases, kustomizations []string, chartValuesFiles map[string][]string, c config.Config) Initializer {
 	switch {
 	case c.SkipDeploy:
 		return &emptyDeployInit{}
 	case len(c.CliKubernetesManifests) > 0:
 		return &cliDeployInit{c.CliKubernetesManifests}
+	case len(chartValuesFiles) > 0:
+		return newHelmInitializer(chartValuesFiles)
 	case len(kustomizations) > 0:
 		return newKustomizeInitializer(c.DefaultKustomization, bases, kustomizations, manifests)
 	default:
@@ -79,3 +79,5 @@ func (k *kubectl) AddManifestForImage(path, image string) {
 	k.configs = append(k.configs, path)
 	k.images = append(k.images, image)
 }
+
+func (k *kubectl) SetBuiltImages([]string) {}
@@ -148,3 +148,5 @@ func (k *kustomize) Validate() error {
 
 // we don't generate k8s manifests for a kustomize deploy
 func (k *kustomize) AddManifestForImage(string, string) {}
+
+func (k *kustomize) SetBuiltImages([]string) {}
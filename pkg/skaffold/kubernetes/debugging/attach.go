@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// Attach forwards a debugging port of a running debugging container so that a debugger can connect to it,
+// and returns the connection details.  The port is forwarded again, to the same local port, whenever the
+// container's pod is replaced.
+func (d *ContainerManager) Attach(ctx context.Context, forwarder PortForwarder, request *proto.AttachDebuggerRequest) (*proto.AttachDebuggerResponse, error) {
+	if d == nil {
+		return nil, status.Error(codes.FailedPrecondition, "debug mode is not enabled")
+	}
+
+	key := request.Namespace + "/" + request.PodName + "/" + request.ContainerName
+	d.lock.Lock()
+	container, found := d.active[key]
+	d.lock.Unlock()
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no running debugging container %s", key)
+	}
+
+	portName, port, err := debugPort(container.config, request.PortName)
+	if err != nil {
+		return nil, err
+	}
+
+	localPort, err := forwarder.ForwardPodPort(ctx, container.pod, request.ContainerName, portName, int32(port))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "forwarding debugging port %q of %s: %v", portName, key, err)
+	}
+
+	session := debuggerSession{
+		forwarder:      forwarder,
+		ownerReference: topLevelOwnerKey(ctx, container.pod, container.pod.Kind),
+		containerName:  request.ContainerName,
+		portName:       portName,
+	}
+	d.lock.Lock()
+	d.sessions[session.ownerReference+"/"+session.containerName+"/"+session.portName] = session
+	d.lock.Unlock()
+
+	return &proto.AttachDebuggerResponse{
+		PodName:       container.pod.Name,
+		ContainerName: request.ContainerName,
+		Namespace:     container.pod.Namespace,
+		Artifact:      container.config.Artifact,
+		Runtime:       container.config.Runtime,
+		WorkingDir:    container.config.WorkingDir,
+		Protocol:      portName,
+		Address:       constants.DefaultPortForwardAddress,
+		LocalPort:     int32(localPort),
+		RemotePort:    int32(port),
+	}, nil
+}
+
+// reattach forwards the debugging ports of attached debuggers to a new generation of a debugging container.
+func (d *ContainerManager) reattach(ctx context.Context, pod *v1.Pod, containerName string, config debug.ContainerDebugConfiguration) {
+	d.lock.Lock()
+	var sessions []debuggerSession
+	for _, session := range d.sessions {
+		if session.containerName == containerName {
+			sessions = append(sessions, session)
+		}
+	}
+	d.lock.Unlock()
+	if len(sessions) == 0 {
+		return
+	}
+
+	ownerReference := topLevelOwnerKey(ctx, pod, pod.Kind)
+	for _, session := range sessions {
+		port, found := config.Ports[session.portName]
+		if session.ownerReference != ownerReference || !found {
+			continue
+		}
+		if _, err := session.forwarder.ForwardPodPort(ctx, pod, containerName, session.portName, int32(port)); err != nil {
+			logrus.Warnf("Unable to forward debugging port %q of %s/%s/%s: %v", session.portName, pod.Namespace, pod.Name, containerName, err)
+		}
+	}
+}
+
+// debugPort selects the requested debugging port, or the only debugging port if none is requested.
+func debugPort(config debug.ContainerDebugConfiguration, portName string) (string, uint32, error) {
+	if portName != "" {
+		port, found := config.Ports[portName]
+		if !found {
+			return "", 0, status.Errorf(codes.InvalidArgument, "no debugging port %q", portName)
+		}
+		return portName, port, nil
+	}
+
+	switch len(config.Ports) {
+	case 0:
+		return "", 0, status.Errorf(codes.FailedPrecondition, "%s runtime has no debugging port", config.Runtime)
+	case 1:
+		for name, port := range config.Ports {
+			return name, port, nil
+		}
+	}
+	var names []string
+	for name := range config.Ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "", 0, status.Errorf(codes.InvalidArgument, "a debugging port name is required, one of: %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakePortForwarder struct {
+	forwarded []string
+	err       error
+}
+
+func (f *fakePortForwarder) ForwardPodPort(_ context.Context, pod *v1.Pod, containerName, portName string, port int32) (int, error) {
+	f.forwarded = append(f.forwarded, pod.Name+"/"+containerName+"/"+portName)
+	return int(port) + 1, f.err
+}
+
+func TestAttach(t *testing.T) {
+	debuggingPod := func(name string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "ns",
+				Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"artifact":"image","runtime":"jvm","ports":{"jdwp":5005}}}`},
+			},
+			Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{Name: "test", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}}},
+		}
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&notifyDebuggingContainerStarted, func(string, string, string, string, string, string, map[string]uint32) {})
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+
+		m := &ContainerManager{active: map[string]debugContainer{}, sessions: map[string]debuggerSession{}}
+		forwarder := &fakePortForwarder{}
+
+		_, err := m.Attach(context.Background(), forwarder, &proto.AttachDebuggerRequest{PodName: "pod1", ContainerName: "test", Namespace: "ns"})
+		t.CheckDeepEqual(codes.NotFound, status.Code(err))
+
+		m.checkPod(context.Background(), debuggingPod("pod1"))
+		response, err := m.Attach(context.Background(), forwarder, &proto.AttachDebuggerRequest{PodName: "pod1", ContainerName: "test", Namespace: "ns"})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&proto.AttachDebuggerResponse{
+			PodName:       "pod1",
+			ContainerName: "test",
+			Namespace:     "ns",
+			Artifact:      "image",
+			Runtime:       "jvm",
+			Protocol:      "jdwp",
+			Address:       "127.0.0.1",
+			LocalPort:     5006,
+			RemotePort:    5005,
+		}, response)
+		t.CheckDeepEqual([]string{"pod1/test/jdwp"}, forwarder.forwarded)
+
+		// a new generation of the pod is forwarded again
+		m.checkPod(context.Background(), debuggingPod("pod2"))
+		t.CheckDeepEqual([]string{"pod1/test/jdwp", "pod2/test/jdwp"}, forwarder.forwarded)
+
+		forwarder.err = errors.New("unable to forward")
+		_, err = m.Attach(context.Background(), forwarder, &proto.AttachDebuggerRequest{PodName: "pod2", ContainerName: "test", Namespace: "ns"})
+		t.CheckDeepEqual(codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestAttachZeroValue(t *testing.T) {
+	var m *ContainerManager
+
+	_, err := m.Attach(context.Background(), &fakePortForwarder{}, &proto.AttachDebuggerRequest{})
+	testutil.CheckDeepEqual(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestDebugPort(t *testing.T) {
+	tests := []struct {
+		description  string
+		ports        map[string]uint32
+		portName     string
+		expectedName string
+		expectedPort uint32
+		expectedCode codes.Code
+	}{
+		{
+			description:  "single port",
+			ports:        map[string]uint32{"dlv": 56268},
+			expectedName: "dlv",
+			expectedPort: 56268,
+		},
+		{
+			description:  "requested port",
+			ports:        map[string]uint32{"dap": 5678, "http": 8080},
+			portName:     "dap",
+			expectedName: "dap",
+			expectedPort: 5678,
+		},
+		{
+			description:  "unknown port",
+			ports:        map[string]uint32{"dap": 5678},
+			portName:     "jdwp",
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			description:  "several ports",
+			ports:        map[string]uint32{"dap": 5678, "http": 8080},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			description:  "no ports",
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			name, port, err := debugPort(debug.ContainerDebugConfiguration{Runtime: "test", Ports: test.ports}, test.portName)
+
+			t.CheckDeepEqual(test.expectedCode, status.Code(err))
+			t.CheckDeepEqual(test.expectedName, name)
+			t.CheckDeepEqual(test.expectedPort, port)
+		})
+	}
+}
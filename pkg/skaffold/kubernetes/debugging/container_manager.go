@@ -19,6 +19,7 @@ package debugging
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -32,12 +33,37 @@ var (
 	// For testing
 	notifyDebuggingContainerStarted    = event.DebuggingContainerStarted
 	notifyDebuggingContainerTerminated = event.DebuggingContainerTerminated
+	topLevelOwnerKey                   = kubernetes.TopLevelOwnerKey
 )
 
+// PortForwarder forwards a container port of a pod on demand and returns the local port.
+type PortForwarder interface {
+	ForwardPodPort(ctx context.Context, pod *v1.Pod, containerName, portName string, port int32) (int, error)
+}
+
 type ContainerManager struct {
 	podWatcher kubernetes.PodWatcher
-	active     map[string]string // set of containers that have been notified
 	events     chan kubernetes.PodEvent
+
+	// lock guards active and sessions, which are also accessed when attaching debuggers
+	lock     sync.Mutex
+	active   map[string]debugContainer  // set of containers that have been notified
+	sessions map[string]debuggerSession // debugging ports forwarded for attached debuggers
+}
+
+// debugContainer is a running debugging container.
+type debugContainer struct {
+	pod    *v1.Pod
+	config debug.ContainerDebugConfiguration
+}
+
+// debuggerSession is a debugging port forwarded for an attached debugger.
+// The port is forwarded again when the container's pod is replaced.
+type debuggerSession struct {
+	forwarder      PortForwarder
+	ownerReference string
+	containerName  string
+	portName       string
 }
 
 func NewContainerManager(podSelector kubernetes.PodSelector, namespaces []string) *ContainerManager {
@@ -45,7 +71,8 @@ func NewContainerManager(podSelector kubernetes.PodSelector, namespaces []string
 	// avoiding the possibility of closing a nil channel. Channels are cheap.
 	return &ContainerManager{
 		podWatcher: kubernetes.NewPodWatcher(podSelector, namespaces),
-		active:     map[string]string{},
+		active:     map[string]debugContainer{},
+		sessions:   map[string]debuggerSession{},
 		events:     make(chan kubernetes.PodEvent),
 	}
 }
@@ -74,7 +101,7 @@ func (d *ContainerManager) Start(ctx context.Context) error {
 					return
 				}
 
-				d.checkPod(evt.Pod)
+				d.checkPod(ctx, evt.Pod)
 			}
 		}
 	}()
@@ -89,7 +116,7 @@ func (d *ContainerManager) Stop() {
 	}
 }
 
-func (d *ContainerManager) checkPod(pod *v1.Pod) {
+func (d *ContainerManager) checkPod(ctx context.Context, pod *v1.Pod) {
 	debugConfigString, found := pod.Annotations[debug.DebugConfigAnnotation]
 	if !found {
 		return
@@ -104,10 +131,14 @@ func (d *ContainerManager) checkPod(pod *v1.Pod) {
 		if config, found := configurations[c.Name]; found {
 			key := pod.Namespace + "/" + pod.Name + "/" + c.Name
 			// only notify of first appearance or disappearance
+			d.lock.Lock()
 			_, seen := d.active[key]
+			d.lock.Unlock()
 			switch {
 			case c.State.Running != nil && !seen:
-				d.active[key] = key
+				d.lock.Lock()
+				d.active[key] = debugContainer{pod: pod, config: config}
+				d.lock.Unlock()
 				notifyDebuggingContainerStarted(
 					pod.Name,
 					c.Name,
@@ -116,9 +147,12 @@ func (d *ContainerManager) checkPod(pod *v1.Pod) {
 					config.Runtime,
 					config.WorkingDir,
 					config.Ports)
+				d.reattach(ctx, pod, c.Name, config)
 
 			case c.State.Terminated != nil && seen:
+				d.lock.Lock()
 				delete(d.active, key)
+				d.lock.Unlock()
 				notifyDebuggingContainerTerminated(pod.Name, c.Name, pod.Namespace,
 					config.Artifact,
 					config.Runtime,
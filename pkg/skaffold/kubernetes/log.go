@@ -19,27 +19,42 @@ package kubernetes
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
+// For testing
+var (
+	applicationLog      = event.ApplicationLog
+	applicationLogEnded = event.ApplicationLogEnded
+	waitLogsReconnect   = 1 * time.Second
+)
+
 // LogAggregator aggregates the logs for all the deployed pods.
 type LogAggregator struct {
 	output      io.Writer
-	kubectlcli  *kubectl.CLI
 	config      latest.LogsConfig
 	podWatcher  PodWatcher
 	colorPicker ColorPicker
+	format      string
+	previous    bool
+	include     []logFilter
+	exclude     []logFilter
 
 	muted             int32
 	sinceTime         time.Time
@@ -49,13 +64,27 @@ type LogAggregator struct {
 }
 
 // NewLogAggregator creates a new LogAggregator for a given output.
-func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector PodSelector, namespaces []string, config latest.LogsConfig) *LogAggregator {
+// The format is either `text` or `json`. If previous is true, the logs of the previous
+// instance of restarted containers are printed too, if they were not tailed already.
+func NewLogAggregator(out io.Writer, imageNames []string, podSelector PodSelector, namespaces []string, config latest.LogsConfig, format string, previous bool) *LogAggregator {
+	switch format {
+	case TextLogFormat, JSONLogFormat:
+	case "":
+		format = TextLogFormat
+	default:
+		logrus.Warnf("Unknown log format %q, printing logs as %s", format, TextLogFormat)
+		format = TextLogFormat
+	}
+
 	return &LogAggregator{
 		output:      out,
-		kubectlcli:  cli,
 		config:      config,
 		podWatcher:  NewPodWatcher(podSelector, namespaces),
 		colorPicker: NewColorPicker(imageNames),
+		format:      format,
+		previous:    previous,
+		include:     newLogFilters(config.Include),
+		exclude:     newLogFilters(config.Exclude),
 		events:      make(chan PodEvent),
 	}
 }
@@ -139,41 +168,185 @@ func sinceSeconds(d time.Duration) int64 {
 func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, container v1.ContainerStatus) {
 	logrus.Infof("Streaming logs from pod: %s container: %s", pod.Name, container.Name)
 
-	// In theory, it's more precise to use --since-time='' but there can be a time
-	// difference between the user's machine and the server.
-	// So we use --since=Xs and round up to the nearest second to not lose any log.
-	sinceSeconds := fmt.Sprintf("--since=%ds", sinceSeconds(time.Since(a.sinceTime)))
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		logrus.Warnf("getting Kubernetes client: %s", err)
+		return
+	}
 
-	tr, tw := io.Pipe()
-	go func() {
-		if err := a.kubectlcli.Run(ctx, nil, tw, "logs", sinceSeconds, "-f", pod.Name, "-c", container.Name, "--namespace", pod.Namespace); err != nil {
+	headerColor := a.colorPicker.Pick(pod)
+	prefix := a.prefix(pod, container)
+	source := newLogSource(pod, container.Name)
+	defer applicationLogEnded(pod.Name, container.Name, pod.Namespace)
+
+	if a.previous && a.crashedBeforeTailing(container) {
+		opts := &v1.PodLogOptions{Container: container.Name, Previous: true, Timestamps: true}
+		if err := a.streamLogs(ctx, client, pod, opts, headerColor, prefix, source, &lineTracker{}); err != nil {
+			logrus.Debugf("streaming previous logs of %s: %s", prefix, err)
+		}
+	}
+
+	tracker := &lineTracker{}
+	for {
+		opts := &v1.PodLogOptions{Container: container.Name, Follow: true, Timestamps: true}
+		if tracker.last.IsZero() {
+			// In theory, it's more precise to use SinceTime but there can be a time
+			// difference between the user's machine and the server.
+			// So we use SinceSeconds and round up to the nearest second to not lose any log.
+			since := sinceSeconds(time.Since(a.sinceTime))
+			opts.SinceSeconds = &since
+		} else {
+			// When resuming, the timestamps come from the server. Lines already printed are skipped by the tracker.
+			since := metav1.NewTime(tracker.last)
+			opts.SinceTime = &since
+		}
+
+		if err := a.streamLogs(ctx, client, pod, opts, headerColor, prefix, source, tracker); err != nil {
 			// Don't print errors if the user interrupted the logs
 			// or if the logs were interrupted because of a configuration change
 			if ctx.Err() != context.Canceled {
-				logrus.Warn(err)
+				logrus.Warnf("streaming logs of %s: %s", prefix, err)
 			}
 		}
-		_ = tw.Close()
-	}()
 
-	headerColor := a.colorPicker.Pick(pod)
-	prefix := a.prefix(pod, container)
-	if err := a.streamRequest(ctx, headerColor, prefix, tr); err != nil {
-		logrus.Errorf("streaming request %s", err)
+		// The stream ends when the container stops, or when the connection is lost.
+		if ctx.Err() != nil || !isContainerRunning(ctx, client, pod, container.ContainerID) {
+			return
+		}
+		logrus.Debugf("resuming logs of %s", prefix)
+		time.Sleep(waitLogsReconnect)
+	}
+}
+
+// crashedBeforeTailing returns true if the previous instance of a container terminated before its logs were tailed.
+func (a *LogAggregator) crashedBeforeTailing(container v1.ContainerStatus) bool {
+	terminated := container.LastTerminationState.Terminated
+	if container.RestartCount == 0 || terminated == nil {
+		return false
+	}
+	return terminated.ContainerID == "" || !a.trackedContainers.contains(terminated.ContainerID)
+}
+
+func (a *LogAggregator) streamLogs(ctx context.Context, client kubernetes.Interface, pod *v1.Pod, opts *v1.PodLogOptions, headerColor color.Color, prefix string, source logSource, tracker *lineTracker) error {
+	rc, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return a.streamRequest(ctx, headerColor, prefix, source, tracker, rc)
+}
+
+// isContainerRunning checks if the given container of a pod is still running.
+func isContainerRunning(ctx context.Context, client kubernetes.Interface, pod *v1.Pod, containerID string) bool {
+	current, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+
+	for _, c := range append(current.Status.InitContainerStatuses, current.Status.ContainerStatuses...) {
+		if c.ContainerID == containerID {
+			return c.State.Running != nil
+		}
 	}
+	return false
+}
+
+// lineTracker de-duplicates the lines sent again by a stream that resumes from the timestamp of the last line.
+// The Kubernetes API only resumes with a precision of one second.
+type lineTracker struct {
+	last time.Time
+	seen map[string]bool
+}
+
+// isNew records a line and returns true if it wasn't seen before.
+func (t *lineTracker) isNew(timestamp, line string) bool {
+	ts, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return true
+	}
+
+	switch {
+	case ts.Before(t.last):
+		return false
+	case ts.Equal(t.last):
+		if t.seen[line] {
+			return false
+		}
+		t.seen[line] = true
+		return true
+	default:
+		t.last = ts
+		t.seen = map[string]bool{line: true}
+		return true
+	}
+}
+
+// handleRawLine handles a line prefixed with its timestamp, unless it was already handled.
+func (a *LogAggregator) handleRawLine(headerColor color.Color, prefix string, source logSource, tracker *lineTracker, line string) {
+	timestamp, line := splitTimestamp(line)
+	if tracker.isNew(timestamp, line) {
+		a.handleLogLine(headerColor, prefix, source, timestamp, line)
+	}
+}
+
+// handleLogLine filters a log line and prints it in the configured format.
+// Muted lines are neither printed nor sent as events.
+func (a *LogAggregator) handleLogLine(headerColor color.Color, prefix string, source logSource, timestamp, line string) {
+	if a.IsMuted() {
+		return
+	}
+	message := strings.TrimSuffix(line, "\n")
+	if !shouldPrint(a.include, a.exclude, source, message) {
+		return
+	}
+	level := parseLevel(message)
+	applicationLog(source.pod.Name, source.container, source.pod.Namespace, source.image, timestamp, level, message)
+
+	if a.format == JSONLogFormat {
+		a.printJSONLogLine(jsonLogLine{
+			Timestamp: timestamp,
+			Namespace: source.pod.Namespace,
+			Pod:       source.pod.Name,
+			Container: source.container,
+			Image:     source.image,
+			Level:     level,
+			Message:   message,
+		})
+		return
+	}
+	a.printLogLine(headerColor, levelColor(level), prefix, line)
 }
 
-func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text string) {
+func (a *LogAggregator) printLogLine(headerColor, textColor color.Color, prefix, text string) {
 	if !a.IsMuted() {
 		a.outputLock.Lock()
 
 		headerColor.Fprintf(a.output, "%s ", prefix)
-		fmt.Fprint(a.output, text)
+		if textColor == color.None {
+			fmt.Fprint(a.output, text)
+		} else {
+			textColor.Fprintln(a.output, strings.TrimSuffix(text, "\n"))
+		}
 
 		a.outputLock.Unlock()
 	}
 }
 
+func (a *LogAggregator) printJSONLogLine(line jsonLogLine) {
+	b, err := json.Marshal(line)
+	if err != nil {
+		logrus.Warnf("encoding log line: %s", err)
+		return
+	}
+
+	if !a.IsMuted() {
+		a.outputLock.Lock()
+		fmt.Fprintln(a.output, string(b))
+		a.outputLock.Unlock()
+	}
+}
+
 func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	switch a.config.Prefix {
 	case "auto":
@@ -207,7 +380,7 @@ func podAndContainerPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	return fmt.Sprintf("[%s %s]", pod.Name, container.Name)
 }
 
-func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, rc io.Reader) error {
+func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, source logSource, tracker *lineTracker, rc io.Reader) error {
 	r := bufio.NewReader(rc)
 	for {
 		select {
@@ -218,13 +391,17 @@ func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Col
 			// Read up to newline
 			line, err := r.ReadString('\n')
 			if err == io.EOF {
+				// Don't lose the last line if the stream ends before its end of line.
+				if line != "" {
+					a.handleRawLine(headerColor, prefix, source, tracker, line+"\n")
+				}
 				return nil
 			}
 			if err != nil {
 				return fmt.Errorf("reading bytes from log stream: %w", err)
 			}
 
-			a.printLogLine(headerColor, prefix, line)
+			a.handleRawLine(headerColor, prefix, source, tracker, line)
 		}
 	}
 }
@@ -259,6 +436,14 @@ type trackedContainers struct {
 	ids map[string]bool
 }
 
+// contains returns true if a containerID is tracked.
+func (t *trackedContainers) contains(id string) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.ids[id]
+}
+
 // add adds a containerID to be tracked. Return true if the container
 // was already tracked.
 func (t *trackedContainers) add(id string) bool {
@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// TextLogFormat prints log lines as they are, prefixed with the pod and container names.
+	TextLogFormat = "text"
+
+	// JSONLogFormat prints each log line as a JSON object.
+	JSONLogFormat = "json"
+)
+
+// Log levels surfaced from structured application logs.
+const (
+	levelDebug = "debug"
+	levelInfo  = "info"
+	levelWarn  = "warn"
+	levelError = "error"
+)
+
+// logSource identifies the container that a log line comes from.
+type logSource struct {
+	pod       *v1.Pod
+	container string
+	image     string
+}
+
+func newLogSource(pod *v1.Pod, container string) logSource {
+	source := logSource{pod: pod, container: container}
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.Name == container {
+			source.image = c.Image
+		}
+	}
+	return source
+}
+
+// jsonLogLine is a log line printed with the `json` log format.
+type jsonLogLine struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Image     string `json:"image"`
+	Level     string `json:"level,omitempty"`
+	Message   string `json:"message"`
+}
+
+// logFilter is a compiled `latest.LogFilter`.
+type logFilter struct {
+	images     []string
+	containers []string
+	messages   []*regexp.Regexp
+}
+
+func newLogFilters(filters []latest.LogFilter) []logFilter {
+	var compiled []logFilter
+	for _, f := range filters {
+		lf := logFilter{images: f.Images, containers: f.Containers}
+		for _, m := range f.Messages {
+			re, err := regexp.Compile(m)
+			if err != nil {
+				logrus.Warnf("ignoring invalid log message filter %q: %s", m, err)
+				continue
+			}
+			lf.messages = append(lf.messages, re)
+		}
+		compiled = append(compiled, lf)
+	}
+	return compiled
+}
+
+// matches returns true if a log line matches all the non-empty fields of the filter.
+func (f logFilter) matches(source logSource, message string) bool {
+	if len(f.images) > 0 && !matchesImage(f.images, source.image) {
+		return false
+	}
+	if len(f.containers) > 0 && !util.StrSliceContains(f.containers, source.container) {
+		return false
+	}
+	if len(f.messages) > 0 {
+		for _, re := range f.messages {
+			if re.MatchString(message) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// shouldPrint returns true if a log line matches at least one of the include filters, if any,
+// and none of the exclude filters.
+func shouldPrint(include, exclude []logFilter, source logSource, message string) bool {
+	if len(include) > 0 {
+		included := false
+		for _, f := range include {
+			if f.matches(source, message) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, f := range exclude {
+		if f.matches(source, message) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesImage checks if an image, with or without its tag, is one of the given images.
+// Images can be given by their full name or by the last part of their name.
+func matchesImage(images []string, image string) bool {
+	name := stripTag(image)
+	for _, i := range images {
+		i = stripTag(i)
+		if name == i || strings.HasSuffix(name, "/"+i) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitTimestamp splits the RFC3339 timestamp that `kubectl logs --timestamps` adds to each line.
+func splitTimestamp(line string) (string, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return "", line
+	}
+	if _, err := time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		return "", line
+	}
+	return parts[0], parts[1]
+}
+
+// parseLevel surfaces the severity of structured application logs: JSON objects with a
+// `level`, `severity` or `lvl` field. It returns an empty string for unstructured logs.
+func parseLevel(message string) string {
+	message = strings.TrimSpace(message)
+	if !strings.HasPrefix(message, "{") {
+		return ""
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(message), &fields); err != nil {
+		return ""
+	}
+	for _, key := range []string{"level", "severity", "lvl"} {
+		for k, v := range fields {
+			if !strings.EqualFold(k, key) {
+				continue
+			}
+			if s, ok := v.(string); ok {
+				return normalizeLevel(s)
+			}
+		}
+	}
+	return ""
+}
+
+func normalizeLevel(level string) string {
+	switch strings.ToLower(level) {
+	case "trace", "debug":
+		return levelDebug
+	case "info", "information", "notice":
+		return levelInfo
+	case "warn", "warning":
+		return levelWarn
+	case "error", "err", "fatal", "critical", "alert", "emergency", "panic":
+		return levelError
+	default:
+		return ""
+	}
+}
+
+// levelColor is the color used to print the message of a log line with the given level.
+func levelColor(level string) color.Color {
+	switch level {
+	case levelWarn:
+		return color.Yellow
+	case levelError:
+		return color.Red
+	default:
+		return color.None
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestShouldPrint(t *testing.T) {
+	source := logSource{
+		pod:       &v1.Pod{},
+		container: "web",
+		image:     "gcr.io/project/web:v1@sha256:abcd",
+	}
+
+	tests := []struct {
+		description string
+		include     []latest.LogFilter
+		exclude     []latest.LogFilter
+		message     string
+		expected    bool
+	}{
+		{
+			description: "no filters",
+			message:     "hello",
+			expected:    true,
+		},
+		{
+			description: "include by full image name",
+			include:     []latest.LogFilter{{Images: []string{"gcr.io/project/web"}}},
+			expected:    true,
+		},
+		{
+			description: "include by short image name",
+			include:     []latest.LogFilter{{Images: []string{"web"}}},
+			expected:    true,
+		},
+		{
+			description: "include other image",
+			include:     []latest.LogFilter{{Images: []string{"eb"}}},
+			expected:    false,
+		},
+		{
+			description: "include needs all the fields of a filter to match",
+			include:     []latest.LogFilter{{Containers: []string{"web"}, Messages: []string{"^ERROR"}}},
+			message:     "INFO started",
+			expected:    false,
+		},
+		{
+			description: "include needs one filter to match",
+			include:     []latest.LogFilter{{Containers: []string{"db"}}, {Messages: []string{"^INFO"}}},
+			message:     "INFO started",
+			expected:    true,
+		},
+		{
+			description: "exclude by container",
+			exclude:     []latest.LogFilter{{Containers: []string{"web"}}},
+			expected:    false,
+		},
+		{
+			description: "exclude by message",
+			exclude:     []latest.LogFilter{{Messages: []string{"healthz"}}},
+			message:     "GET /healthz",
+			expected:    false,
+		},
+		{
+			description: "exclude wins over include",
+			include:     []latest.LogFilter{{Images: []string{"web"}}},
+			exclude:     []latest.LogFilter{{Messages: []string{"healthz"}}},
+			message:     "GET /healthz",
+			expected:    false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			printed := shouldPrint(newLogFilters(test.include), newLogFilters(test.exclude), source, test.message)
+
+			t.CheckDeepEqual(test.expected, printed)
+		})
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		description string
+		message     string
+		expected    string
+	}{
+		{"unstructured", "ERROR something failed", ""},
+		{"invalid json", "{level: error", ""},
+		{"level", `{"level":"error","msg":"failed"}`, levelError},
+		{"severity", `{"severity":"WARNING"}`, levelWarn},
+		{"lvl", `{"lvl":"dbug"}`, ""},
+		{"capitalized key", `{"Level":"info"}`, levelInfo},
+		{"fatal", `{"level":"fatal"}`, levelError},
+		{"trace", `{"level":"trace"}`, levelDebug},
+		{"non string level", `{"level":30}`, ""},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, parseLevel(test.message))
+		})
+	}
+}
+
+func TestSplitTimestamp(t *testing.T) {
+	timestamp, message := splitTimestamp("2021-01-12T10:00:00.123Z hello world\n")
+	testutil.CheckDeepEqual(t, "2021-01-12T10:00:00.123Z", timestamp)
+	testutil.CheckDeepEqual(t, "hello world\n", message)
+
+	timestamp, message = splitTimestamp("hello world\n")
+	testutil.CheckDeepEqual(t, "", timestamp)
+	testutil.CheckDeepEqual(t, "hello world\n", message)
+}
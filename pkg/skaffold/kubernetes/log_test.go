@@ -26,8 +26,12 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -110,7 +114,7 @@ func TestPrintLogLine(t *testing.T) {
 
 			go func() {
 				for i := 0; i < 100; i++ {
-					logger.printLogLine(color.Default, "PREFIX", "TEXT\n")
+					logger.printLogLine(color.Default, color.None, "PREFIX", "TEXT\n")
 				}
 				wg.Done()
 			}()
@@ -124,6 +128,188 @@ func TestPrintLogLine(t *testing.T) {
 	})
 }
 
+func TestHandleLogLine(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "ns"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: "gcr.io/project/web:v1"}}},
+	}
+
+	tests := []struct {
+		description    string
+		format         string
+		config         latest.LogsConfig
+		timestamp      string
+		line           string
+		muted          bool
+		expected       string
+		expectedEvents []string
+	}{
+		{
+			description:    "text",
+			format:         TextLogFormat,
+			timestamp:      "2021-01-12T10:00:00Z",
+			line:           "hello\n",
+			expected:       "[web] hello\n",
+			expectedEvents: []string{"web-1/web: hello"},
+		},
+		{
+			description:    "json",
+			format:         JSONLogFormat,
+			timestamp:      "2021-01-12T10:00:00.123456789Z",
+			line:           "hello\n",
+			expected:       `{"timestamp":"2021-01-12T10:00:00.123456789Z","namespace":"ns","pod":"web-1","container":"web","image":"gcr.io/project/web:v1","message":"hello"}` + "\n",
+			expectedEvents: []string{"web-1/web: hello"},
+		},
+		{
+			description:    "json with level",
+			format:         JSONLogFormat,
+			timestamp:      "2021-01-12T10:00:00Z",
+			line:           "{\"severity\":\"WARNING\",\"msg\":\"low disk\"}\n",
+			expected:       `{"timestamp":"2021-01-12T10:00:00Z","namespace":"ns","pod":"web-1","container":"web","image":"gcr.io/project/web:v1","level":"warn","message":"{\"severity\":\"WARNING\",\"msg\":\"low disk\"}"}` + "\n",
+			expectedEvents: []string{"web-1/web: {\"severity\":\"WARNING\",\"msg\":\"low disk\"}"},
+		},
+		{
+			description:    "included",
+			format:         TextLogFormat,
+			config:         latest.LogsConfig{Include: []latest.LogFilter{{Images: []string{"web"}}}},
+			line:           "hello\n",
+			expected:       "[web] hello\n",
+			expectedEvents: []string{"web-1/web: hello"},
+		},
+		{
+			description: "not included",
+			format:      TextLogFormat,
+			config:      latest.LogsConfig{Include: []latest.LogFilter{{Containers: []string{"other"}}}},
+			line:        "hello\n",
+		},
+		{
+			description: "excluded",
+			format:      JSONLogFormat,
+			config:      latest.LogsConfig{Exclude: []latest.LogFilter{{Messages: []string{"^GET /healthz"}}}},
+			timestamp:   "2021-01-12T10:00:00Z",
+			line:        "GET /healthz 200\n",
+		},
+		{
+			description: "muted",
+			format:      TextLogFormat,
+			line:        "hello\n",
+			muted:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var events []string
+			t.Override(&applicationLog, func(podName, containerName, _, _, _, _, message string) {
+				events = append(events, podName+"/"+containerName+": "+message)
+			})
+
+			var buf bytes.Buffer
+			logger := NewLogAggregator(&buf, nil, nil, nil, test.config, test.format, false)
+			if test.muted {
+				logger.Mute()
+			}
+
+			logger.handleLogLine(color.None, "[web]", newLogSource(pod, "web"), test.timestamp, test.line)
+
+			t.CheckDeepEqual(test.expected, buf.String())
+			t.CheckDeepEqual(test.expectedEvents, events)
+		})
+	}
+}
+
+func TestStreamRequestDeduplicatesResumedLines(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&applicationLog, func(string, string, string, string, string, string, string) {})
+
+		var buf bytes.Buffer
+		logger := NewLogAggregator(&buf, nil, nil, nil, latest.LogsConfig{}, TextLogFormat, false)
+		source := newLogSource(&v1.Pod{}, "web")
+		tracker := &lineTracker{}
+
+		first := "2021-01-12T10:00:00.1Z one\n2021-01-12T10:00:00.2Z two\n"
+		// A stream resumed from 10:00:00 sends the lines of that second again.
+		resumed := "2021-01-12T10:00:00.1Z one\n2021-01-12T10:00:00.2Z two\n2021-01-12T10:00:00.2Z three\n2021-01-12T10:00:01Z four"
+
+		err := logger.streamRequest(context.Background(), color.None, "[web]", source, tracker, strings.NewReader(first))
+		t.CheckNoError(err)
+		err = logger.streamRequest(context.Background(), color.None, "[web]", source, tracker, strings.NewReader(resumed))
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual("[web] one\n[web] two\n[web] three\n[web] four\n", buf.String())
+	})
+}
+
+func TestStreamContainerLogs(t *testing.T) {
+	crashed := v1.ContainerStatus{
+		Name:                 "web",
+		ContainerID:          "docker://2",
+		RestartCount:         1,
+		LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://1"}},
+	}
+
+	tests := []struct {
+		description       string
+		previous          bool
+		trackedContainers []string
+		expected          string
+		expectedPrevious  bool
+	}{
+		{
+			description: "current logs",
+			expected:    "[web] fake logs\n",
+		},
+		{
+			description:      "previous logs of crashed container",
+			previous:         true,
+			expected:         "[web] fake logs\n[web] fake logs\n",
+			expectedPrevious: true,
+		},
+		{
+			description:       "previous logs were already streamed",
+			previous:          true,
+			trackedContainers: []string{"docker://1"},
+			expected:          "[web] fake logs\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&applicationLog, func(string, string, string, string, string, string, string) {})
+			var ended []string
+			t.Override(&applicationLogEnded, func(podName, containerName, namespace string) {
+				ended = append(ended, namespace+"/"+podName+"/"+containerName)
+			})
+
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+				Status:     v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{crashed}},
+			}
+			client := fake.NewSimpleClientset(pod)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+
+			var buf bytes.Buffer
+			logger := NewLogAggregator(&buf, nil, nil, nil, latest.LogsConfig{Prefix: "container"}, TextLogFormat, test.previous)
+			for _, id := range test.trackedContainers {
+				logger.trackedContainers.add(id)
+			}
+
+			// The container isn't running anymore so the logs are streamed only once.
+			logger.streamContainerLogs(context.Background(), pod, crashed)
+
+			var previous bool
+			for _, action := range client.Actions() {
+				if generic, ok := action.(k8stesting.GenericAction); ok {
+					if opts, ok := generic.GetValue().(*v1.PodLogOptions); ok && opts.Previous {
+						previous = true
+					}
+				}
+			}
+			t.CheckDeepEqual(test.expected, buf.String())
+			t.CheckDeepEqual(test.expectedPrevious, previous)
+			t.CheckDeepEqual([]string{"ns/web/web"}, ended)
+		})
+	}
+}
+
 func TestLogAggregatorZeroValue(t *testing.T) {
 	var m *LogAggregator
 
@@ -187,9 +373,9 @@ func TestPrefix(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			logger := NewLogAggregator(nil, nil, nil, nil, nil, latest.LogsConfig{
+			logger := NewLogAggregator(nil, nil, nil, nil, latest.LogsConfig{
 				Prefix: test.prefix,
-			})
+			}, TextLogFormat, false)
 
 			p := logger.prefix(&test.pod, test.container)
 
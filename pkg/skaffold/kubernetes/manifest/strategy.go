@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"fmt"
+
+	apimachinery "k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	// TrackLabel is set on the copies of the workloads deployed by a canary rollout.
+	TrackLabel = "skaffold.dev/track"
+
+	// ColorLabel is set on the workloads deployed by a blue/green rollout and selected by the Services.
+	ColorLabel = "skaffold.dev/color"
+)
+
+// workloadKinds are the kinds that a deploy strategy can copy or rename.
+// The value tells whether the workload has a number of replicas.
+var workloadKinds = map[apimachinery.GroupKind]bool{
+	{Group: "apps", Kind: "DaemonSet"}:        false,
+	{Group: "apps", Kind: "Deployment"}:       true,
+	{Group: "apps", Kind: "ReplicaSet"}:       true,
+	{Group: "apps", Kind: "StatefulSet"}:      true,
+	{Group: "extensions", Kind: "DaemonSet"}:  false,
+	{Group: "extensions", Kind: "Deployment"}: true,
+	{Group: "extensions", Kind: "ReplicaSet"}: true,
+}
+
+// ColoredManifests is a blue/green release of a ManifestList.
+type ColoredManifests struct {
+	// Workloads are renamed after the colour and only manage the pods of that colour.
+	Workloads ManifestList
+
+	// Services select the pods of the colour.
+	Services ManifestList
+
+	// Others are the remaining manifests, left untouched.
+	Others ManifestList
+
+	// Uncolored are the workloads as deployed without a strategy.
+	Uncolored ManifestList
+}
+
+// Canary returns scaled down copies of the named workloads, renamed with the given suffix.
+// All the workloads with replicas are copied when no names are given.
+func (l *ManifestList) Canary(names []string, suffix string, percent int) (ManifestList, error) {
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var copies ManifestList
+	for _, manifest := range *l {
+		m, err := parse(manifest)
+		if err != nil {
+			return nil, err
+		}
+
+		hasReplicas, isWorkload := workloadKinds[groupKind(m)]
+		name := nestedString(m, "metadata", "name")
+		if !isWorkload || !hasReplicas || (len(names) > 0 && !wanted[name]) {
+			continue
+		}
+		delete(wanted, name)
+
+		spec := nestedMap(m, "spec")
+		if spec == nil {
+			return nil, fmt.Errorf("workload %q without spec", name)
+		}
+		spec["replicas"] = scaleReplicas(spec["replicas"], percent)
+		if err := rename(m, name+"-"+suffix, TrackLabel, suffix); err != nil {
+			return nil, err
+		}
+
+		updated, err := yaml.Marshal(m)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling yaml: %w", err)
+		}
+		copies = append(copies, updated)
+	}
+
+	for _, name := range names {
+		if wanted[name] {
+			return nil, fmt.Errorf("canary workload %q was not found in the manifests", name)
+		}
+	}
+
+	return copies, nil
+}
+
+// Colored returns the manifests of a blue/green release with the given colour.
+func (l *ManifestList) Colored(color string) (ColoredManifests, error) {
+	var colored ColoredManifests
+	for _, manifest := range *l {
+		m, err := parse(manifest)
+		if err != nil {
+			return ColoredManifests{}, err
+		}
+
+		gk := groupKind(m)
+		_, isWorkload := workloadKinds[gk]
+		selector := nestedMap(m, "spec", "selector")
+
+		switch {
+		case isWorkload:
+			colored.Uncolored = append(colored.Uncolored, manifest)
+			if err := rename(m, nestedString(m, "metadata", "name")+"-"+color, ColorLabel, color); err != nil {
+				return ColoredManifests{}, err
+			}
+		case gk == apimachinery.GroupKind{Kind: "Service"} && len(selector) > 0:
+			selector[ColorLabel] = color
+		default:
+			colored.Others = append(colored.Others, manifest)
+			continue
+		}
+
+		updated, err := yaml.Marshal(m)
+		if err != nil {
+			return ColoredManifests{}, fmt.Errorf("marshalling yaml: %w", err)
+		}
+		if isWorkload {
+			colored.Workloads = append(colored.Workloads, updated)
+		} else {
+			colored.Services = append(colored.Services, updated)
+		}
+	}
+
+	return colored, nil
+}
+
+// rename renames a workload and labels both the workload and its pods.
+// The label is added to the selector so that the workload doesn't manage the pods of the original one.
+func rename(m map[string]interface{}, name, key, value string) error {
+	metadata := nestedMap(m, "metadata")
+	if metadata == nil {
+		return fmt.Errorf("workload without metadata")
+	}
+	metadata["name"] = name
+	setLabel(metadata, key, value)
+
+	if matchLabels := nestedMap(m, "spec", "selector", "matchLabels"); matchLabels != nil {
+		matchLabels[key] = value
+	}
+
+	template := nestedMap(m, "spec", "template")
+	if template == nil {
+		return fmt.Errorf("workload %q without pod template", name)
+	}
+	if template["metadata"] == nil {
+		template["metadata"] = map[string]interface{}{}
+	}
+	setLabel(nestedMap(template, "metadata"), key, value)
+
+	return nil
+}
+
+func setLabel(metadata map[string]interface{}, key, value string) {
+	labels, ok := metadata["labels"].(map[string]interface{})
+	if !ok {
+		labels = map[string]interface{}{}
+		metadata["labels"] = labels
+	}
+	labels[key] = value
+}
+
+// scaleReplicas returns the given percentage of a number of replicas, rounded up.
+func scaleReplicas(replicas interface{}, percent int) int {
+	var count int
+	switch value := replicas.(type) {
+	case int:
+		count = value
+	case int64:
+		count = int(value)
+	case float64:
+		count = int(value)
+	default:
+		// Kubernetes defaults to one replica.
+		count = 1
+	}
+
+	scaled := (count*percent + 99) / 100
+	if scaled < 1 {
+		return 1
+	}
+	return scaled
+}
+
+func parse(manifest []byte) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	if err := yaml.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("reading Kubernetes YAML: %w", err)
+	}
+	return m, nil
+}
+
+func groupKind(m map[string]interface{}) apimachinery.GroupKind {
+	apiVersion, _ := m["apiVersion"].(string)
+	kind, _ := m["kind"].(string)
+	return apimachinery.FromAPIVersionAndKind(apiVersion, kind).GroupKind()
+}
+
+func nestedMap(m map[string]interface{}, fields ...string) map[string]interface{} {
+	for _, field := range fields {
+		next, ok := m[field].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		m = next
+	}
+	return m
+}
+
+func nestedString(m map[string]interface{}, fields ...string) string {
+	parent := nestedMap(m, fields[:len(fields)-1]...)
+	value, _ := parent[fields[len(fields)-1]].(string)
+	return value
+}
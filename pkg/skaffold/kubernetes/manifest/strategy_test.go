@@ -0,0 +1,195 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const deploymentAndService = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 4
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+  - port: 80
+  selector:
+    app: web
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+func TestCanary(t *testing.T) {
+	tests := []struct {
+		description string
+		names       []string
+		percent     int
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "all workloads",
+			percent:     10,
+			expected: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/track: canary
+  name: web-canary
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: web
+      skaffold.dev/track: canary
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/track: canary
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web`,
+		},
+		{
+			description: "rounded up",
+			names:       []string{"web"},
+			percent:     60,
+			expected: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/track: canary
+  name: web-canary
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      app: web
+      skaffold.dev/track: canary
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/track: canary
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web`,
+		},
+		{
+			description: "unknown workload",
+			names:       []string{"api"},
+			percent:     10,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var manifests ManifestList
+			manifests.Append([]byte(deploymentAndService))
+
+			copies, err := manifests.Canary(test.names, "canary", test.percent)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, copies.String())
+		})
+	}
+}
+
+func TestColored(t *testing.T) {
+	var manifests ManifestList
+	manifests.Append([]byte(deploymentAndService))
+
+	colored, err := manifests.Colored("green")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    skaffold.dev/color: green
+  name: web-green
+spec:
+  replicas: 4
+  selector:
+    matchLabels:
+      app: web
+      skaffold.dev/color: green
+  template:
+    metadata:
+      labels:
+        app: web
+        skaffold.dev/color: green
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web`, colored.Workloads.String())
+	testutil.CheckDeepEqual(t, `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+  - port: 80
+  selector:
+    app: web
+    skaffold.dev/color: green`, colored.Services.String())
+	testutil.CheckDeepEqual(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config`, colored.Others.String())
+	testutil.CheckDeepEqual(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 4
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - image: gcr.io/k8s-skaffold/web
+        name: web`, colored.Uncolored.String())
+}
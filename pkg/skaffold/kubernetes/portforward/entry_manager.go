@@ -20,10 +20,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
@@ -41,6 +47,33 @@ var (
 			entry.resource.Name,
 			entry.resource.Address)
 	}
+
+	portForwardReconnectedEvent = func(entry *portForwardEntry, podName string) {
+		event.PortForwardReconnected(
+			int32(entry.localPort),
+			entry.resource.Port,
+			podName,
+			entry.containerName,
+			entry.resource.Namespace,
+			entry.portName,
+			string(entry.resource.Type),
+			entry.resource.Name,
+			entry.resource.Address)
+	}
+
+	portForwardFailedEvent = func(entry *portForwardEntry, err error) {
+		event.PortForwardFailed(
+			int32(entry.localPort),
+			entry.resource.Port,
+			entry.podName,
+			entry.containerName,
+			entry.resource.Namespace,
+			entry.portName,
+			string(entry.resource.Type),
+			entry.resource.Name,
+			entry.resource.Address,
+			err)
+	}
 )
 
 type forwardedResources struct {
@@ -103,14 +136,15 @@ func NewEntryManager(out io.Writer, entryForwarder EntryForwarder) *EntryManager
 	}
 }
 
-func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portForwardEntry) {
+func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portForwardEntry) error {
 	// Check if this resource has already been forwarded
 	if _, ok := b.forwardedResources.Load(entry.key()); ok {
-		return
+		return nil
 	}
 	b.forwardedResources.Store(entry.key(), entry)
 
-	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
+	err := b.entryForwarder.Forward(ctx, entry)
+	if err == nil {
 		color.Green.Fprintln(
 			b.output,
 			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> address %s port %d",
@@ -122,8 +156,63 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portF
 				entry.localPort))
 	} else {
 		color.Red.Fprintln(b.output, err)
+		portForwardFailedEvent(entry, err)
 	}
 	portForwardEvent(entry)
+	return err
+}
+
+// ForwardPodPort forwards a single container port of a pod, on demand, and returns the local port.
+// Like automatic pod forwarding, the entry is keyed by the pod's top-level owner so that
+// forwarding a new generation of the pod reuses the same local port.
+func (b *EntryManager) ForwardPodPort(ctx context.Context, pod *v1.Pod, containerName, portName string, port int32) (int, error) {
+	ownerReference := topLevelOwnerKey(ctx, pod, pod.Kind)
+	entry, err := b.podForwardingEntry(pod, containerName, portName, port, ownerReference)
+	if err != nil {
+		return 0, fmt.Errorf("getting pod forwarding entry: %w", err)
+	}
+	if prevEntry, ok := b.forwardedResources.Load(entry.key()); ok {
+		if prevEntry.podName == pod.Name {
+			// already forwarded
+			return prevEntry.localPort, nil
+		}
+		b.Terminate(prevEntry)
+	}
+	if err := b.forwardPortForwardEntry(ctx, entry); err != nil {
+		return 0, err
+	}
+	return entry.localPort, nil
+}
+
+// podForwardingEntry returns the entry to forward a container port of a pod,
+// reusing the local port of a previous generation of the pod if any.
+func (b *EntryManager) podForwardingEntry(pod *v1.Pod, containerName, portName string, port int32, ownerReference string) (*portForwardEntry, error) {
+	rv, err := strconv.Atoi(pod.ResourceVersion)
+	if err != nil {
+		return nil, fmt.Errorf("converting resource version to integer: %w", err)
+	}
+	resource := latest.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Port:      schemautil.FromInt(int(port)),
+		Address:   constants.DefaultPortForwardAddress,
+		LocalPort: int(port),
+	}
+	entry := newPortForwardEntry(rv, resource, resource.Name, containerName, portName, ownerReference, 0, true)
+
+	// If we have, return the current entry
+	oldEntry, ok := b.forwardedResources.Load(entry.key())
+
+	if ok {
+		entry.localPort = oldEntry.localPort
+		return entry, nil
+	}
+
+	// retrieve an open port on the host
+	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, &b.forwardedPorts)
+
+	return entry, nil
 }
 
 // Stop terminates all kubectl port-forward commands.
@@ -18,12 +18,17 @@ package portforward
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -56,6 +61,49 @@ func TestStop(t *testing.T) {
 	testutil.CheckDeepEqual(t, 0, fakeForwarder.forwardedPorts.Length())
 }
 
+type failingForwarder struct {
+	testForwarder
+}
+
+func (f *failingForwarder) Forward(context.Context, *portForwardEntry) error {
+	return errors.New("unable to forward")
+}
+
+func TestForwardPortForwardEntryEvents(t *testing.T) {
+	tests := []struct {
+		description string
+		forwarder   EntryForwarder
+		shouldErr   bool
+		expected    []string
+	}{
+		{
+			description: "forwarded",
+			forwarder:   newTestForwarder(),
+			expected:    []string{"forwarded"},
+		},
+		{
+			description: "failed",
+			forwarder:   &failingForwarder{},
+			shouldErr:   true,
+			expected:    []string{"failed", "forwarded"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var events []string
+			t.Override(&portForwardEvent, func(*portForwardEntry) { events = append(events, "forwarded") })
+			t.Override(&portForwardFailedEvent, func(*portForwardEntry, error) { events = append(events, "failed") })
+			pfe := newPortForwardEntry(0, latest.PortForwardResource{Type: constants.Pod, Name: "resource", Namespace: "default"}, "", "", "", "", 9000, false)
+
+			em := NewEntryManager(ioutil.Discard, test.forwarder)
+			err := em.forwardPortForwardEntry(context.Background(), pfe)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, events)
+		})
+	}
+}
+
 func TestForwardedResources(t *testing.T) {
 	pf := &forwardedResources{}
 
@@ -72,3 +120,36 @@ func TestForwardedResources(t *testing.T) {
 		t.Fatal("loaded resource that doesn't exist")
 	}
 }
+
+func TestForwardPodPort(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		event.InitializeState(latest.Pipeline{}, "test", true, true, true)
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+		t.Override(&retrieveAvailablePort, func(_ string, port int, _ *util.PortSet) int { return port + 1 })
+
+		pod := func(name, resourceVersion string) *v1.Pod {
+			return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion}}
+		}
+		fakeForwarder := newTestForwarder()
+		em := NewEntryManager(ioutil.Discard, fakeForwarder)
+
+		localPort, err := em.ForwardPodPort(context.Background(), pod("pod1", "1"), "container", "jdwp", 5005)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(5006, localPort)
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+
+		// forwarding the same pod again reuses the forward
+		localPort, err = em.ForwardPodPort(context.Background(), pod("pod1", "1"), "container", "jdwp", 5005)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(5006, localPort)
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+
+		// a new generation of the pod is forwarded to the same local port
+		localPort, err = em.ForwardPodPort(context.Background(), pod("pod2", "2"), "container", "jdwp", 5005)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(5006, localPort)
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+		entry, _ := em.forwardedResources.Load("owner-container-default-jdwp-5005")
+		t.CheckDeepEqual("pod2", entry.podName)
+	})
+}
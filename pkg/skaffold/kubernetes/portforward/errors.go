@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+func podNotFoundErr(pfe *portForwardEntry, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("port forwarding %v: no running pod to connect to: %s", pfe, err),
+			ErrCode: proto.StatusCode_PORTFORWARD_POD_NOT_FOUND_ERR,
+		})
+}
+
+func connectionErr(pfe *portForwardEntry, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("port forwarding %v got terminated: %s", pfe, err),
+			ErrCode: proto.StatusCode_PORTFORWARD_CONNECTION_ERR,
+		})
+}
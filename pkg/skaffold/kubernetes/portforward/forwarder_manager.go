@@ -18,8 +18,12 @@ package portforward
 
 import (
 	"context"
+	"errors"
 	"io"
 
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
@@ -34,22 +38,39 @@ type Forwarder interface {
 
 // ForwarderManager manages all forwarders
 type ForwarderManager struct {
-	forwarders []Forwarder
+	forwarders   []Forwarder
+	entryManager *EntryManager
 }
 
-// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
+// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding.
+// When port forwarding is not enabled, ports are only forwarded on demand through ForwardPodPort.
 func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes.PodSelector, namespaces []string, label string, opts config.PortForwardOptions, userDefined []*latest.PortForwardResource) *ForwarderManager {
-	entryManager := NewEntryManager(out, NewKubectlForwarder(out, cli))
+	entryManager := NewEntryManager(out, newEntryForwarder(out, cli, opts.Mode))
 
 	var forwarders []Forwarder
-	forwarders = append(forwarders, NewResourceForwarder(entryManager, namespaces, label, userDefined))
-	if opts.ForwardPods {
-		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, podSelector, namespaces))
+	if opts.Enabled {
+		forwarders = append(forwarders, NewResourceForwarder(entryManager, namespaces, label, userDefined))
+		if opts.ForwardPods {
+			forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, podSelector, namespaces))
+		}
 	}
 
 	return &ForwarderManager{
-		forwarders: forwarders,
+		forwarders:   forwarders,
+		entryManager: entryManager,
+	}
+}
+
+// newEntryForwarder returns the EntryForwarder for a port forward mode.
+func newEntryForwarder(out io.Writer, cli *kubectl.CLI, mode string) EntryForwarder {
+	switch mode {
+	case NativeForwardMode:
+		return NewNativeForwarder(out)
+	case KubectlForwardMode, "":
+	default:
+		logrus.Warnf("Unknown port forward mode %q, forwarding ports with %s", mode, KubectlForwardMode)
 	}
+	return NewKubectlForwarder(out, cli)
 }
 
 // Start begins all forwarders managed by the ForwarderManager
@@ -77,4 +98,16 @@ func (p *ForwarderManager) Stop() {
 	for _, f := range p.forwarders {
 		f.Stop()
 	}
+	// terminate the ports forwarded on demand
+	p.entryManager.Stop()
+}
+
+// ForwardPodPort forwards a container port of a pod on demand and returns the local port.
+func (p *ForwarderManager) ForwardPodPort(ctx context.Context, pod *v1.Pod, containerName, portName string, port int32) (int, error) {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return 0, errors.New("port forwarding is not available")
+	}
+
+	return p.entryManager.ForwardPodPort(ctx, pod, containerName, portName, port)
 }
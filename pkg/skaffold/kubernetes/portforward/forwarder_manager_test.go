@@ -18,7 +18,13 @@ package portforward
 
 import (
 	"context"
+	"io/ioutil"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
 func TestForwarderManagerZeroValue(t *testing.T) {
@@ -27,4 +33,40 @@ func TestForwarderManagerZeroValue(t *testing.T) {
 	// Should not raise a nil dereference
 	m.Start(context.Background())
 	m.Stop()
+
+	_, err := m.ForwardPodPort(context.Background(), &v1.Pod{}, "container", "dlv", 56268)
+	testutil.CheckError(t, true, err)
+}
+
+func TestNewEntryForwarder(t *testing.T) {
+	tests := []struct {
+		description string
+		mode        string
+		expected    EntryForwarder
+	}{
+		{
+			description: "default",
+			expected:    &KubectlForwarder{out: ioutil.Discard},
+		},
+		{
+			description: "kubectl",
+			mode:        KubectlForwardMode,
+			expected:    &KubectlForwarder{out: ioutil.Discard},
+		},
+		{
+			description: "native",
+			mode:        NativeForwardMode,
+			expected:    &NativeForwarder{out: ioutil.Discard},
+		},
+		{
+			description: "unknown mode falls back to kubectl",
+			mode:        "unknown",
+			expected:    &KubectlForwarder{out: ioutil.Discard},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, newEntryForwarder(ioutil.Discard, nil, test.mode), cmp.AllowUnexported(KubectlForwarder{}, NativeForwarder{}))
+		})
+	}
 }
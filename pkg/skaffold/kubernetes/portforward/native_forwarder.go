@@ -0,0 +1,425 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	k8sportforward "k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// KubectlForwardMode forwards ports with one `kubectl port-forward` process per entry.
+	KubectlForwardMode = "kubectl"
+
+	// NativeForwardMode forwards ports in-process, over SPDY streams opened through the Kubernetes API.
+	NativeForwardMode = "native"
+)
+
+// For testing
+var (
+	forwardPod    = spdyForwardPod
+	waitReconnect = 1 * time.Second
+)
+
+// podTarget is the pod, and the port of that pod, that a port forward entry is connected to.
+type podTarget struct {
+	pod  string
+	port int
+}
+
+// NativeForwarder forwards ports with client-go instead of spawning `kubectl port-forward` processes.
+// Each entry is resolved to a running pod, and reconnected to a new pod whenever that pod goes away.
+type NativeForwarder struct {
+	out io.Writer
+}
+
+// NewNativeForwarder returns a new NativeForwarder
+func NewNativeForwarder(out io.Writer) *NativeForwarder {
+	return &NativeForwarder{
+		out: out,
+	}
+}
+
+// Forward port-forwards a resource in the background.
+// It returns once the first connection is established, or failed.
+// The connection is re-established, to a new pod if needed, until the entry is terminated.
+func (n *NativeForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	errChan := make(chan error, 1)
+	go n.forward(parentCtx, pfe, errChan)
+	return <-errChan
+}
+
+func (n *NativeForwarder) forward(parentCtx context.Context, pfe *portForwardEntry, errChan chan error) {
+	// reported is set once the outcome of the first connection is sent to errChan.
+	// connected and failing track the state of the last connection, to notify about reconnects and failures only once.
+	var notifiedUser, reported, connected, failing bool
+	defer deferFunc()
+
+	for {
+		pfe.terminationLock.Lock()
+		if pfe.terminated {
+			logrus.Debugf("port forwarding %v was cancelled...", pfe)
+			pfe.terminationLock.Unlock()
+			notify(errChan, nil)
+			return
+		}
+		ctx, cancel := context.WithCancel(parentCtx)
+		pfe.cancel = cancel
+		pfe.terminationLock.Unlock()
+
+		if !isPortFree(util.Loopback, pfe.localPort) {
+			color.Red.Fprintf(n.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, pfe.localPort)
+			notifiedUser = true
+			cancel()
+			time.Sleep(waitPortNotFree)
+			continue
+		}
+
+		if notifiedUser {
+			color.Green.Fprintf(n.out, "port forwarding %v recovered on port %d\n", pfe, pfe.localPort)
+			notifiedUser = false
+		}
+
+		target, err := n.connect(ctx, pfe, func(target podTarget) {
+			switch {
+			case !reported:
+				reported = true
+				notify(errChan, nil)
+			case connected || failing:
+				color.Green.Fprintf(n.out, "port forwarding %v reconnected to pod %s\n", pfe, target.pod)
+				portForwardReconnectedEvent(pfe, target.pod)
+			}
+			connected = true
+			failing = false
+		})
+		if ctx.Err() == context.Canceled {
+			logrus.Debugf("terminated %v due to context cancellation", pfe)
+			cancel()
+			notify(errChan, nil)
+			return
+		}
+		cancel()
+
+		switch {
+		case err == nil:
+			logrus.Debugf("port forwarding %v lost its connection to pod %s, reconnecting", pfe, target.pod)
+		case !reported:
+			reported = true
+			failing = true
+			notify(errChan, err)
+		case !failing:
+			failing = true
+			connected = false
+			color.Red.Fprintf(n.out, "port forwarding %v failed: %s, retrying...\n", pfe, err)
+			portForwardFailedEvent(pfe, err)
+		default:
+			logrus.Debugf("port forwarding %v failed: %s", pfe, err)
+		}
+		time.Sleep(waitReconnect)
+	}
+}
+
+// connect forwards the entry's local port to a running pod, until the connection is lost,
+// the pod is deleted or stops running, or ctx is cancelled.
+// onReady is called, from the calling goroutine, once the local port is listening.
+func (n *NativeForwarder) connect(ctx context.Context, pfe *portForwardEntry, onReady func(podTarget)) (podTarget, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return podTarget{}, connectionErr(pfe, fmt.Errorf("getting Kubernetes client: %w", err))
+	}
+
+	target, err := resolvePodTarget(ctx, client, pfe.resource)
+	if err != nil {
+		return podTarget{}, podNotFoundErr(pfe, err)
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go watchPod(sessionCtx, cancel, client, pfe.resource.Namespace, target.pod)
+
+	ready := make(chan struct{})
+	done := make(chan error, 1)
+	go func() { done <- forwardPod(sessionCtx, pfe, target, ready) }()
+
+	select {
+	case <-ready:
+		logrus.Debugf("port forwarding %v connected to pod %s, port %d", pfe, target.pod, target.port)
+		onReady(target)
+		err = <-done
+	case err = <-done:
+	}
+	if err != nil {
+		return target, connectionErr(pfe, err)
+	}
+	return target, nil
+}
+
+// notify sends the outcome of the first connection to Forward, without blocking.
+func notify(errChan chan error, err error) {
+	select {
+	case errChan <- err:
+	default:
+	}
+}
+
+// spdyForwardPod forwards a local port to a pod over a SPDY connection, until the connection is closed or ctx is cancelled.
+func spdyForwardPod(ctx context.Context, pfe *portForwardEntry, target podTarget, ready chan struct{}) error {
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return err
+	}
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(target.pod).
+		Namespace(pfe.resource.Namespace).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	ports := []string{fmt.Sprintf("%d:%d", pfe.localPort, target.port)}
+
+	fw, err := k8sportforward.NewOnAddresses(dialer, []string{address}, ports, ctx.Done(), ready, traceWriter{}, traceWriter{})
+	if err != nil {
+		return err
+	}
+	return fw.ForwardPorts()
+}
+
+// traceWriter logs the output of the port forwarder at trace level.
+type traceWriter struct{}
+
+func (traceWriter) Write(p []byte) (int, error) {
+	logrus.Tracef("[port-forward] %s", strings.TrimSpace(string(p)))
+	return len(p), nil
+}
+
+// watchPod calls cancel when the given pod is deleted or stops running.
+func watchPod(ctx context.Context, cancel context.CancelFunc, client kubernetes.Interface, ns, name string) {
+	for ctx.Err() == nil {
+		w, err := client.CoreV1().Pods(ns).Watch(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+		})
+		if err != nil {
+			logrus.Debugf("watching pod %s/%s: %s", ns, name, err)
+			return
+		}
+
+		for evt := range w.ResultChan() {
+			pod, ok := evt.Object.(*corev1.Pod)
+			if !ok || pod.Name != name {
+				continue
+			}
+			if evt.Type == watch.Deleted || pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+				logrus.Debugf("pod %s/%s is going away", ns, name)
+				w.Stop()
+				cancel()
+				return
+			}
+		}
+		w.Stop()
+	}
+}
+
+// resolvePodTarget finds the newest running pod backing a resource, and the pod port that the resource's port maps to.
+func resolvePodTarget(ctx context.Context, client kubernetes.Interface, resource latest.PortForwardResource) (podTarget, error) {
+	ns := resource.Namespace
+
+	switch strings.ToLower(string(resource.Type)) {
+	case "pod":
+		pod, err := client.CoreV1().Pods(ns).Get(ctx, resource.Name, metav1.GetOptions{})
+		if err != nil {
+			return podTarget{}, fmt.Errorf("getting pod %s/%s: %w", ns, resource.Name, err)
+		}
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			return podTarget{}, fmt.Errorf("pod %s/%s is not running", ns, resource.Name)
+		}
+		port, err := findContainerPort(*pod, resource.Port)
+		if err != nil {
+			return podTarget{}, err
+		}
+		return podTarget{pod: pod.Name, port: port}, nil
+
+	case "service":
+		svc, err := client.CoreV1().Services(ns).Get(ctx, resource.Name, metav1.GetOptions{})
+		if err != nil {
+			return podTarget{}, fmt.Errorf("getting service %s/%s: %w", ns, resource.Name, err)
+		}
+		svcPort, err := findServicePort(*svc, resource.Port)
+		if err != nil {
+			return podTarget{}, err
+		}
+		pods, err := runningPods(ctx, client, ns, labels.SelectorFromSet(svc.Spec.Selector))
+		if err != nil {
+			return podTarget{}, err
+		}
+		for _, p := range pods {
+			if targetPort := findTargetPort(svcPort, p); targetPort > 0 {
+				return podTarget{pod: p.Name, port: targetPort}, nil
+			}
+		}
+		return podTarget{}, fmt.Errorf("no running pods match service %s/%s", resource.Name, resource.Port.String())
+
+	default:
+		selector, err := podSelector(ctx, client, resource)
+		if err != nil {
+			return podTarget{}, err
+		}
+		pods, err := runningPods(ctx, client, ns, selector)
+		if err != nil {
+			return podTarget{}, err
+		}
+		for _, p := range pods {
+			if port, err := findContainerPort(p, resource.Port); err == nil {
+				return podTarget{pod: p.Name, port: port}, nil
+			}
+		}
+		return podTarget{}, fmt.Errorf("no running pods of %s/%s expose port %s", resource.Type, resource.Name, resource.Port.String())
+	}
+}
+
+// podSelector returns the label selector of the pods managed by a controller resource.
+func podSelector(ctx context.Context, client kubernetes.Interface, resource latest.PortForwardResource) (labels.Selector, error) {
+	ns, name := resource.Namespace, resource.Name
+
+	var selector *metav1.LabelSelector
+	switch strings.ToLower(string(resource.Type)) {
+	case "deployment":
+		d, err := client.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting deployment %s/%s: %w", ns, name, err)
+		}
+		selector = d.Spec.Selector
+	case "replicaset":
+		rs, err := client.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting replicaset %s/%s: %w", ns, name, err)
+		}
+		selector = rs.Spec.Selector
+	case "statefulset":
+		ss, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting statefulset %s/%s: %w", ns, name, err)
+		}
+		selector = ss.Spec.Selector
+	case "daemonset":
+		ds, err := client.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting daemonset %s/%s: %w", ns, name, err)
+		}
+		selector = ds.Spec.Selector
+	case "job":
+		job, err := client.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting job %s/%s: %w", ns, name, err)
+		}
+		selector = job.Spec.Selector
+	case "replicationcontroller":
+		rc, err := client.CoreV1().ReplicationControllers(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("getting replicationcontroller %s/%s: %w", ns, name, err)
+		}
+		return labels.SelectorFromSet(rc.Spec.Selector), nil
+	default:
+		return nil, fmt.Errorf("resource type %q can't be forwarded with the %s port forward mode", resource.Type, NativeForwardMode)
+	}
+
+	if selector == nil {
+		return nil, fmt.Errorf("%s %s/%s has no pod selector", resource.Type, ns, name)
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// runningPods lists the running pods that match a selector, newest first.
+func runningPods(ctx context.Context, client kubernetes.Interface, ns string, selector labels.Selector) ([]corev1.Pod, error) {
+	podsList, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range podsList.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Slice(pods, newestPodsFirst(pods))
+	return pods, nil
+}
+
+// findContainerPort maps a port number or name to a port exposed by a pod's containers.
+func findContainerPort(pod corev1.Pod, port schemautil.IntOrString) (int, error) {
+	if port.Type == schemautil.Int {
+		return port.IntVal, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return int(p.ContainerPort), nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("pod %s/%s does not expose port %s", pod.Namespace, pod.Name, port.String())
+}
+
+// Terminate stops forwarding an entry and closes its local port.
+func (*NativeForwarder) Terminate(p *portForwardEntry) {
+	logrus.Debugf("Terminating port-forward %v", p)
+
+	p.terminationLock.Lock()
+	defer p.terminationLock.Unlock()
+
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.terminated = true
+}
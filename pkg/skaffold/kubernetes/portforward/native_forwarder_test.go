@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func runningPod(name string, created time.Time, labels map[string]string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: labels, CreationTimestamp: metav1.NewTime(created)},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Ports: ports}}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestResolvePodTarget(t *testing.T) {
+	now := time.Now()
+	appLabels := map[string]string{"app": "web"}
+	httpPort := corev1.ContainerPort{Name: "http", ContainerPort: 8080}
+
+	pending := runningPod("pending", now, appLabels, httpPort)
+	pending.Status.Phase = corev1.PodPending
+
+	tests := []struct {
+		description string
+		resource    latest.PortForwardResource
+		objects     []pkgruntime.Object
+		expected    podTarget
+		shouldErr   bool
+	}{
+		{
+			description: "pod with port number",
+			resource:    latest.PortForwardResource{Type: "pod", Name: "web", Namespace: "ns", Port: schemautil.FromInt(9000)},
+			objects:     []pkgruntime.Object{runningPod("web", now, nil)},
+			expected:    podTarget{pod: "web", port: 9000},
+		},
+		{
+			description: "pod with port name",
+			resource:    latest.PortForwardResource{Type: "Pod", Name: "web", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects:     []pkgruntime.Object{runningPod("web", now, nil, httpPort)},
+			expected:    podTarget{pod: "web", port: 8080},
+		},
+		{
+			description: "pod not running",
+			resource:    latest.PortForwardResource{Type: "pod", Name: "pending", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			objects:     []pkgruntime.Object{pending},
+			shouldErr:   true,
+		},
+		{
+			description: "service forwards to the newest running pod",
+			resource:    latest.PortForwardResource{Type: "service", Name: "svc", Namespace: "ns", Port: schemautil.FromInt(80)},
+			objects: []pkgruntime.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns"},
+					Spec: corev1.ServiceSpec{
+						Selector: appLabels,
+						Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromString("http")}},
+					},
+				},
+				runningPod("old", now.Add(-time.Minute), appLabels, httpPort),
+				runningPod("new", now, appLabels, httpPort),
+				pending,
+			},
+			expected: podTarget{pod: "new", port: 8080},
+		},
+		{
+			description: "service without running pods",
+			resource:    latest.PortForwardResource{Type: "service", Name: "svc", Namespace: "ns", Port: schemautil.FromInt(80)},
+			objects: []pkgruntime.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "ns"},
+					Spec: corev1.ServiceSpec{
+						Selector: appLabels,
+						Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080)}},
+					},
+				},
+				pending,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "deployment",
+			resource:    latest.PortForwardResource{Type: "deployment", Name: "web", Namespace: "ns", Port: schemautil.FromString("http")},
+			objects: []pkgruntime.Object{
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+					Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: appLabels}},
+				},
+				runningPod("web-1", now, appLabels, httpPort),
+				runningPod("other", now, map[string]string{"app": "other"}, httpPort),
+			},
+			expected: podTarget{pod: "web-1", port: 8080},
+		},
+		{
+			description: "unsupported resource type",
+			resource:    latest.PortForwardResource{Type: "cronjob", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			target, err := resolvePodTarget(context.Background(), fake.NewSimpleClientset(test.objects...), test.resource)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, target, cmp.AllowUnexported(podTarget{}))
+		})
+	}
+}
+
+func TestNativeForwarderReconnects(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&waitReconnect, 10*time.Millisecond)
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(runningPod("web", time.Now(), nil)), nil
+		})
+
+		var forwardFunctionWG sync.WaitGroup
+		forwardFunctionWG.Add(1)
+		t.Override(&deferFunc, forwardFunctionWG.Done)
+
+		// The first connection is lost, the second one fails and the third one stays up.
+		var lock sync.Mutex
+		var calls int
+		t.Override(&forwardPod, func(ctx context.Context, _ *portForwardEntry, _ podTarget, ready chan struct{}) error {
+			lock.Lock()
+			calls++
+			call := calls
+			lock.Unlock()
+
+			switch call {
+			case 1:
+				close(ready)
+				return nil
+			case 2:
+				return errors.New("error upgrading connection")
+			default:
+				close(ready)
+				<-ctx.Done()
+				return nil
+			}
+		})
+
+		var events []string
+		reconnected := make(chan struct{})
+		t.Override(&portForwardFailedEvent, func(_ *portForwardEntry, err error) {
+			events = append(events, "failed")
+			t.CheckDeepEqual(proto.StatusCode_PORTFORWARD_CONNECTION_ERR, sErrors.ActionableErr(sErrors.PortForward, err).ErrCode)
+		})
+		t.Override(&portForwardReconnectedEvent, func(_ *portForwardEntry, podName string) {
+			events = append(events, "reconnected to "+podName)
+			close(reconnected)
+		})
+
+		var buf bytes.Buffer
+		n := NewNativeForwarder(&buf)
+		pfe := newPortForwardEntry(0, latest.PortForwardResource{Type: "pod", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)}, "", "", "", "", 9000, false)
+
+		err := n.Forward(context.Background(), pfe)
+		t.CheckNoError(err)
+
+		<-reconnected
+		n.Terminate(pfe)
+		forwardFunctionWG.Wait()
+
+		t.CheckDeepEqual([]string{"failed", "reconnected to web"}, events)
+		t.CheckContains("reconnected to pod web", buf.String())
+	})
+}
+
+func TestNativeForwarderPodNotFound(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return true })
+		t.Override(&waitReconnect, 10*time.Millisecond)
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(), nil
+		})
+		t.Override(&forwardPod, func(context.Context, *portForwardEntry, podTarget, chan struct{}) error {
+			t.Fatal("no pod should be forwarded")
+			return nil
+		})
+
+		var forwardFunctionWG sync.WaitGroup
+		forwardFunctionWG.Add(1)
+		t.Override(&deferFunc, forwardFunctionWG.Done)
+
+		n := NewNativeForwarder(&bytes.Buffer{})
+		pfe := newPortForwardEntry(0, latest.PortForwardResource{Type: "pod", Name: "web", Namespace: "ns", Port: schemautil.FromInt(8080)}, "", "", "", "", 9000, false)
+
+		err := n.Forward(context.Background(), pfe)
+		n.Terminate(pfe)
+		forwardFunctionWG.Wait()
+
+		t.CheckError(true, err)
+		t.CheckDeepEqual(proto.StatusCode_PORTFORWARD_POD_NOT_FOUND_ERR, sErrors.ActionableErr(sErrors.PortForward, err).ErrCode)
+	})
+}
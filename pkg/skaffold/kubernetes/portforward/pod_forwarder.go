@@ -19,17 +19,13 @@ package portforward
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
-	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 )
 
 var (
@@ -98,16 +94,7 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	for _, c := range pod.Spec.Containers {
 		for _, port := range c.Ports {
 			// get current entry for this container
-			resource := latest.PortForwardResource{
-				Type:      constants.Pod,
-				Name:      pod.Name,
-				Namespace: pod.Namespace,
-				Port:      schemautil.FromInt(int(port.ContainerPort)),
-				Address:   constants.DefaultPortForwardAddress,
-				LocalPort: int(port.ContainerPort),
-			}
-
-			entry, err := p.podForwardingEntry(pod.ResourceVersion, c.Name, port.Name, ownerReference, resource)
+			entry, err := p.entryManager.podForwardingEntry(pod, c.Name, port.Name, port.ContainerPort, ownerReference)
 			if err != nil {
 				return fmt.Errorf("getting pod forwarding entry: %w", err)
 			}
@@ -125,24 +112,3 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	}
 	return nil
 }
-
-func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName, portName, ownerReference string, resource latest.PortForwardResource) (*portForwardEntry, error) {
-	rv, err := strconv.Atoi(resourceVersion)
-	if err != nil {
-		return nil, fmt.Errorf("converting resource version to integer: %w", err)
-	}
-	entry := newPortForwardEntry(rv, resource, resource.Name, containerName, portName, ownerReference, 0, true)
-
-	// If we have, return the current entry
-	oldEntry, ok := p.entryManager.forwardedResources.Load(entry.key())
-
-	if ok {
-		entry.localPort = oldEntry.localPort
-		return entry, nil
-	}
-
-	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, &p.entryManager.forwardedPorts)
-
-	return entry, nil
-}
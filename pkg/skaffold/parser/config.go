@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Graph describes how the configuration files of a project require each other.
+type Graph struct {
+	// Files lists all the configuration files, starting with the root configuration.
+	Files []string
+
+	// Requires maps each configuration file to the files it requires.
+	Requires map[string][]string
+}
+
+// For testing
+var parseConfig = schema.ParseConfigAndUpgrade
+
+// GetConfig parses the skaffold configuration file given in the options, along with all the
+// configurations it requires, and merges them into a single configuration.
+// Each configuration is activated with its own profiles before being merged.
+func GetConfig(opts config.SkaffoldOptions) (*latest.SkaffoldConfig, *Graph, error) {
+	p := &parser{
+		opts:     opts,
+		graph:    &Graph{Requires: map[string][]string{}},
+		profiles: map[string]string{},
+	}
+
+	root, err := p.parse(opts.ConfigurationFile, opts.Profiles, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return root, p.graph, nil
+}
+
+type parser struct {
+	opts  config.SkaffoldOptions
+	graph *Graph
+	// profiles records the profiles each configuration file was activated with.
+	profiles map[string]string
+}
+
+// parse parses a configuration file, applies its profiles and merges the configurations it requires into it.
+// `chain` is the list of configuration files that lead to this one, used to detect cycles.
+func (p *parser) parse(file string, profiles []string, chain []string) (*latest.SkaffoldConfig, error) {
+	parsed, err := parseConfig(file, latest.Version)
+	if err != nil {
+		if len(chain) == 0 {
+			return nil, err
+		}
+		return nil, fmt.Errorf("parsing config %q required by %q: %w", file, chain[len(chain)-1], err)
+	}
+	cfg := parsed.(*latest.SkaffoldConfig)
+
+	opts := p.opts
+	opts.ConfigurationFile = file
+	opts.Profiles = profiles
+
+	activated, err := schema.ActivatedProfiles(cfg, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding auto-activated profiles of %q: %w", file, err)
+	}
+	if err := schema.ApplyProfiles(cfg, opts); err != nil {
+		return nil, fmt.Errorf("applying profiles of %q: %w", file, err)
+	}
+
+	// Defaults are set before merging, otherwise the defaults of a config, like its manifests,
+	// would be lost when merged with a required config that sets them.
+	defaults.SetPaths(cfg)
+
+	// Paths in the root config are relative to the directory Skaffold runs from.
+	if len(chain) > 0 {
+		rebasePaths(cfg, filepath.Dir(file))
+	}
+
+	p.graph.Files = append(p.graph.Files, file)
+	p.profiles[file] = profileKey(profiles)
+
+	path := append(append([]string{}, chain...), file)
+	for _, d := range cfg.Dependencies {
+		depFile, err := resolvePath(file, d.Path)
+		if err != nil {
+			return nil, err
+		}
+		if util.StrSliceContains(path, depFile) {
+			return nil, fmt.Errorf("cycle detected in required configs: %s -> %s", strings.Join(path, " -> "), depFile)
+		}
+		depProfiles := requiredProfiles(d, activated)
+		p.graph.Requires[file] = append(p.graph.Requires[file], depFile)
+
+		if previous, found := p.profiles[depFile]; found {
+			// The config was already merged, through another path in the graph.
+			if previous != profileKey(depProfiles) {
+				return nil, fmt.Errorf("config %q is required with different profiles: [%s] and [%s]", depFile, previous, profileKey(depProfiles))
+			}
+			continue
+		}
+
+		dep, err := p.parse(depFile, depProfiles, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := merge(cfg, dep); err != nil {
+			return nil, fmt.Errorf("merging config %q into %q: %w", depFile, file, err)
+		}
+	}
+	// The required configs are now part of this config.
+	cfg.Dependencies = nil
+
+	return cfg, nil
+}
+
+// resolvePath returns the path of a required configuration file.
+// Relative paths are resolved from the directory of the requiring file
+// and a directory refers to the `skaffold.yaml` file it contains.
+func resolvePath(from, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(from), path)
+	}
+
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("config %q required by %q doesn't exist", path, from)
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading config %q required by %q: %w", path, from, err)
+	}
+	if fi.IsDir() {
+		path = filepath.Join(path, "skaffold.yaml")
+	}
+	return path, nil
+}
+
+// requiredProfiles returns the profiles to activate in a required config, given the profiles activated in the current config.
+func requiredProfiles(d latest.ConfigDependency, activated []string) []string {
+	var profiles []string
+	for _, pd := range d.ActiveProfiles {
+		if len(pd.ActivatedBy) == 0 {
+			profiles = append(profiles, pd.Name)
+			continue
+		}
+		for _, by := range pd.ActivatedBy {
+			if util.StrSliceContains(activated, by) {
+				profiles = append(profiles, pd.Name)
+				break
+			}
+		}
+	}
+	return profiles
+}
+
+func profileKey(profiles []string) string {
+	sorted := append([]string{}, profiles...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func cfg(yaml string) string {
+	return fmt.Sprintf("apiVersion: %s\nkind: Config\n%s", latest.Version, yaml)
+}
+
+func TestGetConfig(t *testing.T) {
+	tests := []struct {
+		description       string
+		files             map[string]string
+		profiles          []string
+		shouldErr         bool
+		expectedImages    []string
+		expectedWorkspace []string
+		expectedManifests []string
+		expectedTests     []string
+		expectedFiles     []string
+		expectedRequires  map[string][]string
+	}{
+		{
+			description: "single config",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: app
+deploy:
+  kubectl: {}`),
+			},
+			expectedImages:    []string{"app"},
+			expectedWorkspace: []string{"."},
+			expectedManifests: []string{"k8s/*.yaml"},
+			expectedFiles:     []string{"skaffold.yaml"},
+			expectedRequires:  map[string][]string{},
+		},
+		{
+			description: "required config",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: backend
+build:
+  artifacts:
+  - image: app
+    requires:
+    - image: backend
+      alias: BACKEND
+deploy:
+  kubectl:
+    manifests: [app.yaml]`),
+				"backend/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: backend
+    context: src
+deploy:
+  kubectl: {}`),
+			},
+			expectedImages:    []string{"backend", "app"},
+			expectedWorkspace: []string{"backend/src", "."},
+			expectedManifests: []string{"app.yaml", "backend/k8s/*.yaml"},
+			expectedFiles:     []string{"skaffold.yaml", "backend/skaffold.yaml"},
+			expectedRequires:  map[string][]string{"skaffold.yaml": {"backend/skaffold.yaml"}},
+		},
+		{
+			description: "root config without deploy section",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: backend
+build:
+  artifacts:
+  - image: app`),
+				"backend/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: backend
+deploy:
+  kubectl:
+    manifests: [deployment.yaml]`),
+			},
+			expectedImages:    []string{"backend", "app"},
+			expectedWorkspace: []string{"backend", "."},
+			expectedManifests: []string{"k8s/*.yaml", "backend/deployment.yaml"},
+			expectedFiles:     []string{"skaffold.yaml", "backend/skaffold.yaml"},
+			expectedRequires:  map[string][]string{"skaffold.yaml": {"backend/skaffold.yaml"}},
+		},
+		{
+			description: "tests of required config",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: backend
+build:
+  artifacts:
+  - image: app
+test:
+- image: app
+  custom:
+  - command: ./test.sh`),
+				"backend/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: backend
+test:
+- image: backend
+  context: tests
+  custom:
+  - command: ./test.sh
+    dependencies:
+      paths: [src/**]`),
+			},
+			expectedImages:    []string{"backend", "app"},
+			expectedWorkspace: []string{"backend", "."},
+			expectedManifests: []string{"k8s/*.yaml", "backend/k8s/*.yaml"},
+			expectedTests:     []string{"backend/tests", "."},
+			expectedFiles:     []string{"skaffold.yaml", "backend/skaffold.yaml"},
+			expectedRequires:  map[string][]string{"skaffold.yaml": {"backend/skaffold.yaml"}},
+		},
+		{
+			description: "required config activated with profiles",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: backend/skaffold.yaml
+  activeProfiles:
+  - name: dev
+    activatedBy: [local]
+build:
+  artifacts:
+  - image: app
+profiles:
+- name: local`),
+				"backend/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: backend
+profiles:
+- name: dev
+  patches:
+  - path: /build/artifacts/0/image
+    value: backend-dev`),
+			},
+			profiles:          []string{"local"},
+			expectedImages:    []string{"backend-dev", "app"},
+			expectedWorkspace: []string{"backend", "."},
+			expectedManifests: []string{"k8s/*.yaml", "backend/k8s/*.yaml"},
+			expectedFiles:     []string{"skaffold.yaml", "backend/skaffold.yaml"},
+			expectedRequires:  map[string][]string{"skaffold.yaml": {"backend/skaffold.yaml"}},
+		},
+		{
+			description: "profile not activated in required config",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: backend/skaffold.yaml
+  activeProfiles:
+  - name: dev
+    activatedBy: [local]
+build:
+  artifacts:
+  - image: app
+profiles:
+- name: local`),
+				"backend/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: backend
+profiles:
+- name: dev
+  patches:
+  - path: /build/artifacts/0/image
+    value: backend-dev`),
+			},
+			expectedImages:    []string{"backend", "app"},
+			expectedWorkspace: []string{"backend", "."},
+			expectedManifests: []string{"k8s/*.yaml", "backend/k8s/*.yaml"},
+			expectedFiles:     []string{"skaffold.yaml", "backend/skaffold.yaml"},
+			expectedRequires:  map[string][]string{"skaffold.yaml": {"backend/skaffold.yaml"}},
+		},
+		{
+			description: "config required twice is merged once",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: a
+- path: b`),
+				"a/skaffold.yaml": cfg(`requires:
+- path: ../common
+build:
+  artifacts:
+  - image: a`),
+				"b/skaffold.yaml": cfg(`requires:
+- path: ../common
+build:
+  artifacts:
+  - image: b`),
+				"common/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: common`),
+			},
+			expectedImages:    []string{"b", "common", "a"},
+			expectedWorkspace: []string{"b", "common", "a"},
+			expectedManifests: []string{"k8s/*.yaml", "a/k8s/*.yaml", "common/k8s/*.yaml", "b/k8s/*.yaml"},
+			expectedFiles:     []string{"skaffold.yaml", "a/skaffold.yaml", "common/skaffold.yaml", "b/skaffold.yaml"},
+			expectedRequires: map[string][]string{
+				"skaffold.yaml":   {"a/skaffold.yaml", "b/skaffold.yaml"},
+				"a/skaffold.yaml": {"common/skaffold.yaml"},
+				"b/skaffold.yaml": {"common/skaffold.yaml"},
+			},
+		},
+		{
+			description: "config required with different profiles",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: a
+- path: a
+  activeProfiles:
+  - name: p`),
+				"a/skaffold.yaml": cfg(`profiles:
+- name: p`),
+			},
+			shouldErr: true,
+		},
+		{
+			description: "cycle",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: a`),
+				"a/skaffold.yaml": cfg(`requires:
+- path: ..`),
+			},
+			shouldErr: true,
+		},
+		{
+			description: "artifact defined twice",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: a
+build:
+  artifacts:
+  - image: app`),
+				"a/skaffold.yaml": cfg(`build:
+  artifacts:
+  - image: app`),
+			},
+			shouldErr: true,
+		},
+		{
+			description: "missing required config",
+			files: map[string]string{
+				"skaffold.yaml": cfg(`requires:
+- path: missing`),
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			cfg, graph, err := GetConfig(config.SkaffoldOptions{ConfigurationFile: "skaffold.yaml", Profiles: test.profiles})
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			var images, workspaces []string
+			for _, a := range cfg.Build.Artifacts {
+				images = append(images, a.ImageName)
+				workspaces = append(workspaces, a.Workspace)
+			}
+			t.CheckDeepEqual(test.expectedImages, images)
+			t.CheckDeepEqual(test.expectedWorkspace, workspaces)
+			if cfg.Deploy.KubectlDeploy != nil {
+				t.CheckDeepEqual(test.expectedManifests, cfg.Deploy.KubectlDeploy.Manifests)
+			}
+			var tests []string
+			for _, tc := range cfg.Test {
+				tests = append(tests, tc.Workspace)
+			}
+			t.CheckDeepEqual(test.expectedTests, tests)
+			t.CheckDeepEqual(test.expectedFiles, graph.Files)
+			t.CheckDeepEqual(test.expectedRequires, graph.Requires)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// merge adds the pipeline of a required config to the pipeline of the config that requires it.
+// Settings that apply to the whole pipeline, like the build environment, the tag policy or the
+// deploy flags, are taken from the requiring config.
+func merge(into, dep *latest.SkaffoldConfig) error {
+	images := map[string]bool{}
+	for _, a := range into.Build.Artifacts {
+		images[a.ImageName] = true
+	}
+	for _, a := range dep.Build.Artifacts {
+		if images[a.ImageName] {
+			return fmt.Errorf("artifact %q is defined in more than one config", a.ImageName)
+		}
+	}
+	// Required artifacts come first.
+	into.Build.Artifacts = append(dep.Build.Artifacts, into.Build.Artifacts...)
+
+	for _, r := range dep.Build.InsecureRegistries {
+		if !util.StrSliceContains(into.Build.InsecureRegistries, r) {
+			into.Build.InsecureRegistries = append(into.Build.InsecureRegistries, r)
+		}
+	}
+
+	into.Test = append(dep.Test, into.Test...)
+	into.PortForward = append(dep.PortForward, into.PortForward...)
+
+	return mergeDeploy(&into.Deploy, &dep.Deploy)
+}
+
+func mergeDeploy(into, dep *latest.DeployConfig) error {
+	if dep.KubeContext != "" {
+		if into.KubeContext != "" && into.KubeContext != dep.KubeContext {
+			return fmt.Errorf("configs deploy to different kube-contexts: %q and %q", into.KubeContext, dep.KubeContext)
+		}
+		into.KubeContext = dep.KubeContext
+	}
+
+	if k := dep.KubectlDeploy; k != nil {
+		if into.KubectlDeploy == nil {
+			into.KubectlDeploy = k
+		} else {
+			into.KubectlDeploy.Manifests = append(into.KubectlDeploy.Manifests, k.Manifests...)
+			into.KubectlDeploy.RemoteManifests = append(into.KubectlDeploy.RemoteManifests, k.RemoteManifests...)
+		}
+	}
+
+	if k := dep.KustomizeDeploy; k != nil {
+		if into.KustomizeDeploy == nil {
+			into.KustomizeDeploy = k
+		} else {
+			into.KustomizeDeploy.KustomizePaths = append(into.KustomizeDeploy.KustomizePaths, k.KustomizePaths...)
+		}
+	}
+
+	if h := dep.HelmDeploy; h != nil {
+		if into.HelmDeploy == nil {
+			into.HelmDeploy = h
+		} else {
+			into.HelmDeploy.Releases = append(into.HelmDeploy.Releases, h.Releases...)
+		}
+	}
+
+	if k := dep.KptDeploy; k != nil {
+		if into.KptDeploy != nil {
+			return fmt.Errorf("only one config can use the kpt deployer")
+		}
+		into.KptDeploy = k
+	}
+
+	if s := dep.Strategy; s != nil {
+		if into.Strategy != nil && !reflect.DeepEqual(into.Strategy, s) {
+			return fmt.Errorf("configs use different deploy strategies")
+		}
+		into.Strategy = s
+	}
+
+	if dep.StatusCheckDeadlineSeconds > into.StatusCheckDeadlineSeconds {
+		into.StatusCheckDeadlineSeconds = dep.StatusCheckDeadlineSeconds
+	}
+	into.StatusCheckCustomResources = append(into.StatusCheckCustomResources, dep.StatusCheckCustomResources...)
+	into.LifecycleHooks.PreHooks = append(dep.LifecycleHooks.PreHooks, into.LifecycleHooks.PreHooks...)
+	into.LifecycleHooks.PostHooks = append(into.LifecycleHooks.PostHooks, dep.LifecycleHooks.PostHooks...)
+
+	return nil
+}
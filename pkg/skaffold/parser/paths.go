@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// rebasePaths rewrites the paths of a required config, which are relative to its own directory,
+// so that they are relative to the directory Skaffold runs from.
+// Defaults that are paths must be set first so that they are rebased too.
+func rebasePaths(cfg *latest.SkaffoldConfig, base string) {
+	if base == "." {
+		return
+	}
+
+	rebase := func(p string) string {
+		if p == "" || filepath.IsAbs(p) || util.IsURL(p) {
+			return p
+		}
+		return filepath.Join(base, p)
+	}
+	rebaseAll := func(paths []string) []string {
+		rebased := make([]string, len(paths))
+		for i, p := range paths {
+			rebased[i] = rebase(p)
+		}
+		return rebased
+	}
+
+	for _, a := range cfg.Build.Artifacts {
+		a.Workspace = rebase(a.Workspace)
+	}
+
+	for _, tc := range cfg.Test {
+		// Custom tests run from the test workspace and their dependencies are relative to it.
+		tc.Workspace = rebase(tc.Workspace)
+		tc.StructureTests = rebaseAll(tc.StructureTests)
+	}
+
+	if kubectl := cfg.Deploy.KubectlDeploy; kubectl != nil {
+		kubectl.Manifests = rebaseAll(kubectl.Manifests)
+	}
+
+	if kustomize := cfg.Deploy.KustomizeDeploy; kustomize != nil {
+		kustomize.KustomizePaths = rebaseAll(kustomize.KustomizePaths)
+	}
+
+	if helm := cfg.Deploy.HelmDeploy; helm != nil {
+		for i := range helm.Releases {
+			r := &helm.Releases[i]
+			if !r.Remote {
+				r.ChartPath = rebase(r.ChartPath)
+			}
+			r.ValuesFiles = rebaseAll(r.ValuesFiles)
+			for k, v := range r.SetFiles {
+				r.SetFiles[k] = rebase(v)
+			}
+		}
+	}
+
+	if kpt := cfg.Deploy.KptDeploy; kpt != nil {
+		kpt.Dir = rebase(kpt.Dir)
+		kpt.Fn.FnPath = rebase(kpt.Fn.FnPath)
+	}
+}
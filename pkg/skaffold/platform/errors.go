@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// MultiPlatformNoPushErr is returned when an image built for several platforms would be loaded
+// into the local Docker daemon, which only holds images for a single platform.
+func MultiPlatformNoPushErr(image string) error {
+	return sErrors.NewErrorWithStatusCode(
+		proto.ActionableErr{
+			Message: fmt.Sprintf("artifact %s targets several platforms and must be pushed to a registry", image),
+			ErrCode: proto.StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_PUSH_MULTI_PLATFORM_IMAGES,
+					Action:         "Set `build.local.push` to true, or build a single platform",
+				},
+			},
+		})
+}
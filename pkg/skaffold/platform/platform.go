@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package platform describes the platforms that images are built for, like `linux/arm64`.
+package platform
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+// Parse reads a platform formatted as `os/arch[/variant]`.
+func Parse(s string) (v1.Platform, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v1.Platform{}, fmt.Errorf("invalid platform %q: expected os/arch[/variant]", s)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return v1.Platform{}, fmt.Errorf("invalid platform %q: expected os/arch[/variant]", s)
+		}
+	}
+
+	p := v1.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// Format formats a platform as `os/arch[/variant]`.
+func Format(p v1.Platform) string {
+	if p.Variant == "" {
+		return p.OS + "/" + p.Architecture
+	}
+	return p.OS + "/" + p.Architecture + "/" + p.Variant
+}
+
+// Host returns the platform of the machine running Skaffold.
+func Host() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// Cluster returns the platform of the nodes of the current cluster.
+// It fails if the nodes don't all share the same platform.
+func Cluster(ctx context.Context) (string, error) {
+	platforms, err := nodePlatforms(ctx)
+	if err != nil {
+		return "", fmt.Errorf("listing the platforms of the cluster nodes: %w", err)
+	}
+
+	switch len(platforms) {
+	case 0:
+		return "", fmt.Errorf("no node found in the cluster")
+	case 1:
+		return platforms[0], nil
+	default:
+		return "", fmt.Errorf("the cluster nodes run on different platforms: %s", strings.Join(platforms, ", "))
+	}
+}
+
+// nodePlatforms returns the sorted, distinct platforms of the cluster nodes.
+func nodePlatforms(ctx context.Context) ([]string, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var platforms []string
+	for _, node := range nodes.Items {
+		p := node.Status.NodeInfo.OperatingSystem + "/" + node.Status.NodeInfo.Architecture
+		if !seen[p] {
+			seen[p] = true
+			platforms = append(platforms, p)
+		}
+	}
+	sort.Strings(platforms)
+	return platforms, nil
+}
+
+// NodeSelector returns the node labels that select the nodes of the given platform.
+func NodeSelector(p v1.Platform) map[string]string {
+	return map[string]string{
+		"kubernetes.io/os":   p.OS,
+		"kubernetes.io/arch": p.Architecture,
+	}
+}
+
+// TagSuffix returns a suffix, valid in an image tag, that identifies the platform.
+// For example: `linux_arm64_v8`.
+func TagSuffix(p v1.Platform) string {
+	return strings.ReplaceAll(Format(p), "/", "_")
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package platform
+
+import (
+	"context"
+	"testing"
+
+	specs "github.com/google/go-containerregistry/pkg/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		expected    specs.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "os and architecture",
+			platform:    "linux/amd64",
+			expected:    specs.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			description: "with variant",
+			platform:    "linux/arm/v7",
+			expected:    specs.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			description: "missing architecture",
+			platform:    "linux",
+			shouldErr:   true,
+		},
+		{
+			description: "empty architecture",
+			platform:    "linux/",
+			shouldErr:   true,
+		},
+		{
+			description: "too many parts",
+			platform:    "linux/arm/v7/extra",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p, err := Parse(test.platform)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, p)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.platform, Format(p))
+			}
+		})
+	}
+}
+
+func TestTagSuffix(t *testing.T) {
+	testutil.CheckDeepEqual(t, "linux_arm64_v8", TagSuffix(specs.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}))
+}
+
+func TestCluster(t *testing.T) {
+	tests := []struct {
+		description string
+		nodes       []runtime.Object
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single platform",
+			nodes:       []runtime.Object{node("node1", "linux", "arm64"), node("node2", "linux", "arm64")},
+			expected:    "linux/arm64",
+		},
+		{
+			description: "mixed platforms",
+			nodes:       []runtime.Object{node("node1", "linux", "arm64"), node("node2", "linux", "amd64")},
+			shouldErr:   true,
+		},
+		{
+			description: "no nodes",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.nodes...)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+
+			p, err := Cluster(context.Background())
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, p)
+		})
+	}
+}
+
+func node(name, os, arch string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			NodeInfo: v1.NodeSystemInfo{OperatingSystem: os, Architecture: arch},
+		},
+	}
+}
@@ -24,6 +24,8 @@ import (
 type changeSet struct {
 	needsRebuild   []*latest.Artifact
 	rebuildTracker map[string]*latest.Artifact
+	needsRetest    []*latest.Artifact
+	retestTracker  map[string]*latest.Artifact
 	needsResync    []*sync.Item
 	resyncTracker  map[string]*sync.Item
 	needsRedeploy  bool
@@ -43,6 +45,18 @@ func (c *changeSet) AddRebuild(a *latest.Artifact) {
 	c.needsRedeploy = true
 }
 
+func (c *changeSet) AddRetest(a *latest.Artifact) {
+	if _, ok := c.retestTracker[a.ImageName]; ok {
+		return
+	}
+
+	if c.retestTracker == nil {
+		c.retestTracker = map[string]*latest.Artifact{}
+	}
+	c.retestTracker[a.ImageName] = a
+	c.needsRetest = append(c.needsRetest, a)
+}
+
 func (c *changeSet) AddResync(s *sync.Item) {
 	if _, ok := c.resyncTracker[s.Image]; ok {
 		return
@@ -60,6 +74,11 @@ func (c *changeSet) resetBuild() {
 	c.needsRebuild = nil
 }
 
+func (c *changeSet) resetTest() {
+	c.retestTracker = make(map[string]*latest.Artifact)
+	c.needsRetest = nil
+}
+
 func (c *changeSet) resetSync() {
 	c.resyncTracker = make(map[string]*sync.Item)
 	c.needsResync = nil
@@ -28,8 +28,11 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 )
@@ -70,10 +73,15 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	if err != nil {
 		return err
 	}
+	// The deploy log also collects the output of the post-deploy hooks.
+	defer postDeployFn()
 
 	event.DeployInProgress()
+	if err := r.deployHooks().RunPreHooks(ctx, deployOut); err != nil {
+		event.DeployFailed(err)
+		return err
+	}
 	namespaces, err := r.deployer.Deploy(ctx, deployOut, artifacts)
-	postDeployFn()
 	if err != nil {
 		event.DeployFailed(err)
 		return err
@@ -88,8 +96,39 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 	event.DeployComplete()
 	r.runCtx.UpdateNamespaces(namespaces)
-	sErr := r.performStatusCheck(ctx, statusCheckOut)
-	return sErr
+	if err := r.performStatusCheck(ctx, statusCheckOut); err != nil {
+		if r.runCtx.RollbackOnFailure() {
+			return r.rollback(ctx, out, err)
+		}
+		return err
+	}
+
+	// Post-deploy hooks run once the deployed resources are ready, so that they can
+	// execute commands in the running containers.
+	return r.deployHooks().RunPostHooks(ctx, deployOut)
+}
+
+// rollback restores the previous version of the deployed resources after a failed status check.
+func (r *SkaffoldRunner) rollback(ctx context.Context, out io.Writer, statusCheckErr error) error {
+	color.Yellow.Fprintln(out, "Status check failed, restoring the previous version of the deployed resources")
+
+	if err := deploy.Rollback(ctx, out, r.deployer); err != nil {
+		event.DeployFailed(err)
+		return err
+	}
+
+	err := deployerr.NewRolledBackErr(statusCheckErr)
+	event.DeployRolledBack(err)
+	return err
+}
+
+// deployHooks returns the runner of the deploy lifecycle hooks.
+func (r *SkaffoldRunner) deployHooks() hooks.Runner {
+	return hooks.DeployRunner(r.runCtx, r.runCtx.Pipeline().Deploy.LifecycleHooks, hooks.DeployEnvOpts{
+		RunID:       r.labeller.GetRunID(),
+		KubeContext: r.runCtx.GetKubeContext(),
+		Namespaces:  r.runCtx.GetNamespaces(),
+	})
 }
 
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
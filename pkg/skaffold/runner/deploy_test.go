@@ -29,6 +29,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/status"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
@@ -88,6 +89,56 @@ func TestDeploy(t *testing.T) {
 	}
 }
 
+func TestDeployRollback(t *testing.T) {
+	tests := []struct {
+		description    string
+		rollback       bool
+		statusCheckErr error
+		expectedCode   int
+		rolledBack     bool
+	}{
+		{
+			description: "status check passes",
+			rollback:    true,
+		},
+		{
+			description:    "status check fails without rollback",
+			statusCheckErr: errors.New("crash loop"),
+		},
+		{
+			description:    "status check fails with rollback",
+			rollback:       true,
+			statusCheckErr: errors.New("crash loop"),
+			expectedCode:   deployerr.RolledBackExitCode,
+			rolledBack:     true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&client.Client, mockK8sClient)
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+				return dummyStatusChecker{err: test.statusCheckErr}
+			})
+
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil)
+			runner.runCtx.Opts.StatusCheck = true
+			runner.runCtx.Opts.RollbackOnFailure = test.rollback
+
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckError(test.statusCheckErr != nil, err)
+			t.CheckDeepEqual(test.rolledBack, testBench.rolledBack)
+			var rolledBackErr deployerr.RolledBackErr
+			t.CheckDeepEqual(test.rolledBack, errors.As(err, &rolledBackErr))
+			if test.rolledBack {
+				t.CheckDeepEqual(test.expectedCode, rolledBackErr.ExitCode())
+			}
+		})
+	}
+}
+
 func TestDeployNamespace(t *testing.T) {
 	tests := []struct {
 		description string
@@ -145,7 +196,7 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 			KubeContext: "does-not-exist",
 		}
 
-		deployer, err := getDeployer(runCtx, nil)
+		deployer, err := getDeployer(runCtx, nil, nil)
 		t.RequireNoError(err)
 		r := SkaffoldRunner{
 			runCtx:     runCtx,
@@ -160,8 +211,10 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 	})
 }
 
-type dummyStatusChecker struct{}
+type dummyStatusChecker struct {
+	err error
+}
 
 func (d dummyStatusChecker) Check(_ context.Context, _ io.Writer) error {
-	return nil
+	return d.err
 }
@@ -34,11 +34,13 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
-// ErrorConfigurationChanged is a special error that's returned when the skaffold configuration was changed.
+// ErrorConfigurationChanged is a special error that's returned when the skaffold configuration was changed
+// in a way that can't be applied to the running dev loop.
 var ErrorConfigurationChanged = errors.New("configuration changed")
 
 var (
@@ -50,14 +52,18 @@ var (
 
 func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kubernetes.LogAggregator, forwarderManager portforward.Forwarder) error {
 	if r.changeSet.needsReload {
-		return ErrorConfigurationChanged
+		r.changeSet.needsReload = false
+		if err := r.reloadConfig(ctx, out); err != nil {
+			return err
+		}
 	}
 
 	buildIntent, syncIntent, deployIntent := r.intents.GetIntents()
 	needsSync := syncIntent && len(r.changeSet.needsResync) > 0
 	needsBuild := buildIntent && len(r.changeSet.needsRebuild) > 0
+	needsTest := buildIntent && len(r.changeSet.needsRetest) > 0
 	needsDeploy := deployIntent && r.changeSet.needsRedeploy
-	if !needsSync && !needsBuild && !needsDeploy {
+	if !needsSync && !needsBuild && !needsTest && !needsDeploy {
 		return nil
 	}
 
@@ -81,7 +87,7 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 			color.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
 			fileSyncInProgress(fileCount, s.Image)
 
-			if err := r.syncer.Sync(ctx, s); err != nil {
+			if err := r.syncer.Sync(ctx, out, s); err != nil {
 				logrus.Warnln("Skipping deploy due to sync error:", err)
 				fileSyncFailed(fileCount, s.Image, err)
 				event.DevLoopFailedInPhase(r.devIteration, sErrors.FileSync, err)
@@ -109,6 +115,22 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		}
 	}
 
+	if needsTest {
+		defer func() {
+			r.changeSet.resetTest()
+			r.intents.resetBuild()
+		}()
+		if !meterUpdated {
+			instrumentation.AddDevIteration("test")
+			meterUpdated = true
+		}
+		if err := r.retest(ctx, out, needsBuild); err != nil {
+			logrus.Warnln("Skipping deploy due to test error:", err)
+			event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
+			return nil
+		}
+	}
+
 	if needsDeploy {
 		event.ResetStateOnDeploy()
 		defer func() {
@@ -134,12 +156,46 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 	return nil
 }
 
+// retest reruns the tests of the artifacts whose test dependencies changed.
+// Artifacts that were just rebuilt have already been tested.
+func (r *SkaffoldRunner) retest(ctx context.Context, out io.Writer, rebuilt bool) error {
+	if r.runCtx.SkipTests() {
+		return nil
+	}
+
+	tested := map[string]bool{}
+	if rebuilt {
+		for _, a := range r.changeSet.needsRebuild {
+			tested[a.ImageName] = true
+		}
+	}
+
+	var builds []build.Artifact
+	for _, a := range r.changeSet.needsRetest {
+		if tested[a.ImageName] {
+			continue
+		}
+		for _, b := range r.builds {
+			if b.ImageName == a.ImageName {
+				builds = append(builds, b)
+			}
+		}
+	}
+	if len(builds) == 0 {
+		return nil
+	}
+
+	return r.tester.Test(ctx, out, builds)
+}
+
 // Dev watches for changes and runs the skaffold build and deploy
 // config until interrupted by the user.
 func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) error {
 	event.DevLoopInProgress(r.devIteration)
 	defer func() { r.devIteration++ }()
-	g := getTransposeGraph(artifacts)
+	artifacts = r.selectDevPlatform(ctx, out, artifacts)
+	r.artifactGraph = getTransposeGraph(artifacts)
+
 	// Watch artifacts
 	start := time.Now()
 	color.Default.Fprintln(out, "Listing files to watch...")
@@ -156,40 +212,20 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		case <-ctx.Done():
 			return context.Canceled
 		default:
-			if err := r.monitor.Register(
-				func() ([]string, error) {
-					return build.DependenciesForArtifact(ctx, artifact, r.runCtx, r.artifactStore)
-				},
-				func(e filemon.Events) {
-					s, err := sync.NewItem(ctx, artifact, e, r.builds, r.runCtx, len(g[artifact.ImageName]))
-					switch {
-					case err != nil:
-						logrus.Warnf("error adding dirty artifact to changeset: %s", err.Error())
-					case s != nil:
-						r.changeSet.AddResync(s)
-					default:
-						addRebuild(g, artifact, r.changeSet.AddRebuild, r.runCtx.Opts.IsTargetImage)
-					}
-				},
-			); err != nil {
+			if err := r.watchArtifact(ctx, artifact); err != nil {
 				event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_BUILD_DEPS, err)
 				return fmt.Errorf("watching files for artifact %q: %w", artifact.ImageName, err)
 			}
+			if err := r.watchTests(artifact); err != nil {
+				event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
+				return fmt.Errorf("watching test files for artifact %q: %w", artifact.ImageName, err)
+			}
 		}
 	}
 
-	// Watch test configuration
-	if err := r.monitor.Register(
-		r.tester.TestDependencies,
-		func(filemon.Events) { r.changeSet.needsRedeploy = true },
-	); err != nil {
-		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
-		return fmt.Errorf("watching test files: %w", err)
-	}
-
-	// Watch deployment configuration
+	// Watch deployment configuration. The deployer can be replaced when the configuration is reloaded.
 	if err := r.monitor.Register(
-		r.deployer.Dependencies,
+		func() ([]string, error) { return r.deployer.Dependencies() },
 		func(filemon.Events) { r.changeSet.needsRedeploy = true },
 	); err != nil {
 		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_DEPLOY_DEPS, err)
@@ -198,7 +234,7 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 
 	// Watch Skaffold configuration
 	if err := r.monitor.Register(
-		func() ([]string, error) { return []string{r.runCtx.ConfigurationFile()}, nil },
+		func() ([]string, error) { return r.runCtx.ConfigurationFiles(), nil },
 		func(filemon.Events) { r.changeSet.needsReload = true },
 	); err != nil {
 		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_CONFIG_DEP, err)
@@ -244,6 +280,11 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	if err := debugContainerManager.Start(ctx); err != nil {
 		logrus.Warnln("Error starting debug container notification:", err)
 	}
+	if debugContainerManager != nil {
+		server.SetAttachDebuggerCallback(func(request *proto.AttachDebuggerRequest) (*proto.AttachDebuggerResponse, error) {
+			return debugContainerManager.Attach(ctx, forwarderManager, request)
+		})
+	}
 	// Start printing the logs after deploy is finished
 	if err := logger.Start(ctx); err != nil {
 		return fmt.Errorf("starting logger: %w", err)
@@ -257,6 +298,60 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	})
 }
 
+// watchArtifact registers the dependencies of an artifact with the file monitor.
+// The watch stays active for as long as this definition of the artifact is the
+// current one: a reloaded configuration registers a new watch for modified artifacts.
+func (r *SkaffoldRunner) watchArtifact(ctx context.Context, artifact *latest.Artifact) error {
+	if r.devArtifacts == nil {
+		r.devArtifacts = map[string]*latest.Artifact{}
+	}
+	r.devArtifacts[artifact.ImageName] = artifact
+	isCurrent := func() bool { return r.devArtifacts[artifact.ImageName] == artifact }
+
+	return r.monitor.Register(
+		func() ([]string, error) {
+			if !isCurrent() {
+				return nil, nil
+			}
+			return build.DependenciesForArtifact(ctx, artifact, r.runCtx, r.artifactStore)
+		},
+		func(e filemon.Events) {
+			if !isCurrent() {
+				return
+			}
+			s, err := sync.NewItem(ctx, artifact, e, r.builds, r.runCtx, len(r.artifactGraph[artifact.ImageName]))
+			switch {
+			case err != nil:
+				logrus.Warnf("error adding dirty artifact to changeset: %s", err.Error())
+			case s != nil:
+				r.changeSet.AddResync(s)
+			default:
+				addRebuild(r.artifactGraph, artifact, r.changeSet.AddRebuild, r.runCtx.Opts.IsTargetImage)
+			}
+		},
+	)
+}
+
+// watchTests registers the dependencies of the tests of an artifact with the file monitor.
+// Changes to these files only rerun the tests, without rebuilding the artifact.
+func (r *SkaffoldRunner) watchTests(artifact *latest.Artifact) error {
+	isCurrent := func() bool { return r.devArtifacts[artifact.ImageName] == artifact }
+
+	return r.monitor.Register(
+		func() ([]string, error) {
+			if !isCurrent() {
+				return nil, nil
+			}
+			return r.tester.TestDependencies(artifact)
+		},
+		func(filemon.Events) {
+			if isCurrent() {
+				r.changeSet.AddRetest(artifact)
+			}
+		},
+	)
+}
+
 // graph represents the artifact graph
 type graph map[string][]*latest.Artifact
 
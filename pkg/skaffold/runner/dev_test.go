@@ -76,10 +76,12 @@ func (t *TestMonitor) Run(bool) error {
 		switch file {
 		case "file1":
 			t.callbacks[0](evt) // 1st artifact changed
+		case "test1.yaml":
+			t.callbacks[1](evt) // 1st artifact's tests changed
 		case "file2":
-			t.callbacks[1](evt) // 2nd artifact changed
+			t.callbacks[2](evt) // 2nd artifact changed
 		case "manifest.yaml":
-			t.callbacks[3](evt) // deployment configuration changed
+			t.callbacks[4](evt) // deployment configuration changed
 		}
 	}
 
@@ -246,6 +248,42 @@ func TestDev(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "only change tests of first artifact",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test1.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Tested: []string{"img1:1"},
+				},
+			},
+		},
+		{
+			description: "change first artifact and its tests",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"file1", "test1.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Built:    []string{"img1:2"},
+					Tested:   []string{"img1:2"},
+					Deployed: []string{"img1:2", "img2:1"},
+				},
+			},
+		},
 		{
 			description: "redeploy",
 			testBench:   &TestBench{},
@@ -20,22 +20,32 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/docker/distribution/reference"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/podman"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
+// for testing
+var tempDir = ioutil.TempDir
+
 // loadImagesInKindNodes loads artifact images into every node of a kind cluster.
 func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, kindCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into kind cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
+	return r.loadImages(ctx, out, artifacts, func(tag, archive string) *exec.Cmd {
+		if archive != "" {
+			return exec.CommandContext(ctx, "kind", "load", "image-archive", "--name", kindCluster, archive)
+		}
 		return exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, tag)
 	})
 }
@@ -43,12 +53,17 @@ func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Write
 // loadImagesInK3dNodes loads artifact images into every node of a k3s cluster.
 func (r *SkaffoldRunner) loadImagesInK3dNodes(ctx context.Context, out io.Writer, k3dCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into k3d cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
+	return r.loadImages(ctx, out, artifacts, func(tag, archive string) *exec.Cmd {
+		if archive != "" {
+			tag = archive
+		}
 		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, tag)
 	})
 }
 
-func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag string) *exec.Cmd) error {
+// loadImages loads images that are unknown to the cluster's nodes. Images built with podman or buildah
+// are not in the Docker daemon, so createCmd is given a tarball of those images to load instead.
+func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag, archive string) *exec.Cmd) error {
 	start := time.Now()
 
 	var knownImages []string
@@ -77,10 +92,9 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 			continue
 		}
 
-		cmd := createCmd(artifact.Tag)
-		if output, err := util.RunCmdOut(cmd); err != nil {
+		if err := r.loadImage(ctx, artifact, createCmd); err != nil {
 			color.Red.Fprintln(out, "Failed")
-			return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
+			return err
 		}
 
 		color.Green.Fprintln(out, "Loaded")
@@ -90,6 +104,39 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 	return nil
 }
 
+func (r *SkaffoldRunner) loadImage(ctx context.Context, artifact build.Artifact, createCmd func(tag, archive string) *exec.Cmd) error {
+	var archive string
+	if tool := r.daemonlessTool(artifact.ImageName); tool != "" {
+		dir, err := tempDir("", "skaffold-images")
+		if err != nil {
+			return fmt.Errorf("creating temporary directory: %w", err)
+		}
+		defer os.RemoveAll(dir)
+
+		archive = filepath.Join(dir, "image.tar")
+		if err := podman.Save(ctx, tool, artifact.Tag, archive); err != nil {
+			return fmt.Errorf("unable to save image %q: %w", artifact.Tag, err)
+		}
+	}
+
+	cmd := createCmd(artifact.Tag, archive)
+	if output, err := util.RunCmdOut(cmd); err != nil {
+		return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
+	}
+	return nil
+}
+
+// daemonlessTool returns `podman` or `buildah` if the image is built without a Docker daemon.
+func (r *SkaffoldRunner) daemonlessTool(imageName string) string {
+	buildConfig := r.runCtx.Pipeline().Build
+	for _, a := range buildConfig.Artifacts {
+		if a.ImageName == imageName {
+			return podman.Tool(buildConfig, a)
+		}
+	}
+	return ""
+}
+
 func findKnownImages(ctx context.Context, cli *kubectl.CLI) ([]string, error) {
 	nodeGetOut, err := cli.RunOut(ctx, "get", "nodes", `-ojsonpath='{@.items[*].status.images[*].names[*]}'`)
 	if err != nil {
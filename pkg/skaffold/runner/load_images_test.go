@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -36,6 +37,7 @@ type ImageLoadingTest = struct {
 	built         []build.Artifact
 	deployed      []build.Artifact
 	commands      util.Command
+	backend       string
 	shouldErr     bool
 	expectedError string
 }
@@ -51,6 +53,17 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
 				AndRunOut("kind load docker-image --name kind tag1", "output: image loaded"),
 		},
+		{
+			description: "load podman image",
+			cluster:     "kind",
+			backend:     "podman",
+			built:       []build.Artifact{{ImageName: "image", Tag: "tag1"}},
+			deployed:    []build.Artifact{{ImageName: "image", Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut("podman save --output images/image.tar tag1", "").
+				AndRunOut("kind load image-archive --name kind images/image.tar", "output: image loaded"),
+		},
 		{
 			description: "load missing image",
 			cluster:     "other-kind",
@@ -124,6 +137,17 @@ func TestLoadImagesInK3dNodes(t *testing.T) {
 				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
 				AndRunOut("k3d image import --cluster k3d tag1", "output: image loaded"),
 		},
+		{
+			description: "load buildah image",
+			cluster:     "k3d",
+			backend:     "buildah",
+			built:       []build.Artifact{{ImageName: "image", Tag: "tag1"}},
+			deployed:    []build.Artifact{{ImageName: "image", Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut("buildah save --output images/image.tar tag1", "").
+				AndRunOut("k3d image import --cluster k3d images/image.tar", "output: image loaded"),
+		},
 		{
 			description: "load missing image",
 			cluster:     "other-k3d",
@@ -190,11 +214,17 @@ func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc fu
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
+			t.NewTempDir().Chdir()
+			t.Override(&tempDir, func(string, string) (string, error) { return "images", nil })
 
 			runCtx := &runcontext.RunContext{
 				Opts: config.SkaffoldOptions{
 					Namespace: "namespace",
 				},
+				Cfg: latest.Pipeline{Build: latest.BuildConfig{
+					Artifacts: []*latest.Artifact{{ImageName: "image", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}},
+					BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Backend: test.backend}},
+				}},
 				KubeContext: "kubecontext",
 			}
 
@@ -19,6 +19,7 @@ package runner
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/sirupsen/logrus"
 
@@ -52,11 +53,6 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	event.LogMetaEvent()
 	kubectlCLI := pkgkubectl.NewCLI(runCtx, "")
 
-	tagger, err := getTagger(runCtx)
-	if err != nil {
-		return nil, fmt.Errorf("creating tagger: %w", err)
-	}
-
 	store := build.NewArtifactStore()
 	builder, imagesAreLocal, err := getBuilder(runCtx, store)
 	if err != nil {
@@ -70,28 +66,18 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 
 	labeller := label.NewLabeller(runCtx.AddSkaffoldLabels(), runCtx.CustomLabels())
 	tester := getTester(runCtx, imagesAreLocal)
+	tagger, err := getTagger(runCtx, newInputDigester(runCtx, newDependencyLister(runCtx, tester, store)))
+	if err != nil {
+		return nil, fmt.Errorf("creating tagger: %w", err)
+	}
+
 	syncer := getSyncer(runCtx)
 	var deployer deploy.Deployer
-	deployer, err = getDeployer(runCtx, labeller.Labels())
+	deployer, err = getDeployer(runCtx, labeller.Labels(), strategyStatusCheck(runCtx, labeller))
 	if err != nil {
 		return nil, fmt.Errorf("creating deployer: %w", err)
 	}
-	depLister := func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
-		buildDependencies, err := build.DependenciesForArtifact(ctx, artifact, runCtx, store)
-		if err != nil {
-			return nil, err
-		}
-
-		testDependencies, err := tester.TestDependencies()
-		if err != nil {
-			return nil, err
-		}
-
-		return append(buildDependencies, testDependencies...), nil
-	}
-
-	graph := build.ToArtifactGraph(runCtx.Pipeline().Build.Artifacts)
-	artifactCache, err := cache.NewCache(runCtx, imagesAreLocal, tryImportMissing, depLister, graph, store)
+	artifactCache, err := newArtifactCache(runCtx, imagesAreLocal, tryImportMissing, tester, store)
 	if err != nil {
 		return nil, fmt.Errorf("initializing cache: %w", err)
 	}
@@ -120,17 +106,55 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 			Trigger:    trigger,
 			intentChan: intentChan,
 		},
-		artifactStore:  store,
-		kubectlCLI:     kubectlCLI,
-		labeller:       labeller,
-		podSelector:    kubernetes.NewImageList(),
-		cache:          artifactCache,
-		runCtx:         runCtx,
-		intents:        intents,
-		imagesAreLocal: imagesAreLocal,
+		artifactStore:    store,
+		kubectlCLI:       kubectlCLI,
+		labeller:         labeller,
+		podSelector:      kubernetes.NewImageList(),
+		cache:            artifactCache,
+		runCtx:           runCtx,
+		intents:          intents,
+		imagesAreLocal:   imagesAreLocal,
+		tryImportMissing: tryImportMissing,
 	}, nil
 }
 
+// WithConfigLoader sets the function used to reload the configuration when it changes during dev.
+func (r *SkaffoldRunner) WithConfigLoader(l ConfigLoader) *SkaffoldRunner {
+	r.configLoader = l
+	return r
+}
+
+func newArtifactCache(runCtx *runcontext.RunContext, imagesAreLocal, tryImportMissing bool, tester test.Tester, store build.ArtifactStore) (cache.Cache, error) {
+	graph := build.ToArtifactGraph(runCtx.Pipeline().Build.Artifacts)
+	return cache.NewCache(runCtx, imagesAreLocal, tryImportMissing, newDependencyLister(runCtx, tester, store), graph, store)
+}
+
+// newDependencyLister returns a lister of the files that an artifact is built and tested from.
+func newDependencyLister(runCtx *runcontext.RunContext, tester test.Tester, store build.ArtifactStore) cache.DependencyLister {
+	return func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
+		buildDependencies, err := build.DependenciesForArtifact(ctx, artifact, runCtx, store)
+		if err != nil {
+			return nil, err
+		}
+
+		testDependencies, err := tester.TestDependencies(artifact)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(buildDependencies, testDependencies...), nil
+	}
+}
+
+// newInputDigester returns a digester that computes the same digest of an artifact's inputs as the cache.
+// The artifact graph is read from the pipeline on every call since the configuration can be reloaded during dev.
+func newInputDigester(runCtx *runcontext.RunContext, lister cache.DependencyLister) tag.InputDigester {
+	return func(ctx context.Context, a *latest.Artifact) (string, error) {
+		graph := build.ToArtifactGraph(runCtx.Pipeline().Build.Artifacts)
+		return cache.NewInputDigester(graph, lister, runCtx.Mode())(ctx, a)
+	}
+}
+
 func setupIntents(runCtx *runcontext.RunContext) (*intents, chan bool) {
 	intents := newIntents(runCtx.AutoBuild(), runCtx.AutoSync(), runCtx.AutoDeploy())
 
@@ -209,7 +233,7 @@ func getSyncer(cfg sync.Config) sync.Syncer {
 	return sync.NewSyncer(cfg)
 }
 
-func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer, error) {
+func getDeployer(cfg kubectl.Config, labels map[string]string, statusCheck kubectl.StatusCheck) (deploy.Deployer, error) {
 	d := cfg.Pipeline().Deploy
 
 	var deployers deploy.DeployerMux
@@ -231,6 +255,7 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 		if err != nil {
 			return nil, err
 		}
+		deployer.SetStatusCheck(statusCheck)
 		deployers = append(deployers, deployer)
 	}
 
@@ -239,6 +264,7 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 		if err != nil {
 			return nil, err
 		}
+		deployer.SetStatusCheck(statusCheck)
 		deployers = append(deployers, deployer)
 	}
 
@@ -250,7 +276,20 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 	return deployers, nil
 }
 
-func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
+// strategyStatusCheck returns the status check that gates the rollout of the deploy strategies.
+func strategyStatusCheck(runCtx *runcontext.RunContext, labeller *label.DefaultLabeller) kubectl.StatusCheck {
+	return func(ctx context.Context, out io.Writer, namespaces []string) error {
+		if !runCtx.StatusCheck() {
+			logrus.Warnln("Status check is disabled, the deploy strategy won't wait for the new workloads to stabilize")
+			return nil
+		}
+
+		runCtx.UpdateNamespaces(namespaces)
+		return newStatusCheck(runCtx, labeller).Check(ctx, out)
+	}
+}
+
+func getTagger(runCtx *runcontext.RunContext, digester tag.InputDigester) (tag.Tagger, error) {
 	t := runCtx.Pipeline().Build.TagPolicy
 
 	switch {
@@ -271,8 +310,11 @@ func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 	case t.DateTimeTagger != nil:
 		return tag.NewDateTimeTagger(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone), nil
 
+	case t.InputDigest != nil:
+		return tag.NewInputDigestTagger(digester), nil
+
 	case t.CustomTemplateTagger != nil:
-		components, err := CreateComponents(t.CustomTemplateTagger)
+		components, err := CreateComponents(t.CustomTemplateTagger, digester)
 
 		if err != nil {
 			return nil, fmt.Errorf("creating components: %w", err)
@@ -286,7 +328,7 @@ func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 }
 
 // CreateComponents creates a map of taggers for CustomTemplateTagger
-func CreateComponents(t *latest.CustomTemplateTagger) (map[string]tag.Tagger, error) {
+func CreateComponents(t *latest.CustomTemplateTagger, digester tag.InputDigester) (map[string]tag.Tagger, error) {
 	components := map[string]tag.Tagger{}
 
 	for _, taggerComponent := range t.Components {
@@ -309,6 +351,9 @@ func CreateComponents(t *latest.CustomTemplateTagger) (map[string]tag.Tagger, er
 		case c.DateTimeTagger != nil:
 			components[name] = tag.NewDateTimeTagger(c.DateTimeTagger.Format, c.DateTimeTagger.TimeZone)
 
+		case c.InputDigest != nil:
+			components[name] = tag.NewInputDigestTagger(digester)
+
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
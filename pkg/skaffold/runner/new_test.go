@@ -120,7 +120,7 @@ func TestGetDeployer(tOuter *testing.T) {
 							DeployType: test.cfg,
 						},
 					},
-				}, nil)
+				}, nil, nil)
 
 				t.CheckError(test.shouldErr, err)
 				t.CheckTypeEquality(test.expected, deployer)
@@ -156,6 +156,7 @@ func TestCreateComponents(t *testing.T) {
 					{Name: "FOE", Component: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
 					{Name: "BAR", Component: latest.TagPolicy{EnvTemplateTagger: &latest.EnvTemplateTagger{Template: "test"}}},
 					{Name: "BAT", Component: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{}}},
+					{Name: "BAZ", Component: latest.TagPolicy{InputDigest: &latest.InputDigest{}}},
 				},
 			},
 			expected: map[string]tag.Tagger{
@@ -163,6 +164,7 @@ func TestCreateComponents(t *testing.T) {
 				"FOE": &tag.ChecksumTagger{},
 				"BAR": envExample,
 				"BAT": tag.NewDateTimeTagger("", ""),
+				"BAZ": tag.NewInputDigestTagger(nil),
 			},
 		},
 		{
@@ -196,7 +198,7 @@ func TestCreateComponents(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			components, err := CreateComponents(test.customTemplateTagger)
+			components, err := CreateComponents(test.customTemplateTagger, nil)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, len(test.expected), len(components))
 			for k, v := range test.expected {
 				t.CheckTypeEquality(v, components[k])
@@ -48,3 +48,7 @@ func (w withNotification) Deploy(ctx context.Context, out io.Writer, builds []bu
 	}
 	return ns, err
 }
+
+func (w withNotification) Rollback(ctx context.Context, out io.Writer) error {
+	return deploy.Rollback(ctx, out, w.Deployer)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	clusterPlatform = platform.Cluster
+	hostPlatform    = platform.Host
+)
+
+// selectDevPlatform finds the platform of the cluster nodes, so that the dev loop
+// only builds images that can run on the cluster.
+func (r *SkaffoldRunner) selectDevPlatform(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) []*latest.Artifact {
+	p, err := clusterPlatform(ctx)
+	if err != nil {
+		logrus.Debugln("Building for the configured platforms:", err)
+		return artifacts
+	}
+
+	r.devPlatform = p
+	return r.useDevPlatform(out, artifacts)
+}
+
+// useDevPlatform returns the artifacts to build for the platform of the cluster nodes:
+// artifacts that target several platforms are only built for the cluster's one,
+// and images built locally target the cluster when it doesn't run on the same platform as Skaffold.
+// The artifacts of the configuration are left untouched: modified artifacts are copies.
+func (r *SkaffoldRunner) useDevPlatform(out io.Writer, artifacts []*latest.Artifact) []*latest.Artifact {
+	if r.devPlatform == "" {
+		return artifacts
+	}
+
+	localBuild := r.runCtx.Pipeline().Build.LocalBuild != nil
+	var devArtifacts []*latest.Artifact
+	for _, a := range artifacts {
+		switch {
+		case len(a.Platforms) > 1 && containsPlatform(a.Platforms, r.devPlatform):
+		case len(a.Platforms) == 0 && localBuild && (a.DockerArtifact != nil || a.JibArtifact != nil || a.KoArtifact != nil) && r.devPlatform != hostPlatform():
+		default:
+			devArtifacts = append(devArtifacts, a)
+			continue
+		}
+
+		copied := *a
+		copied.Platforms = []string{r.devPlatform}
+		devArtifacts = append(devArtifacts, &copied)
+		color.Default.Fprintf(out, "Building %s for the cluster platform %s\n", a.ImageName, r.devPlatform)
+	}
+	return devArtifacts
+}
+
+func containsPlatform(platforms []string, p string) bool {
+	for _, candidate := range platforms {
+		if candidate == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSelectDevPlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		cluster     string
+		clusterErr  error
+		remoteBuild bool
+		artifact    *latest.Artifact
+		expected    []string
+	}{
+		{
+			description: "local build for a cluster on another platform",
+			cluster:     "linux/amd64",
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "local build for a cluster on the same platform",
+			cluster:     "linux/arm64",
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}},
+		},
+		{
+			description: "ko build for a cluster on another platform",
+			cluster:     "linux/amd64",
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}}},
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "builder without platform support",
+			cluster:     "linux/amd64",
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}}},
+		},
+		{
+			description: "in-cluster build",
+			cluster:     "linux/amd64",
+			remoteBuild: true,
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}}},
+		},
+		{
+			description: "several platforms",
+			cluster:     "linux/amd64",
+			artifact:    &latest.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "cluster platform not listed",
+			cluster:     "linux/s390x",
+			artifact:    &latest.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+		{
+			description: "unknown cluster platform",
+			clusterErr:  errors.New("nodes run on different platforms"),
+			artifact:    &latest.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&clusterPlatform, func(context.Context) (string, error) { return test.cluster, test.clusterErr })
+			t.Override(&hostPlatform, func() string { return "linux/arm64" })
+
+			build := latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}
+			if test.remoteBuild {
+				build = latest.BuildConfig{BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}}}
+			}
+			r := &SkaffoldRunner{runCtx: &runcontext.RunContext{Cfg: latest.Pipeline{Build: build}}}
+
+			platforms := test.artifact.Platforms
+			artifacts := r.selectDevPlatform(context.Background(), ioutil.Discard, []*latest.Artifact{test.artifact})
+
+			t.CheckDeepEqual(1, len(artifacts))
+			t.CheckDeepEqual(test.expected, artifacts[0].Platforms)
+			// The artifact of the configuration is left untouched.
+			t.CheckDeepEqual(platforms, test.artifact.Platforms)
+		})
+	}
+}
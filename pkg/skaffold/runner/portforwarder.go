@@ -19,11 +19,13 @@ package runner
 import (
 	"io"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 )
 
 func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderManager {
-	if !r.runCtx.PortForward() {
+	// in debug mode, debugging ports are forwarded on demand when attaching a debugger
+	if !r.runCtx.PortForward() && r.runCtx.Mode() != config.RunModes.Debug {
 		return nil
 	}
 
@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
+)
+
+// ConfigLoader reads the skaffold configuration again and returns the resulting RunContext.
+type ConfigLoader func() (*runcontext.RunContext, error)
+
+// for testing
+var newDeployer = getDeployer
+
+// configDiff lists the changes between two pipelines.
+type configDiff struct {
+	// added are the artifacts that are new in the configuration.
+	added []*latest.Artifact
+	// changed are the artifacts whose definition changed and need to be rebuilt.
+	changed []*latest.Artifact
+	// syncChanged are the artifacts where only the sync rules changed.
+	syncChanged []*latest.Artifact
+	// removed are the names of the artifacts that are not part of the configuration anymore.
+	removed []string
+	// redeploy is true when the deploy configuration changed.
+	redeploy bool
+	// restart is true when some changes can't be applied to the running dev loop.
+	restart bool
+}
+
+func (d configDiff) artifactsChanged() bool {
+	return len(d.added) > 0 || len(d.changed) > 0 || len(d.syncChanged) > 0 || len(d.removed) > 0
+}
+
+// diffPipelines computes the differences between the current pipeline and the reloaded one.
+func diffPipelines(current, reloaded latest.Pipeline) configDiff {
+	var diff configDiff
+
+	// Builder, tag policy and test configuration are baked into the builder, the tagger
+	// and the tester when they are created. Changing them requires a new runner.
+	currentBuild, reloadedBuild := current.Build, reloaded.Build
+	currentBuild.Artifacts, reloadedBuild.Artifacts = nil, nil
+	if !reflect.DeepEqual(currentBuild, reloadedBuild) ||
+		!reflect.DeepEqual(current.Test, reloaded.Test) ||
+		!reflect.DeepEqual(current.PortForward, reloaded.PortForward) ||
+		current.Deploy.KubeContext != reloaded.Deploy.KubeContext {
+		diff.restart = true
+		return diff
+	}
+
+	previous := map[string]*latest.Artifact{}
+	for _, a := range current.Build.Artifacts {
+		previous[a.ImageName] = a
+	}
+
+	for _, a := range reloaded.Build.Artifacts {
+		old, found := previous[a.ImageName]
+		delete(previous, a.ImageName)
+
+		switch {
+		case !found:
+			diff.added = append(diff.added, a)
+		case reflect.DeepEqual(old, a):
+			continue
+		case reflect.DeepEqual(withoutSync(old), withoutSync(a)):
+			diff.syncChanged = append(diff.syncChanged, a)
+		default:
+			diff.changed = append(diff.changed, a)
+		}
+	}
+
+	for _, a := range current.Build.Artifacts {
+		if _, removed := previous[a.ImageName]; removed {
+			diff.removed = append(diff.removed, a.ImageName)
+		}
+	}
+
+	diff.redeploy = !reflect.DeepEqual(current.Deploy, reloaded.Deploy)
+	return diff
+}
+
+func withoutSync(a *latest.Artifact) latest.Artifact {
+	copied := *a
+	copied.Sync = nil
+	return copied
+}
+
+// reloadConfig reads the skaffold configuration again and applies the changes to the
+// running dev loop: new and modified artifacts are watched again and rebuilt, and the
+// deployer is recreated when the deploy configuration changed.
+// ErrorConfigurationChanged is returned when the changes can't be applied in place.
+func (r *SkaffoldRunner) reloadConfig(ctx context.Context, out io.Writer) error {
+	if r.configLoader == nil {
+		return ErrorConfigurationChanged
+	}
+
+	color.Default.Fprintln(out, "Configuration changed, reloading...")
+	runCtx, err := r.configLoader()
+	if err != nil {
+		logrus.Warnln("Keeping previous configuration:", err)
+		return nil
+	}
+
+	diff := diffPipelines(r.runCtx.Pipeline(), runCtx.Pipeline())
+	if diff.restart || runCtx.GetKubeContext() != r.runCtx.GetKubeContext() {
+		logrus.Infoln("Configuration changes can't be applied in place, restarting")
+		return ErrorConfigurationChanged
+	}
+
+	// The builder, tester and syncer share this RunContext: update it in place.
+	r.runCtx.Cfg = runCtx.Pipeline()
+	r.runCtx.RequiredConfigFiles = runCtx.RequiredConfigFiles
+	r.runCtx.UpdateNamespaces(runCtx.GetNamespaces())
+	event.ResetStateOnConfigReload(r.runCtx.Pipeline(), r.runCtx.GetKubeContext())
+
+	if diff.artifactsChanged() {
+		if err := r.reloadArtifacts(ctx, out, diff); err != nil {
+			return err
+		}
+	}
+
+	if diff.redeploy {
+		deployer, err := newDeployer(r.runCtx, r.labeller.Labels(), strategyStatusCheck(r.runCtx, r.labeller))
+		if err != nil {
+			return fmt.Errorf("creating deployer: %w", err)
+		}
+		_, _, deployer = WithTimings(r.builder, r.tester, deployer, r.runCtx.CacheArtifacts())
+		if r.runCtx.Notification() {
+			deployer = WithNotification(deployer)
+		}
+		r.deployer = deployer
+		r.changeSet.needsRedeploy = true
+	}
+
+	return nil
+}
+
+func (r *SkaffoldRunner) reloadArtifacts(ctx context.Context, out io.Writer, diff configDiff) error {
+	artifacts := r.useDevPlatform(ioutil.Discard, r.runCtx.Pipeline().Build.Artifacts)
+	r.artifactGraph = getTransposeGraph(artifacts)
+	current := map[string]*latest.Artifact{}
+	for _, a := range artifacts {
+		current[a.ImageName] = a
+	}
+
+	artifactCache, err := newArtifactCache(r.runCtx, r.imagesAreLocal, r.tryImportMissing, r.tester, r.artifactStore)
+	if err != nil {
+		return fmt.Errorf("initializing cache: %w", err)
+	}
+	r.cache = artifactCache
+
+	for _, name := range diff.removed {
+		delete(r.devArtifacts, name)
+		r.builds = removeBuild(r.builds, name)
+	}
+
+	// The artifacts of the diff come from the configuration, before the dev platform is applied.
+	var toRebuild []*latest.Artifact
+	for _, a := range diff.added {
+		toRebuild = append(toRebuild, current[a.ImageName])
+	}
+	for _, a := range diff.changed {
+		toRebuild = append(toRebuild, current[a.ImageName])
+	}
+
+	toWatch := append([]*latest.Artifact{}, toRebuild...)
+	for _, a := range diff.syncChanged {
+		toWatch = append(toWatch, current[a.ImageName])
+	}
+	if err := sync.Init(ctx, toWatch); err != nil {
+		return fmt.Errorf("initializing sync state: %w", err)
+	}
+
+	for _, a := range toWatch {
+		if !r.runCtx.Opts.IsTargetImage(a) {
+			continue
+		}
+		color.Default.Fprintf(out, " - %s\n", a.ImageName)
+		if err := r.watchArtifact(ctx, a); err != nil {
+			return fmt.Errorf("watching files for artifact %q: %w", a.ImageName, err)
+		}
+		if err := r.watchTests(a); err != nil {
+			return fmt.Errorf("watching test files for artifact %q: %w", a.ImageName, err)
+		}
+	}
+
+	// Pending rebuilds might point to outdated artifact definitions.
+	pending := r.changeSet.needsRebuild
+	r.changeSet.resetBuild()
+	for _, a := range pending {
+		if updated, found := current[a.ImageName]; found {
+			r.changeSet.AddRebuild(updated)
+		}
+	}
+
+	for _, a := range toRebuild {
+		addRebuild(r.artifactGraph, a, r.changeSet.AddRebuild, r.runCtx.Opts.IsTargetImage)
+	}
+	if len(diff.removed) > 0 {
+		r.changeSet.needsRedeploy = true
+	}
+
+	return nil
+}
+
+func removeBuild(builds []build.Artifact, imageName string) []build.Artifact {
+	var kept []build.Artifact
+	for _, b := range builds {
+		if b.ImageName != imageName {
+			kept = append(kept, b)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDiffPipelines(t *testing.T) {
+	img1 := &latest.Artifact{ImageName: "img1", Workspace: "img1"}
+	img2 := &latest.Artifact{ImageName: "img2", Workspace: "img2"}
+	img2Moved := &latest.Artifact{ImageName: "img2", Workspace: "other"}
+	img2Sync := &latest.Artifact{ImageName: "img2", Workspace: "img2", Sync: &latest.Sync{Infer: []string{"**/*"}}}
+	img3 := &latest.Artifact{ImageName: "img3"}
+
+	tests := []struct {
+		description string
+		current     latest.Pipeline
+		reloaded    latest.Pipeline
+		expected    configDiff
+	}{
+		{
+			description: "no changes",
+			current:     pipelineWith(img1, img2),
+			reloaded:    pipelineWith(img1, img2),
+		},
+		{
+			description: "added artifact",
+			current:     pipelineWith(img1),
+			reloaded:    pipelineWith(img1, img3),
+			expected:    configDiff{added: []*latest.Artifact{img3}},
+		},
+		{
+			description: "removed artifact",
+			current:     pipelineWith(img1, img2),
+			reloaded:    pipelineWith(img1),
+			expected:    configDiff{removed: []string{"img2"}},
+		},
+		{
+			description: "changed artifact",
+			current:     pipelineWith(img1, img2),
+			reloaded:    pipelineWith(img1, img2Moved),
+			expected:    configDiff{changed: []*latest.Artifact{img2Moved}},
+		},
+		{
+			description: "changed sync rules",
+			current:     pipelineWith(img1, img2),
+			reloaded:    pipelineWith(img1, img2Sync),
+			expected:    configDiff{syncChanged: []*latest.Artifact{img2Sync}},
+		},
+		{
+			description: "changed deploy config",
+			current:     pipelineWith(img1),
+			reloaded: latest.Pipeline{
+				Build:  latest.BuildConfig{Artifacts: []*latest.Artifact{img1}},
+				Deploy: latest.DeployConfig{DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"k8s/*.yaml"}}}},
+			},
+			expected: configDiff{redeploy: true},
+		},
+		{
+			description: "changed tag policy",
+			current:     pipelineWith(img1),
+			reloaded: latest.Pipeline{
+				Build: latest.BuildConfig{
+					Artifacts: []*latest.Artifact{img1},
+					TagPolicy: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}},
+				},
+			},
+			expected: configDiff{restart: true},
+		},
+		{
+			description: "changed kube-context",
+			current:     pipelineWith(img1),
+			reloaded: latest.Pipeline{
+				Build:  latest.BuildConfig{Artifacts: []*latest.Artifact{img1}},
+				Deploy: latest.DeployConfig{KubeContext: "other"},
+			},
+			expected: configDiff{restart: true},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			diff := diffPipelines(test.current, test.reloaded)
+
+			t.CheckDeepEqual(test.expected, diff, cmp.AllowUnexported(configDiff{}))
+		})
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	img1 := &latest.Artifact{ImageName: "img1", Workspace: "img1"}
+	img2 := &latest.Artifact{ImageName: "img2", Workspace: "img2"}
+	img2Moved := &latest.Artifact{ImageName: "img2", Workspace: "other"}
+	img3 := &latest.Artifact{ImageName: "img3", Dependencies: []*latest.ArtifactDependency{{ImageName: "img2"}}}
+
+	tests := []struct {
+		description      string
+		artifacts        []*latest.Artifact
+		changeBuilder    bool
+		loadErr          error
+		shouldErr        bool
+		expectedRebuild  []string
+		expectedRedeploy bool
+	}{
+		{
+			description: "invalid configuration is ignored",
+			loadErr:     errors.New("invalid"),
+		},
+		{
+			description:   "builder change requires restart",
+			artifacts:     []*latest.Artifact{img1, img2},
+			changeBuilder: true,
+			shouldErr:     true,
+		},
+		{
+			description:      "changed artifact is rebuilt with its dependents",
+			artifacts:        []*latest.Artifact{img1, img2Moved, img3},
+			expectedRebuild:  []string{"img3", "img2"},
+			expectedRedeploy: true,
+		},
+		{
+			description:      "removed artifact is redeployed",
+			artifacts:        []*latest.Artifact{img1},
+			expectedRedeploy: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&newDeployer, func(kubectl.Config, map[string]string, kubectl.StatusCheck) (deploy.Deployer, error) {
+				return &TestBench{}, nil
+			})
+
+			r := createRunner(t, &TestBench{}, &NoopMonitor{})
+			r.runCtx.Cfg.Build.Artifacts = []*latest.Artifact{img1, img2}
+			r.artifactGraph = getTransposeGraph(r.runCtx.Cfg.Build.Artifacts)
+			r.WithConfigLoader(func() (*runcontext.RunContext, error) {
+				if test.loadErr != nil {
+					return nil, test.loadErr
+				}
+				reloaded := r.runCtx.Cfg
+				reloaded.Build.Artifacts = test.artifacts
+				if test.changeBuilder {
+					reloaded.Build.BuildType = latest.BuildType{Cluster: &latest.ClusterDetails{}}
+				}
+				return &runcontext.RunContext{Cfg: reloaded}, nil
+			})
+
+			err := r.reloadConfig(context.Background(), ioutil.Discard)
+
+			t.CheckError(test.shouldErr, err)
+			var rebuild []string
+			for _, a := range r.changeSet.needsRebuild {
+				rebuild = append(rebuild, a.ImageName)
+			}
+			t.CheckDeepEqual(test.expectedRebuild, rebuild)
+			t.CheckDeepEqual(test.expectedRedeploy, r.changeSet.needsRedeploy)
+		})
+	}
+}
+
+func pipelineWith(artifacts ...*latest.Artifact) latest.Pipeline {
+	return latest.Pipeline{Build: latest.BuildConfig{Artifacts: artifacts}}
+}
@@ -42,6 +42,9 @@ type RunContext struct {
 	WorkingDir         string
 	InsecureRegistries map[string]bool
 	Cluster            config.Cluster
+
+	// RequiredConfigFiles lists the configuration files required by the root configuration file.
+	RequiredConfigFiles []string
 }
 
 func (rc *RunContext) GetKubeContext() string                 { return rc.KubeContext }
@@ -51,6 +54,11 @@ func (rc *RunContext) GetInsecureRegistries() map[string]bool { return rc.Insecu
 func (rc *RunContext) GetWorkingDir() string                  { return rc.WorkingDir }
 func (rc *RunContext) GetCluster() config.Cluster             { return rc.Cluster }
 
+// ConfigurationFiles returns the root configuration file followed by all the files it requires.
+func (rc *RunContext) ConfigurationFiles() []string {
+	return append([]string{rc.Opts.ConfigurationFile}, rc.RequiredConfigFiles...)
+}
+
 func (rc *RunContext) AddSkaffoldLabels() bool                   { return rc.Opts.AddSkaffoldLabels }
 func (rc *RunContext) AutoBuild() bool                           { return rc.Opts.AutoBuild }
 func (rc *RunContext) AutoDeploy() bool                          { return rc.Opts.AutoDeploy }
@@ -64,21 +72,27 @@ func (rc *RunContext) DefaultRepo() *string                      { return rc.Opt
 func (rc *RunContext) Mode() config.RunMode                      { return rc.Opts.Mode() }
 func (rc *RunContext) DigestSource() string                      { return rc.Opts.DigestSource }
 func (rc *RunContext) DryRun() bool                              { return rc.Opts.DryRun }
+func (rc *RunContext) ExplainCache() bool                        { return rc.Opts.ExplainCache }
 func (rc *RunContext) ForceDeploy() bool                         { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                     { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
 func (rc *RunContext) GlobalConfig() string                      { return rc.Opts.GlobalConfig }
+func (rc *RunContext) LogFormat() string                         { return rc.Opts.LogFormat }
+func (rc *RunContext) LogPrevious() bool                         { return rc.Opts.LogPrevious }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
 func (rc *RunContext) Notification() bool                        { return rc.Opts.Notification }
 func (rc *RunContext) PortForward() bool                         { return rc.Opts.PortForward.Enabled }
 func (rc *RunContext) Prune() bool                               { return rc.Opts.Prune() }
+func (rc *RunContext) RemoteCache() string                       { return rc.Opts.RemoteCache }
 func (rc *RunContext) RenderOnly() bool                          { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                      { return rc.Opts.RenderOutput }
+func (rc *RunContext) RollbackOnFailure() bool                   { return rc.Opts.RollbackOnFailure }
 func (rc *RunContext) SkipRender() bool                          { return rc.Opts.SkipRender }
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) StatusCheck() bool                         { return rc.Opts.StatusCheck }
+func (rc *RunContext) SyncMode() string                          { return rc.Opts.SyncMode }
 func (rc *RunContext) Tail() bool                                { return rc.Opts.Tail }
 func (rc *RunContext) Trigger() string                           { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
@@ -74,11 +74,21 @@ type SkaffoldRunner struct {
 	// podSelector is used to determine relevant pods for logging and portForwarding
 	podSelector *kubernetes.ImageList
 
-	imagesAreLocal bool
-	hasBuilt       bool
-	hasDeployed    bool
-	intents        *intents
-	devIteration   int
+	imagesAreLocal   bool
+	tryImportMissing bool
+	hasBuilt         bool
+	hasDeployed      bool
+	intents          *intents
+	devIteration     int
+
+	// configLoader reloads the configuration when it changes during dev.
+	configLoader ConfigLoader
+	// devArtifacts are the artifacts currently watched by the dev loop.
+	devArtifacts map[string]*latest.Artifact
+	// artifactGraph is the transpose of the artifact dependency graph used by the dev loop.
+	artifactGraph graph
+	// devPlatform is the platform of the cluster nodes, which the dev loop builds for.
+	devPlatform string
 }
 
 // for testing
@@ -57,6 +57,7 @@ type TestBench struct {
 	testErrors   []error
 	deployErrors []error
 	namespaces   []string
+	rolledBack   bool
 
 	devLoop        func(context.Context, io.Writer, func() error) error
 	firstMonitor   func(bool) error
@@ -96,10 +97,14 @@ func (t *TestBench) WithTestErrors(testErrors []error) *TestBench {
 	return t
 }
 
-func (t *TestBench) TestDependencies() ([]string, error)              { return nil, nil }
+func (t *TestBench) TestDependencies(*latest.Artifact) ([]string, error) { return nil, nil }
 func (t *TestBench) Dependencies() ([]string, error)                  { return nil, nil }
 func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error { return nil }
 func (t *TestBench) Prune(ctx context.Context, out io.Writer) error   { return nil }
+func (t *TestBench) Rollback(ctx context.Context, out io.Writer) error {
+	t.rolledBack = true
+	return nil
+}
 
 func (t *TestBench) enterNewCycle() {
 	t.actions = append(t.actions, t.currentActions)
@@ -129,7 +134,7 @@ func (t *TestBench) Build(_ context.Context, _ io.Writer, _ tag.ImageTags, artif
 	return builds, nil
 }
 
-func (t *TestBench) Sync(_ context.Context, item *sync.Item) error {
+func (t *TestBench) Sync(_ context.Context, _ io.Writer, item *sync.Item) error {
 	if len(t.syncErrors) > 0 {
 		err := t.syncErrors[0]
 		t.syncErrors = t.syncErrors[1:]
@@ -239,6 +244,7 @@ func createRunner(t *testutil.T, testBench *TestBench, monitor filemon.Monitor)
 	runner.deployer = testBench
 	runner.listener = testBench
 	runner.monitor = monitor
+	t.Override(&clusterPlatform, func(context.Context) (string, error) { return "", fmt.Errorf("no cluster") })
 
 	testBench.devLoop = func(ctx context.Context, out io.Writer, doDev func() error) error {
 		if err := monitor.Run(true); err != nil {
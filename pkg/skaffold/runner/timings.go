@@ -103,6 +103,19 @@ func (w withTimings) Cleanup(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+func (w withTimings) Rollback(ctx context.Context, out io.Writer) error {
+	start := time.Now()
+	color.Default.Fprintln(out, "Rolling back...")
+
+	err := deploy.Rollback(ctx, out, w.Deployer)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infoln("Rollback complete in", time.Since(start))
+	return nil
+}
+
 func (w withTimings) Prune(ctx context.Context, out io.Writer) error {
 	start := time.Now()
 	color.Default.Fprintln(out, "Pruning images...")
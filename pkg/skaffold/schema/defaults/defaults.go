@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -47,6 +48,12 @@ func Set(c *latest.SkaffoldConfig) error {
 	setDefaultKustomizePath(c)
 	setDefaultKubectlManifests(c)
 	setDefaultLogsConfig(c)
+	setDefaultStatusCheckConditions(c)
+	setDefaultCanaryStrategy(c)
+
+	for _, tc := range c.Test {
+		setDefaultTestWorkspace(tc)
+	}
 
 	for _, a := range c.Build.Artifacts {
 		setDefaultWorkspace(a)
@@ -70,6 +77,9 @@ func Set(c *latest.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.KoArtifact != nil:
+			setKoArtifactDefaults(a.KoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
@@ -106,6 +116,22 @@ func Set(c *latest.SkaffoldConfig) error {
 	return nil
 }
 
+// SetPaths sets the default values that are paths relative to the directory of the config:
+// the artifact workspaces and the manifests of the default deployers.
+// Each config must get them before it's merged with the configs it requires.
+func SetPaths(c *latest.SkaffoldConfig) {
+	defaultToKubectlDeploy(c)
+	setDefaultKustomizePath(c)
+	setDefaultKubectlManifests(c)
+
+	for _, a := range c.Build.Artifacts {
+		setDefaultWorkspace(a)
+	}
+	for _, tc := range c.Test {
+		setDefaultTestWorkspace(tc)
+	}
+}
+
 func defaultToLocalBuild(c *latest.SkaffoldConfig) {
 	if c.Build.BuildType != (latest.BuildType{}) {
 		return
@@ -196,6 +222,26 @@ func setDefaultLogsConfig(c *latest.SkaffoldConfig) {
 	}
 }
 
+func setDefaultStatusCheckConditions(c *latest.SkaffoldConfig) {
+	for i := range c.Deploy.StatusCheckCustomResources {
+		if c.Deploy.StatusCheckCustomResources[i].Condition == "" {
+			c.Deploy.StatusCheckCustomResources[i].Condition = "Ready"
+		}
+	}
+}
+
+func setDefaultCanaryStrategy(c *latest.SkaffoldConfig) {
+	if c.Deploy.Strategy == nil || c.Deploy.Strategy.Canary == nil {
+		return
+	}
+
+	canary := c.Deploy.Strategy.Canary
+	canary.Suffix = valueOrDefault(canary.Suffix, "canary")
+	if canary.Percent == 0 {
+		canary.Percent = 10
+	}
+}
+
 func defaultToDockerArtifact(a *latest.Artifact) {
 	if a.ArtifactType == (latest.ArtifactType{}) {
 		a.ArtifactType = latest.ArtifactType{
@@ -212,6 +258,11 @@ func setCustomArtifactDefaults(a *latest.CustomArtifact) {
 	}
 }
 
+func setKoArtifactDefaults(a *latest.KoArtifact) {
+	a.BaseImage = valueOrDefault(a.BaseImage, ko.DefaultBaseImage)
+	a.Main = valueOrDefault(a.Main, ".")
+}
+
 func setBuildpackArtifactDefaults(a *latest.BuildpackArtifact) {
 	if a.ProjectDescriptor == "" {
 		a.ProjectDescriptor = constants.DefaultProjectDescriptor
@@ -231,6 +282,10 @@ func setDefaultWorkspace(a *latest.Artifact) {
 	a.Workspace = valueOrDefault(a.Workspace, ".")
 }
 
+func setDefaultTestWorkspace(tc *latest.TestCase) {
+	tc.Workspace = valueOrDefault(tc.Workspace, ".")
+}
+
 func setDefaultSync(a *latest.Artifact) {
 	if a.Sync != nil {
 		if len(a.Sync.Manual) == 0 && len(a.Sync.Infer) == 0 && a.Sync.Auto == nil {
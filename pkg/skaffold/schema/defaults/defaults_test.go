@@ -390,3 +390,22 @@ func TestSetLogsConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSetDefaultStatusCheckConditions(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Deploy: latest.DeployConfig{
+				StatusCheckCustomResources: []latest.CustomResourceStatusCheck{
+					{Kind: "Certificate", Group: "cert-manager.io"},
+					{Kind: "Database", Group: "example.com", Condition: "Available"},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, "Ready", cfg.Deploy.StatusCheckCustomResources[0].Condition)
+	testutil.CheckDeepEqual(t, "Available", cfg.Deploy.StatusCheckCustomResources[1].Condition)
+}
@@ -44,6 +44,9 @@ type SkaffoldConfig struct {
 	// Metadata holds additional information about the config.
 	Metadata Metadata `yaml:"metadata,omitempty"`
 
+	// Dependencies *alpha* describes a list of other required configs for the current config.
+	Dependencies []ConfigDependency `yaml:"requires,omitempty"`
+
 	// Pipeline defines the Build/Test/Deploy phases.
 	Pipeline `yaml:",inline"`
 
@@ -57,6 +60,26 @@ type Metadata struct {
 	Name string `yaml:"name,omitempty"`
 }
 
+// ConfigDependency describes a dependency on another skaffold configuration.
+type ConfigDependency struct {
+	// Path describes the path to the file containing the required config, or to the directory containing its `skaffold.yaml`.
+	// Relative paths are resolved from the directory of the current config.
+	Path string `yaml:"path,omitempty" yamltags:"required"`
+
+	// ActiveProfiles describes the list of profiles to activate when resolving the required config. These profiles must exist in the required config.
+	ActiveProfiles []ProfileDependency `yaml:"activeProfiles,omitempty"`
+}
+
+// ProfileDependency describes a mapping from referenced config profiles to the current config profiles.
+// If the current config is activated with a profile in this mapping then the dependency configs are also activated with the corresponding mapped profiles.
+type ProfileDependency struct {
+	// Name describes name of the profile to activate in the dependency config. It should exist in the dependency config.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// ActivatedBy describes a list of profiles in the current config that when activated will also activate the named profile in the dependency config. If empty then the named profile is always activated.
+	ActivatedBy []string `yaml:"activatedBy,omitempty"`
+}
+
 // Pipeline describes a Skaffold pipeline.
 type Pipeline struct {
 	// Build describes how images are built.
@@ -134,11 +157,18 @@ type TagPolicy struct {
 
 	// CustomTemplateTagger *beta* tags images with a configurable template string *composed of other taggers*.
 	CustomTemplateTagger *CustomTemplateTagger `yaml:"customTemplate,omitempty" yamltags:"oneOf=tag"`
+
+	// InputDigest *alpha* tags images with the digest of their inputs: source files, configuration and required artifacts.
+	InputDigest *InputDigest `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
 type ShaTagger struct{}
 
+// InputDigest *alpha* tags images with the digest of their inputs, the same digest that Skaffold uses to cache artifacts.
+// Identical sources always get identical tags.
+type InputDigest struct{}
+
 // GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
 type GitTagger struct {
 	// Variant determines the behavior of the git tagger. Valid variants are:
@@ -233,11 +263,34 @@ type LocalBuild struct {
 	// UseBuildkit use BuildKit to build Docker images.
 	UseBuildkit bool `yaml:"useBuildkit,omitempty"`
 
+	// Backend *alpha* selects the tool that builds `docker` artifacts.
+	// Defaults to `docker`. Valid backends are
+	// `docker`: the Docker daemon, through its API or the `docker` CLI.
+	// `buildkit`: a standalone BuildKit daemon, with `buildctl`.
+	// `podman`: the `podman` CLI, which can build rootless.
+	// `buildah`: the `buildah` CLI.
+	Backend string `yaml:"backend,omitempty"`
+
+	// BuildKit *alpha* configures the `buildkit` backend.
+	BuildKit *BuildKitConfig `yaml:"buildkit,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
 }
 
+// BuildKitConfig describes how `docker` artifacts are built by a standalone BuildKit daemon.
+type BuildKitConfig struct {
+	// Addr is the address of the `buildkitd` daemon.
+	// Defaults to the `BUILDKIT_HOST` environment variable, or to the default address of `buildctl`.
+	// For example: `tcp://buildkitd:1234`.
+	Addr string `yaml:"addr,omitempty"`
+
+	// ExportCache exports the build cache to the registry, as the `buildcache` tag of each image,
+	// and imports it in later builds.
+	ExportCache bool `yaml:"exportCache,omitempty"`
+}
+
 // GoogleCloudBuild *beta* describes how to do a remote build on
 // [Google Cloud Build](https://cloud.google.com/cloud-build/docs/).
 // Docker and Jib artifacts can be built on Cloud Build. The `projectId` needs
@@ -425,18 +478,55 @@ type ResourceRequirement struct {
 	ResourceStorage string `yaml:"resourceStorage,omitempty"`
 }
 
-// TestCase is a list of structure tests to run on images that Skaffold builds.
+// TestCase is a list of tests to run on images that Skaffold builds.
 type TestCase struct {
 	// ImageName is the artifact on which to run those tests.
 	// For example: `gcr.io/k8s-skaffold/example`.
 	ImageName string `yaml:"image" yamltags:"required"`
 
+	// Workspace is the directory the custom tests run from, and that their dependencies are relative to.
+	// Defaults to `.`.
+	Workspace string `yaml:"context,omitempty"`
+
+	// CustomTests *alpha* lists the set of custom tests to run after an artifact is built.
+	CustomTests []CustomTest `yaml:"custom,omitempty"`
+
 	// StructureTests lists the [Container Structure Tests](https://github.com/GoogleContainerTools/container-structure-test)
 	// to run on that artifact.
 	// For example: `["./test/*"]`.
 	StructureTests []string `yaml:"structureTests,omitempty"`
 }
 
+// CustomTest *alpha* describes a custom test command run on an artifact after it's built.
+// The image tag is available to the command in the `IMAGE` environment variable.
+type CustomTest struct {
+	// Command is the custom command to be executed. If the command exits with a non-zero return
+	// code, the test is considered to have failed.
+	// For example: `trivy image --exit-code 1 $IMAGE`.
+	Command string `yaml:"command" yamltags:"required"`
+
+	// TimeoutSeconds sets the wait time for the command to complete.
+	// If unset or 0, Skaffold waits until the command completes.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+
+	// Dependencies are additional test-specific file dependencies; changes to these files re-run this test in dev mode.
+	Dependencies *CustomTestDependencies `yaml:"dependencies,omitempty"`
+}
+
+// CustomTestDependencies *alpha* is used to specify dependencies for a custom test command.
+type CustomTestDependencies struct {
+	// Command represents a command that skaffold executes to obtain dependencies. The output of this command *must* be a valid JSON array.
+	Command string `yaml:"command,omitempty" yamltags:"oneOf=dependency"`
+
+	// Paths locates the file dependencies for the command relative to the skaffold.yaml file.
+	// For example: `["src/test/**"]`.
+	Paths []string `yaml:"paths,omitempty" yamltags:"oneOf=dependency"`
+
+	// Ignore specifies the paths that should be ignored by skaffold's file watcher. If a file exists in both `paths` and in `ignore`, it is ignored.
+	// Will only work in conjunction with `paths`.
+	Ignore []string `yaml:"ignore,omitempty"`
+}
+
 // DeployConfig contains all the configuration needed by the deploy steps.
 type DeployConfig struct {
 	DeployType `yaml:",inline"`
@@ -450,8 +540,65 @@ type DeployConfig struct {
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// StatusCheckCustomResources *alpha* lists the custom resources that the status check waits for,
+	// along with the condition that marks them as ready.
+	StatusCheckCustomResources []CustomResourceStatusCheck `yaml:"statusCheckCustomResources,omitempty"`
+
+	// LifecycleHooks *alpha* describes a set of lifecycle hooks that are executed before and after every deploy.
+	LifecycleHooks DeployHooks `yaml:"hooks,omitempty"`
+
+	// Strategy *alpha* describes how the `kubectl` and `kustomize` deployers roll out the manifests.
+	// By default, all the manifests are applied at once.
+	Strategy *DeployStrategy `yaml:"strategy,omitempty"`
+
+	// Validate *alpha* validates the rendered manifests before they are applied.
+	// The `helm` deployer requires Helm 3.1 or later.
+	Validate *ValidateConfig `yaml:"validate,omitempty"`
+}
+
+// ValidateConfig *alpha* describes how the rendered manifests are validated.
+type ValidateConfig struct {
+	// Schema validates the manifests against the OpenAPI schema of the cluster.
+	// `skaffold render --offline` uses a schema bundled with Skaffold instead.
+	// Defaults to `true`.
+	Schema *bool `yaml:"schema,omitempty"`
+
+	// Policies lists the Rego policy files that the manifests must satisfy.
+	// They are evaluated with `opa` and must define `deny` rules in package `skaffold`,
+	// see https://skaffold.dev/docs/pipeline-stages/deployers/validation.
+	Policies []string `yaml:"policies,omitempty"`
 }
 
+// DeployStrategy *alpha* describes how the manifests are rolled out to the cluster.
+type DeployStrategy struct {
+	// Canary first deploys a scaled down copy of the workloads and promotes
+	// the new version once the copy has passed the status check.
+	Canary *CanaryStrategy `yaml:"canary,omitempty" yamltags:"oneOf=strategy"`
+
+	// BlueGreen deploys the new version of the workloads alongside the current one
+	// and switches the Services to it once it has passed the status check.
+	BlueGreen *BlueGreenStrategy `yaml:"blueGreen,omitempty" yamltags:"oneOf=strategy"`
+}
+
+// CanaryStrategy describes a canary rollout.
+type CanaryStrategy struct {
+	// Workloads lists the names of the Deployments, StatefulSets and ReplicaSets to copy.
+	// Defaults to all of them.
+	Workloads []string `yaml:"workloads,omitempty"`
+
+	// Suffix is appended to the names of the copies. Defaults to `canary`.
+	Suffix string `yaml:"suffix,omitempty"`
+
+	// Percent is the percentage of the replicas of each workload that the copy runs, rounded up. Defaults to `10`.
+	Percent int `yaml:"percent,omitempty"`
+}
+
+// BlueGreenStrategy describes a blue/green rollout.
+// Workloads are renamed after their colour, `blue` or `green`, and Services select the pods of the live colour.
+// The workloads of the previous colour are deleted once the Services have been switched.
+type BlueGreenStrategy struct{}
+
 // DeployType contains the specific implementation and parameters needed
 // for the deploy step. All three deployer types can be used at the same
 // time for hybrid workflows.
@@ -485,6 +632,19 @@ type KubectlDeploy struct {
 
 	// DefaultNamespace is the default namespace passed to kubectl on deployment if no other override is given.
 	DefaultNamespace *string `yaml:"defaultNamespace,omitempty"`
+
+	// ServerSideApply uses server-side apply (`kubectl apply --server-side`) with `skaffold` as the field manager.
+	ServerSideApply bool `yaml:"serverSideApply,omitempty"`
+
+	// Prune deletes the resources deployed by the current Skaffold session
+	// that are no longer part of the manifests.
+	Prune *KubectlPrune `yaml:"prune,omitempty"`
+}
+
+// KubectlPrune configures the pruning of the resources that were removed from the manifests.
+type KubectlPrune struct {
+	// DryRun only lists the resources that would be pruned, without deleting them.
+	DryRun bool `yaml:"dryRun,omitempty"`
 }
 
 // KubectlFlags are additional flags passed on the command
@@ -749,6 +909,42 @@ type LogsConfig struct {
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// Include *alpha* only shows the log lines that match at least one of these filters.
+	// By default, all the log lines are shown.
+	Include []LogFilter `yaml:"include,omitempty"`
+
+	// Exclude *alpha* hides the log lines that match at least one of these filters.
+	Exclude []LogFilter `yaml:"exclude,omitempty"`
+}
+
+// LogFilter selects log lines. A line matches a filter if it matches all of its non-empty fields.
+type LogFilter struct {
+	// Images matches the lines logged by containers running one of these images, with or without their tag.
+	// For example: `gcr.io/k8s-skaffold/leeroy-web` or `leeroy-web`.
+	Images []string `yaml:"images,omitempty"`
+
+	// Containers matches the lines logged by containers with one of these names.
+	Containers []string `yaml:"containers,omitempty"`
+
+	// Messages matches the lines whose message matches one of these regular expressions.
+	// For example: `"^GET /healthz"`.
+	Messages []string `yaml:"messages,omitempty"`
+}
+
+// CustomResourceStatusCheck describes how to check the status of a custom resource.
+type CustomResourceStatusCheck struct {
+	// Kind is the kind of the custom resource.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// Group is the API group of the custom resource.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group" yamltags:"required"`
+
+	// Condition is the type of the status condition that is `True` once the resource is ready.
+	// Defaults to `Ready`.
+	Condition string `yaml:"condition,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -773,6 +969,16 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// Platforms *alpha* lists the platforms to build the image for.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	// Several platforms produce an OCI image index, which has to be pushed to a registry.
+	// Supported by `docker`, `jib` and `ko` artifacts built locally and by `kaniko` artifacts built in cluster.
+	// During `skaffold dev`, only the platform of the cluster nodes is built.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// LifecycleHooks *alpha* describes a set of lifecycle hooks that are executed before and after each build of the artifact.
+	LifecycleHooks BuildHooks `yaml:"hooks,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -793,6 +999,9 @@ type Sync struct {
 	// Auto delegates discovery of sync rules to the build system.
 	// Only available for jib and buildpacks.
 	Auto *bool `yaml:"auto,omitempty" yamltags:"oneOf=sync"`
+
+	// LifecycleHooks *alpha* describes a set of lifecycle hooks that are executed before and after each file sync action on the target artifact's containers.
+	LifecycleHooks SyncHooks `yaml:"hooks,omitempty"`
 }
 
 // SyncRule specifies which local files to sync to remote folders.
@@ -812,6 +1021,79 @@ type SyncRule struct {
 	Strip string `yaml:"strip,omitempty"`
 }
 
+// BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
+type BuildHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
+	PreHooks []HostHook `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
+	PostHooks []HostHook `yaml:"after,omitempty"`
+}
+
+// SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
+type SyncHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=sync_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *ContainerHook `yaml:"container,omitempty" yamltags:"oneOf=sync_hook"`
+}
+
+// SyncHooks describes the list of lifecycle hooks to execute before and after each artifact sync step.
+type SyncHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact sync step.
+	PreHooks []SyncHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact sync step.
+	PostHooks []SyncHookItem `yaml:"after,omitempty"`
+}
+
+// DeployHookItem describes a single lifecycle hook to execute before or after each deployer step.
+type DeployHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=deploy_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=deploy_hook"`
+}
+
+// DeployHooks describes the list of lifecycle hooks to execute before and after each deployer step.
+type DeployHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deployer step. Container hooks will only run if the container exists from a previous deployment step (for instance the successive iterations of a dev-loop during `skaffold dev`).
+	PreHooks []DeployHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deployer step.
+	PostHooks []DeployHookItem `yaml:"after,omitempty"`
+}
+
+// HostHook describes a lifecycle hook definition to execute on the host machine.
+type HostHook struct {
+	// Command is the command to execute.
+	Command []string `yaml:"command" yamltags:"required"`
+
+	// OS is an optional slice of operating system names. If the host machine OS is different, then it skips execution.
+	OS []string `yaml:"os,omitempty"`
+}
+
+// ContainerHook describes a lifecycle hook definition to execute on a container.
+// The container name is inferred from the scope in which this hook is defined.
+type ContainerHook struct {
+	// Command is the command to execute.
+	Command []string `yaml:"command" yamltags:"required"`
+}
+
+// NamedContainerHook describes a lifecycle hook definition to execute on a named container.
+type NamedContainerHook struct {
+	// ContainerHook describes a lifecycle hook definition to execute on a container.
+	ContainerHook `yaml:",inline"`
+
+	// PodName is the name of the pod to execute the command in.
+	PodName string `yaml:"podName" yamltags:"required"`
+
+	// ContainerName is the name of the container to execute the command in.
+	ContainerName string `yaml:"containerName,omitempty"`
+}
+
 // Profile is used to override any `build`, `test` or `deploy` configuration.
 type Profile struct {
 	// Name is a unique profile name.
@@ -890,6 +1172,10 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images of Go applications, without a Docker daemon,
+	// the way [ko](https://github.com/google/ko) does.
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -1164,6 +1450,32 @@ type BazelArtifact struct {
 	BuildArgs []string `yaml:"args,omitempty"`
 }
 
+// KoArtifact builds images of Go applications by compiling a static binary
+// and adding it to a base image.
+type KoArtifact struct {
+	// BaseImage overrides the base image.
+	// Defaults to `gcr.io/distroless/static:nonroot`.
+	BaseImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the path to the main package, relative to the workspace.
+	// Defaults to `.`.
+	// For example: `./cmd/app`.
+	Main string `yaml:"main,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// They are _not_ set in the resulting container image.
+	// For example: `["GOPRIVATE=source.developers.google.com"]`.
+	Env []string `yaml:"env,omitempty"`
+
+	// Flags are additional build flags passed to `go build`.
+	// For example: `["-tags=netgo", "-mod=vendor"]`.
+	Flags []string `yaml:"flags,omitempty"`
+
+	// Ldflags are flags passed to the Go linker.
+	// For example: `["-s", "-w", "-X main.version=1.0"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+}
+
 // JibArtifact builds images using the
 // [Jib plugins for Maven and Gradle](https://github.com/GoogleContainerTools/jib/).
 type JibArtifact struct {
@@ -58,6 +58,13 @@ func ApplyProfiles(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions) error {
 	return checkKubeContextConsistency(contextSpecificProfiles, opts.KubeContext, c.Deploy.KubeContext)
 }
 
+// ActivatedProfiles returns the names of the profiles of a config that are activated,
+// either explicitly with the options or automatically.
+func ActivatedProfiles(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions) ([]string, error) {
+	profiles, _, err := activatedProfiles(c.Profiles, opts)
+	return profiles, err
+}
+
 func checkKubeContextConsistency(contextSpecificProfiles []string, cliContext, effectiveContext string) error {
 	// cli flag takes precedence
 	if cliContext != "" {
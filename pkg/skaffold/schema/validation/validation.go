@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/platform"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -53,8 +54,13 @@ func Process(config *latest.SkaffoldConfig) error {
 	errs = append(errs, validatePortForwardResources(config.PortForward)...)
 	errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
 	errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
+	errs = append(errs, validateLogFilters(config.Deploy.Logs)...)
 	errs = append(errs, validateArtifactTypes(config.Build)...)
+	errs = append(errs, validateLocalBuildBackend(config.Build)...)
+	errs = append(errs, validatePlatforms(config.Build)...)
 	errs = append(errs, validateTaggingPolicy(config.Build)...)
+	errs = append(errs, validateDeployStrategy(config.Deploy)...)
+	errs = append(errs, validateManifestValidation(config.Deploy)...)
 
 	if len(errs) == 0 {
 		return nil
@@ -414,6 +420,21 @@ func validateJibPluginTypes(artifacts []*latest.Artifact) (errs []error) {
 	return
 }
 
+// validateLocalBuildBackend checks that the backend of local builds is one of `docker`, `buildkit`, `podman` or `buildah` if set.
+func validateLocalBuildBackend(bc latest.BuildConfig) (errs []error) {
+	if bc.LocalBuild == nil {
+		return
+	}
+	backend := bc.LocalBuild.Backend
+	if !util.StrSliceContains([]string{"", "docker", "buildkit", "podman", "buildah"}, backend) {
+		errs = append(errs, fmt.Errorf("invalid local build backend '%s'. Valid values are 'docker', 'buildkit', 'podman' or 'buildah'", backend))
+	}
+	if bc.LocalBuild.BuildKit != nil && backend != "buildkit" {
+		errs = append(errs, fmt.Errorf("'buildkit' settings can only be used with the 'buildkit' local build backend"))
+	}
+	return
+}
+
 // validateArtifactTypes checks that the artifact types are compatible with the specified builder.
 func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	switch {
@@ -440,6 +461,28 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	return
 }
 
+// validatePlatforms checks that the target platforms are valid and supported by the artifacts' builders.
+func validatePlatforms(bc latest.BuildConfig) (errs []error) {
+	for _, a := range bc.Artifacts {
+		if len(a.Platforms) == 0 {
+			continue
+		}
+
+		for _, p := range a.Platforms {
+			if _, err := platform.Parse(p); err != nil {
+				errs = append(errs, fmt.Errorf("artifact %s: %w", a.ImageName, err))
+			}
+		}
+
+		at := misc.ArtifactType(a)
+		supported := (bc.LocalBuild != nil && (at == misc.Docker || at == misc.Jib || at == misc.Ko)) || (bc.Cluster != nil && at == misc.Kaniko)
+		if !supported {
+			errs = append(errs, fmt.Errorf("artifact %s: target platforms are only supported by docker, jib and ko artifacts built locally and kaniko artifacts built in cluster", a.ImageName))
+		}
+	}
+	return
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(lc latest.LogsConfig) []error {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
@@ -450,3 +493,46 @@ func validateLogPrefix(lc latest.LogsConfig) []error {
 
 	return nil
 }
+
+// validateLogFilters checks that the message filters of the logs are valid regular expressions.
+func validateLogFilters(lc latest.LogsConfig) (errs []error) {
+	for _, filter := range append(lc.Include, lc.Exclude...) {
+		for _, message := range filter.Messages {
+			if _, err := regexp.Compile(message); err != nil {
+				errs = append(errs, fmt.Errorf("invalid log message filter %q: %w", message, err))
+			}
+		}
+	}
+	return
+}
+
+// validateDeployStrategy checks that the deploy strategy is used with a deployer that supports it.
+func validateDeployStrategy(dc latest.DeployConfig) (errs []error) {
+	if dc.Strategy == nil {
+		return nil
+	}
+
+	if dc.KubectlDeploy == nil && dc.KustomizeDeploy == nil {
+		errs = append(errs, fmt.Errorf("deploy strategies are only supported by the kubectl and kustomize deployers"))
+	}
+
+	if canary := dc.Strategy.Canary; canary != nil && (canary.Percent < 0 || canary.Percent > 100) {
+		errs = append(errs, fmt.Errorf("invalid canary percent %d. It must be between 1 and 100", canary.Percent))
+	}
+
+	return
+}
+
+// validateManifestValidation checks that the validation policies are Rego files.
+func validateManifestValidation(dc latest.DeployConfig) (errs []error) {
+	if dc.Validate == nil {
+		return nil
+	}
+
+	for _, policy := range dc.Validate.Policies {
+		if !strings.HasSuffix(policy, ".rego") {
+			errs = append(errs, fmt.Errorf("invalid validation policy %q: policies must be Rego files ending in '.rego'", policy))
+		}
+	}
+	return
+}
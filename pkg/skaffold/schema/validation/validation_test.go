@@ -943,6 +943,166 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateLocalBuildBackend(t *testing.T) {
+	tests := []struct {
+		description string
+		local       latest.LocalBuild
+		shouldErr   bool
+	}{
+		{description: "default", local: latest.LocalBuild{}},
+		{description: "docker", local: latest.LocalBuild{Backend: "docker"}},
+		{description: "buildkit", local: latest.LocalBuild{Backend: "buildkit", BuildKit: &latest.BuildKitConfig{ExportCache: true}}},
+		{description: "podman", local: latest.LocalBuild{Backend: "podman"}},
+		{description: "buildah", local: latest.LocalBuild{Backend: "buildah"}},
+		{description: "unknown", local: latest.LocalBuild{Backend: "kaniko"}, shouldErr: true},
+		{description: "buildkit settings without buildkit", local: latest.LocalBuild{BuildKit: &latest.BuildKitConfig{}}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateLocalBuildBackend(latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &test.local},
+			})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateLogFilters(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.LogsConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no filters",
+		},
+		{
+			description: "valid filters",
+			cfg: latest.LogsConfig{
+				Include: []latest.LogFilter{{Images: []string{"web"}, Messages: []string{"^GET /"}}},
+				Exclude: []latest.LogFilter{{Messages: []string{"healthz$"}}},
+			},
+		},
+		{
+			description: "invalid include regexp",
+			cfg:         latest.LogsConfig{Include: []latest.LogFilter{{Messages: []string{"[a-"}}}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid exclude regexp",
+			cfg:         latest.LogsConfig{Exclude: []latest.LogFilter{{Messages: []string{"(unclosed"}}}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateLogFilters(test.cfg)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateDeployStrategy(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.DeployConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no strategy",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
+			},
+		},
+		{
+			description: "canary with kubectl",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+				Strategy:   &latest.DeployStrategy{Canary: &latest.CanaryStrategy{Percent: 20}},
+			},
+		},
+		{
+			description: "blue/green with kustomize",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{KustomizeDeploy: &latest.KustomizeDeploy{}},
+				Strategy:   &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			},
+		},
+		{
+			description: "strategy with helm only",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
+				Strategy:   &latest.DeployStrategy{BlueGreen: &latest.BlueGreenStrategy{}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "canary percent too high",
+			cfg: latest.DeployConfig{
+				DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+				Strategy:   &latest.DeployStrategy{Canary: &latest.CanaryStrategy{Percent: 150}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				&latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: test.cfg,
+					},
+				})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateManifestValidation(t *testing.T) {
+	tests := []struct {
+		description string
+		validate    *latest.ValidateConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no validation",
+		},
+		{
+			description: "rego policies",
+			validate:    &latest.ValidateConfig{Policies: []string{"policies/images.rego", "/abs/limits.rego"}},
+		},
+		{
+			description: "not a rego policy",
+			validate:    &latest.ValidateConfig{Policies: []string{"policies/images.rego", "policies/limits.yaml"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				&latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+							Validate:   test.validate,
+						},
+					},
+				})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1179,3 +1339,88 @@ func TestValidateTaggingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "docker artifact built locally",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm/v7"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "ko artifact built locally",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm64"},
+					ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "kaniko artifact built in cluster",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "invalid platform",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"arm64"},
+					ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "unsupported artifact type",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "unsupported builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validatePlatforms(test.cfg)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
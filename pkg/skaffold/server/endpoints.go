@@ -44,6 +44,13 @@ func (s *server) Handle(ctx context.Context, e *proto.Event) (*empty.Empty, erro
 	return &empty.Empty{}, nil
 }
 
+func (s *server) AttachDebugger(ctx context.Context, request *proto.AttachDebuggerRequest) (*proto.AttachDebuggerResponse, error) {
+	if s.attachDebuggerCallback == nil {
+		return nil, status.Error(codes.FailedPrecondition, "attaching a debugger requires `skaffold debug`")
+	}
+	return s.attachDebuggerCallback(request)
+}
+
 func (s *server) Execute(ctx context.Context, intent *proto.UserIntentRequest) (*empty.Empty, error) {
 	if intent.GetIntent().GetBuild() {
 		event.ResetStateOnBuild()
@@ -52,6 +52,8 @@ type server struct {
 	autoBuildCallback    func(bool)
 	autoSyncCallback     func(bool)
 	autoDeployCallback   func(bool)
+
+	attachDebuggerCallback func(*proto.AttachDebuggerRequest) (*proto.AttachDebuggerResponse, error)
 }
 
 func SetBuildCallback(callback func()) {
@@ -90,6 +92,12 @@ func SetAutoSyncCallback(callback func(bool)) {
 	}
 }
 
+func SetAttachDebuggerCallback(callback func(*proto.AttachDebuggerRequest) (*proto.AttachDebuggerResponse, error)) {
+	if srv != nil {
+		srv.attachDebuggerCallback = callback
+	}
+}
+
 // Initialize creates the gRPC and HTTP servers for serving the state and event log.
 // It returns a shutdown callback for tearing down the grpc server,
 // which the runner is responsible for calling.
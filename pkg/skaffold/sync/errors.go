@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+func syncErr(c podContainer, err error) error {
+	if err == nil {
+		return nil
+	}
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("syncing files to container %q of pod %s/%s: %s", c.container.Name, c.pod.Namespace, c.pod.Name, err),
+			ErrCode: proto.StatusCode_SYNC_NATIVE_EXEC_ERR,
+		})
+}
+
+func helperErr(c podContainer, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("container %q of pod %s/%s has no tar and the sync helper couldn't be injected, check that the cluster supports ephemeral containers: %s", c.container.Name, c.pod.Namespace, c.pod.Name, err),
+			ErrCode: proto.StatusCode_SYNC_HELPER_ERR,
+		})
+}
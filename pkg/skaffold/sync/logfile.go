@@ -0,0 +1,47 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
+)
+
+type Muted interface {
+	MuteSync() bool
+}
+
+// withLogFile returns a writer for the output of the sync hooks of an image, and a function to be executed
+// once the hooks are done. When the sync logs are muted, the output goes to a file instead.
+func withLogFile(out io.Writer, muted Muted, image string) (io.Writer, func(), error) {
+	if !muted.MuteSync() {
+		return out, func() {}, nil
+	}
+
+	file, err := logfile.Create("sync", image+".log")
+	if err != nil {
+		return out, func() {}, fmt.Errorf("unable to create log file for %s: %w", image, err)
+	}
+	fmt.Fprintln(out, " - writing logs to", file.Name())
+
+	// After the hooks finish, close the log file.
+	return file, func() {
+		file.Close()
+	}, nil
+}
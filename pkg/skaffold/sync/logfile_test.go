@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWithLogFile(t *testing.T) {
+	logHook := "[pre-sync] reloading"
+	logFilename := " - writing logs to " + filepath.Join(os.TempDir(), "skaffold", "sync", "img.log")
+
+	tests := []struct {
+		description  string
+		muted        Muted
+		logsFound    []string
+		logsNotFound []string
+	}{
+		{
+			description:  "all logs",
+			muted:        muted(false),
+			logsFound:    []string{logHook},
+			logsNotFound: []string{logFilename},
+		},
+		{
+			description:  "mute sync logs",
+			muted:        muted(true),
+			logsFound:    []string{logFilename},
+			logsNotFound: []string{logHook},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var out bytes.Buffer
+
+			hooksOut, closeLog, err := withLogFile(&out, test.muted, "img")
+			fmt.Fprintln(hooksOut, logHook)
+			closeLog()
+
+			t.CheckNoError(err)
+			for _, found := range test.logsFound {
+				t.CheckContains(found, out.String())
+			}
+			for _, notFound := range test.logsNotFound {
+				t.CheckFalse(strings.Contains(out.String(), notFound))
+			}
+		})
+	}
+}
+
+type muted bool
+
+func (m muted) MuteSync() bool {
+	return bool(m)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	stdsync "sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// KubectlSyncMode copies files with `kubectl exec` and `tar`.
+	KubectlSyncMode = "kubectl"
+
+	// NativeSyncMode streams files to containers through the Kubernetes API.
+	NativeSyncMode = "native"
+
+	syncHelperImage  = "busybox:1.32"
+	syncHelperPrefix = "skaffold-sync-"
+
+	// syncHelperRoot is where the helper container sees the filesystem of the container it targets.
+	syncHelperRoot = "/proc/1/root"
+)
+
+// For testing
+var (
+	podSynced         = event.FileSyncPodSynced
+	syncHelperTimeout = 30 * time.Second
+)
+
+// nativeSyncer streams files to containers over SPDY exec connections, without shelling out to `kubectl`.
+// Each container receives all the files of a sync in a single archive and containers are synced in parallel.
+// Containers without `tar`, like distroless images, are synced through a helper container, injected
+// into their pod as an ephemeral container that shares their process namespace.
+type nativeSyncer struct {
+	exec func(ctx context.Context, client kubernetes.Interface, pod v1.Pod, container string, command []string, stdin io.Reader) error
+
+	// withHelper records the containers that are synced through a helper container.
+	withHelper     map[string]bool
+	withHelperLock stdsync.Mutex
+}
+
+func newNativeSyncer() *nativeSyncer {
+	return &nativeSyncer{
+		exec:       spdyExec,
+		withHelper: map[string]bool{},
+	}
+}
+
+func (s *nativeSyncer) syncFiles(ctx context.Context, item *Item, namespaces []string) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	containers, err := runningContainers(ctx, client, item.Image, namespaces)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return errors.New("didn't sync any files")
+	}
+
+	logrus.Infoln("Syncing files:", item.Copy, "and deleting files:", item.Delete, "in", item.Image)
+
+	fileCount := len(item.Copy) + len(item.Delete)
+	errs, ctx := errgroup.WithContext(ctx)
+	for _, c := range containers {
+		c := c
+		errs.Go(func() error {
+			start := time.Now()
+			if err := s.syncContainer(ctx, client, c, item); err != nil {
+				return err
+			}
+			podSynced(fileCount, item.Image, c.pod.Name, c.pod.Namespace, c.container.Name, time.Since(start))
+			return nil
+		})
+	}
+	return errs.Wait()
+}
+
+func (s *nativeSyncer) syncContainer(ctx context.Context, client kubernetes.Interface, c podContainer, item *Item) error {
+	key := path.Join(c.pod.Namespace, c.pod.Name, c.container.Name)
+
+	s.withHelperLock.Lock()
+	withHelper := s.withHelper[key]
+	s.withHelperLock.Unlock()
+
+	if !withHelper {
+		err := s.transfer(ctx, client, c.pod, c.container.Name, "/", item)
+		if !isCommandNotFound(err) {
+			return syncErr(c, err)
+		}
+
+		logrus.Infof("Container %s has no tar, syncing files through a helper container", key)
+		s.withHelperLock.Lock()
+		s.withHelper[key] = true
+		s.withHelperLock.Unlock()
+	}
+
+	helper, err := injectHelper(ctx, client, c)
+	if err != nil {
+		return helperErr(c, err)
+	}
+	return syncErr(c, s.transfer(ctx, client, c.pod, helper, syncHelperRoot, item))
+}
+
+// transfer copies and deletes files in a container, relatively to a given root directory.
+func (s *nativeSyncer) transfer(ctx context.Context, client kubernetes.Interface, pod v1.Pod, container, root string, item *Item) error {
+	if len(item.Copy) > 0 {
+		reader, writer := io.Pipe()
+		defer reader.Close()
+		go func() {
+			writer.CloseWithError(util.CreateMappedTar(writer, "/", item.Copy))
+		}()
+
+		// Use "m" flag to touch the files as they are copied.
+		if err := s.exec(ctx, client, pod, container, []string{"tar", "xmf", "-", "-C", root, "--no-same-owner"}, reader); err != nil {
+			return err
+		}
+	}
+
+	if len(item.Delete) > 0 {
+		command := []string{"rm", "-rf", "--"}
+		for _, dsts := range item.Delete {
+			for _, dst := range dsts {
+				command = append(command, path.Join(root, dst))
+			}
+		}
+		if err := s.exec(ctx, client, pod, container, command, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// injectHelper adds a helper container, targeting the given container, to its pod
+// and waits for it to run. The helper is reused for all subsequent syncs.
+func injectHelper(ctx context.Context, client kubernetes.Interface, c podContainer) (string, error) {
+	name := syncHelperPrefix + c.container.Name
+	pods := client.CoreV1().Pods(c.pod.Namespace)
+
+	ecs, err := pods.GetEphemeralContainers(ctx, c.pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	found := false
+	for _, ec := range ecs.EphemeralContainers {
+		if ec.Name == name {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		ecs.EphemeralContainers = append(ecs.EphemeralContainers, v1.EphemeralContainer{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:    name,
+				Image:   syncHelperImage,
+				Command: []string{"sleep", "2147483647"},
+			},
+			TargetContainerName: c.container.Name,
+		})
+		if _, err := pods.UpdateEphemeralContainers(ctx, c.pod.Name, ecs, metav1.UpdateOptions{}); err != nil {
+			return "", err
+		}
+	}
+
+	err = wait.PollImmediate(time.Second, syncHelperTimeout, func() (bool, error) {
+		pod, err := pods.Get(ctx, c.pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, status := range pod.Status.EphemeralContainerStatuses {
+			if status.Name == name {
+				return status.State.Running != nil, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("waiting for helper container %q to run: %w", name, err)
+	}
+
+	return name, nil
+}
+
+// spdyExec runs a command in a container, streaming stdin to it.
+func spdyExec(ctx context.Context, client kubernetes.Interface, pod v1.Pod, container string, command []string, stdin io.Reader) error {
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	if err := streamWithContext(ctx, executor, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: ioutil.Discard,
+		Stderr: &stderr,
+	}); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// streamWithContext streams a remote command until it ends or the context is cancelled.
+// TODO: use executor.StreamWithContext once client-go is upgraded to v0.26 or later.
+func streamWithContext(ctx context.Context, executor remotecommand.Executor, options remotecommand.StreamOptions) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- executor.Stream(options)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errs:
+		return err
+	}
+}
+
+// isCommandNotFound checks if a command failed because it couldn't be found in the container.
+func isCommandNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127
+	}
+	return strings.Contains(err.Error(), "executable file not found")
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	stdsync "sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeExec struct {
+	lock     stdsync.Mutex
+	commands []string
+	noTar    bool
+	err      error
+}
+
+func (f *fakeExec) exec(_ context.Context, _ kubernetes.Interface, pod v1.Pod, container string, command []string, stdin io.Reader) error {
+	if stdin != nil {
+		ioutil.ReadAll(stdin)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.commands = append(f.commands, pod.Name+"/"+container+": "+strings.Join(command, " "))
+
+	if f.noTar && command[0] == "tar" && !strings.HasPrefix(container, syncHelperPrefix) {
+		return utilexec.CodeExitError{Err: errors.New("tar: not found"), Code: 127}
+	}
+	return f.err
+}
+
+func runningPod(name string, helperStatuses ...v1.ContainerStatus) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.PodStatus{
+			Phase:                      v1.PodRunning,
+			EphemeralContainerStatuses: helperStatuses,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "app", Image: "gcr.io/k8s-skaffold:123"}},
+		},
+	}
+}
+
+func TestNativeSync(t *testing.T) {
+	runningHelper := v1.ContainerStatus{Name: "skaffold-sync-app", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}
+
+	tests := []struct {
+		description     string
+		pods            []runtime.Object
+		item            *Item
+		noTar           bool
+		execErr         error
+		expected        []string
+		expectedHelpers int
+		shouldErr       bool
+	}{
+		{
+			description: "copy and delete in one exec each",
+			pods:        []runtime.Object{runningPod("pod")},
+			item: &Item{
+				Image:  "gcr.io/k8s-skaffold:123",
+				Copy:   map[string][]string{"a.go": {"/app/a.go"}, "b.go": {"/app/b.go"}},
+				Delete: map[string][]string{"c.go": {"/app/c.go"}},
+			},
+			expected: []string{
+				"pod/app: tar xmf - -C / --no-same-owner",
+				"pod/app: rm -rf -- /app/c.go",
+			},
+		},
+		{
+			description: "sync through helper when tar is missing",
+			pods:        []runtime.Object{runningPod("pod", runningHelper)},
+			item: &Item{
+				Image:  "gcr.io/k8s-skaffold:123",
+				Copy:   map[string][]string{"a.go": {"/app/a.go"}},
+				Delete: map[string][]string{"c.go": {"/app/c.go"}},
+			},
+			noTar: true,
+			expected: []string{
+				"pod/app: tar xmf - -C / --no-same-owner",
+				"pod/skaffold-sync-app: tar xmf - -C /proc/1/root --no-same-owner",
+				"pod/skaffold-sync-app: rm -rf -- /proc/1/root/app/c.go",
+			},
+			expectedHelpers: 1,
+		},
+		{
+			description: "exec error",
+			pods:        []runtime.Object{runningPod("pod")},
+			item: &Item{
+				Image: "gcr.io/k8s-skaffold:123",
+				Copy:  map[string][]string{"a.go": {"/app/a.go"}},
+			},
+			execErr:   errors.New("connection refused"),
+			expected:  []string{"pod/app: tar xmf - -C / --no-same-owner"},
+			shouldErr: true,
+		},
+		{
+			description: "no running container",
+			pods:        []runtime.Object{nonRunningPod},
+			item: &Item{
+				Image: "gcr.io/k8s-skaffold:123",
+				Copy:  map[string][]string{"a.go": {"/app/a.go"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var helpers []v1.EphemeralContainer
+			fakeClient := fake.NewSimpleClientset(test.pods...)
+			fakeClient.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "ephemeralcontainers" {
+					return false, nil, nil
+				}
+				return true, &v1.EphemeralContainers{EphemeralContainers: helpers}, nil
+			})
+			fakeClient.PrependReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "ephemeralcontainers" {
+					return false, nil, nil
+				}
+				ecs := action.(k8stesting.UpdateAction).GetObject().(*v1.EphemeralContainers)
+				helpers = ecs.EphemeralContainers
+				return true, ecs, nil
+			})
+			t.Override(&client.Client, func() (kubernetes.Interface, error) { return fakeClient, nil })
+			t.Override(&podSynced, func(int, string, string, string, string, time.Duration) {})
+			t.Override(&syncHelperTimeout, time.Second)
+
+			fake := &fakeExec{noTar: test.noTar, err: test.execErr}
+			s := newNativeSyncer()
+			s.exec = fake.exec
+
+			err := s.syncFiles(context.Background(), test.item, []string{""})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, fake.commands)
+			t.CheckDeepEqual(test.expectedHelpers, len(helpers))
+			for _, h := range helpers {
+				t.CheckDeepEqual("app", h.TargetContainerName)
+			}
+		})
+	}
+}
+
+func TestNativeSyncRemembersHelper(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		helper := v1.ContainerStatus{Name: "skaffold-sync-app", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}
+		fakeClient := fake.NewSimpleClientset(runningPod("pod", helper))
+		fakeClient.PrependReactor("*", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "ephemeralcontainers" {
+				return false, nil, nil
+			}
+			return true, &v1.EphemeralContainers{EphemeralContainers: []v1.EphemeralContainer{{
+				EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "skaffold-sync-app"},
+			}}}, nil
+		})
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return fakeClient, nil })
+
+		var synced []string
+		t.Override(&podSynced, func(_ int, image, pod, namespace, container string, _ time.Duration) {
+			synced = append(synced, image+" "+pod+" "+container)
+		})
+
+		fake := &fakeExec{noTar: true}
+		s := newNativeSyncer()
+		s.exec = fake.exec
+		item := &Item{Image: "gcr.io/k8s-skaffold:123", Copy: map[string][]string{"a.go": {"/app/a.go"}}}
+
+		t.CheckNoError(s.syncFiles(context.Background(), item, []string{""}))
+		t.CheckNoError(s.syncFiles(context.Background(), item, []string{""}))
+
+		t.CheckDeepEqual([]string{
+			"pod/app: tar xmf - -C / --no-same-owner",
+			"pod/skaffold-sync-app: tar xmf - -C /proc/1/root --no-same-owner",
+			"pod/skaffold-sync-app: tar xmf - -C /proc/1/root --no-same-owner",
+		}, fake.commands)
+		t.CheckDeepEqual([]string{"gcr.io/k8s-skaffold:123 pod app", "gcr.io/k8s-skaffold:123 pod app"}, synced)
+	})
+}
+
+func TestIsCommandNotFound(t *testing.T) {
+	testutil.CheckDeepEqual(t, false, isCommandNotFound(nil))
+	testutil.CheckDeepEqual(t, false, isCommandNotFound(errors.New("connection refused")))
+	testutil.CheckDeepEqual(t, false, isCommandNotFound(utilexec.CodeExitError{Err: errors.New("failed"), Code: 1}))
+	testutil.CheckDeepEqual(t, true, isCommandNotFound(utilexec.CodeExitError{Err: errors.New("not found"), Code: 127}))
+	testutil.CheckDeepEqual(t, true, isCommandNotFound(errors.New(`exec: "tar": executable file not found in $PATH`)))
+}
+
+type blockingExecutor struct {
+	release chan struct{}
+}
+
+func (e *blockingExecutor) Stream(remotecommand.StreamOptions) error {
+	<-e.release
+	return nil
+}
+
+func TestStreamWithContext(t *testing.T) {
+	executor := &blockingExecutor{release: make(chan struct{})}
+	defer close(executor.release)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := streamWithContext(ctx, executor, remotecommand.StreamOptions{})
+
+	testutil.CheckDeepEqual(t, true, errors.Is(err, context.Canceled))
+}
@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/bmatcuk/doublestar"
@@ -30,12 +32,14 @@ import (
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -99,7 +103,7 @@ func syncItem(a *latest.Artifact, tag string, e filemon.Events, syncRules []*lat
 		return nil, nil
 	}
 
-	return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 }
 
 func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg docker.Config) (*Item, error) {
@@ -143,7 +147,7 @@ func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg dock
 		}
 	}
 
-	return &Item{Image: tag, Copy: toCopy}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy}, nil
 }
 
 func syncMapForArtifact(a *latest.Artifact, cfg docker.Config) (map[string][]string, error) {
@@ -186,7 +190,7 @@ func autoSyncItem(ctx context.Context, a *latest.Artifact, tag string, e filemon
 			// do a rebuild
 			return nil, nil
 		}
-		return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+		return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 
 	default:
 		// TODO: this error does appear a little late in the build, perhaps it could surface at first run, rather than first sync?
@@ -251,7 +255,59 @@ func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) (
 	return dsts, nil
 }
 
-func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
+func (s *podSyncer) Sync(ctx context.Context, out io.Writer, item *Item) error {
+	var h latest.SyncHooks
+	if item.Artifact != nil && item.Artifact.Sync != nil {
+		h = item.Artifact.Sync.LifecycleHooks
+	}
+	r := hooks.SyncRunner(s.cfg, h, s.hookEnvOpts(item))
+
+	hooksOut := out
+	if len(h.PreHooks) > 0 || len(h.PostHooks) > 0 {
+		var closeLog func()
+		var err error
+		if hooksOut, closeLog, err = withLogFile(out, s.cfg.Muted(), item.Image); err != nil {
+			return err
+		}
+		defer closeLog()
+	}
+
+	if err := r.RunPreHooks(ctx, hooksOut); err != nil {
+		return err
+	}
+
+	if err := s.syncFiles(ctx, item); err != nil {
+		return err
+	}
+
+	return r.RunPostHooks(ctx, hooksOut)
+}
+
+func (s *podSyncer) hookEnvOpts(item *Item) hooks.SyncEnvOpts {
+	opts := hooks.SyncEnvOpts{
+		Image:       item.Image,
+		KubeContext: s.cfg.GetKubeContext(),
+		Namespaces:  s.namespaces,
+	}
+	if item.Artifact != nil {
+		opts.BuildContext, _ = filepath.Abs(item.Artifact.Workspace)
+	}
+	for src := range item.Copy {
+		opts.FilesAddedOrModified = append(opts.FilesAddedOrModified, src)
+	}
+	for src := range item.Delete {
+		opts.FilesDeleted = append(opts.FilesDeleted, src)
+	}
+	sort.Strings(opts.FilesAddedOrModified)
+	sort.Strings(opts.FilesDeleted)
+	return opts
+}
+
+func (s *podSyncer) syncFiles(ctx context.Context, item *Item) error {
+	if s.native != nil {
+		return s.native.syncFiles(ctx, item, s.namespaces)
+	}
+
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
@@ -283,11 +339,42 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 		return fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	numSynced := 0
+	containers, err := runningContainers(ctx, client, image, namespaces)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		cmd := cmdFn(ctx, c.pod, c.container, files)
+		errs.Go(func() error {
+			_, err := util.RunCmdOut(cmd)
+			return err
+		})
+	}
+
+	if err := errs.Wait(); err != nil {
+		return err
+	}
+
+	if len(containers) == 0 {
+		return errors.New("didn't sync any files")
+	}
+	return nil
+}
+
+// podContainer is a container of a running pod.
+type podContainer struct {
+	pod       v1.Pod
+	container v1.Container
+}
+
+// runningContainers lists the containers of running pods that use a given image.
+func runningContainers(ctx context.Context, client kubernetes.Interface, image string, namespaces []string) ([]podContainer, error) {
+	var containers []podContainer
 	for _, ns := range namespaces {
 		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
 		if err != nil {
-			return fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+			return nil, fmt.Errorf("getting pods for namespace %q: %w", ns, err)
 		}
 
 		for _, p := range pods.Items {
@@ -296,28 +383,13 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 			}
 
 			for _, c := range p.Spec.Containers {
-				if c.Image != image {
-					continue
+				if c.Image == image {
+					containers = append(containers, podContainer{pod: p, container: c})
 				}
-
-				cmd := cmdFn(ctx, p, c, files)
-				errs.Go(func() error {
-					_, err := util.RunCmdOut(cmd)
-					return err
-				})
-				numSynced++
 			}
 		}
 	}
-
-	if err := errs.Wait(); err != nil {
-		return err
-	}
-
-	if numSynced == 0 {
-		return errors.New("didn't sync any files")
-	}
-	return nil
+	return containers, nil
 }
 
 func Init(ctx context.Context, artifacts []*latest.Artifact) error {
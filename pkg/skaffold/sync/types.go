@@ -18,37 +18,59 @@ package sync
 
 import (
 	"context"
+	"io"
 
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 type syncMap map[string][]string
 
 type Item struct {
-	Image  string
-	Copy   map[string][]string
-	Delete map[string][]string
+	Image    string
+	Artifact *latest.Artifact
+	Copy     map[string][]string
+	Delete   map[string][]string
 }
 
 type Syncer interface {
-	Sync(context.Context, *Item) error
+	Sync(context.Context, io.Writer, *Item) error
 }
 
 type podSyncer struct {
 	kubectl    *pkgkubectl.CLI
 	namespaces []string
+	cfg        Config
+
+	// native is set when files are streamed through the Kubernetes API instead of `kubectl exec`.
+	native *nativeSyncer
 }
 
 type Config interface {
 	kubectl.Config
 
 	GetNamespaces() []string
+	SyncMode() string
+	Muted() config.Muted
 }
 
 func NewSyncer(cfg Config) Syncer {
-	return &podSyncer{
+	s := &podSyncer{
 		kubectl:    pkgkubectl.NewCLI(cfg, ""),
 		namespaces: cfg.GetNamespaces(),
+		cfg:        cfg,
+	}
+
+	switch cfg.SyncMode() {
+	case NativeSyncMode:
+		s.native = newNativeSyncer()
+	case KubectlSyncMode, "":
+	default:
+		logrus.Warnf("Unknown sync mode %q, syncing files with %s", cfg.SyncMode(), KubectlSyncMode)
 	}
+	return s
 }
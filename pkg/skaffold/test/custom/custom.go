@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Runner runs a custom test command on an image.
+type Runner struct {
+	customTest latest.CustomTest
+	workingDir string
+	extraEnv   []string
+}
+
+// New creates a new custom.Runner.
+func New(ct latest.CustomTest, workingDir string, extraEnv []string) *Runner {
+	return &Runner{
+		customTest: ct,
+		workingDir: workingDir,
+		extraEnv:   extraEnv,
+	}
+}
+
+// Test is the entrypoint for running custom tests
+func (ct *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
+	logrus.Infof("Running custom test command %q on %s", ct.customTest.Command, imageTag)
+
+	if ct.customTest.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(ct.customTest.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	// We evaluate the command with a shell so that it can contain
+	// env variables.
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", ct.customTest.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", ct.customTest.Command)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Env = ct.env(imageTag)
+	cmd.Dir = ct.workingDir
+
+	if err := util.RunCmd(cmd); err != nil {
+		switch {
+		case ctx.Err() == context.DeadlineExceeded:
+			return cmdTimedOutErr(ct.customTest.Command, ct.customTest.TimeoutSeconds, err)
+		case ctx.Err() == context.Canceled:
+			return cmdCancelledErr(ct.customTest.Command, err)
+		}
+		if _, ok := err.(*exec.ExitError); ok {
+			return cmdNonZeroExitErr(ct.customTest.Command, err)
+		}
+		return cmdRunErr(ct.customTest.Command, err)
+	}
+
+	return nil
+}
+
+// TestDependencies returns the absolute paths of the files this test depends on.
+func (ct *Runner) TestDependencies() ([]string, error) {
+	deps := ct.customTest.Dependencies
+	if deps == nil {
+		return nil, nil
+	}
+
+	var files []string
+	switch {
+	case deps.Command != "":
+		split := strings.Split(deps.Command, " ")
+		cmd := exec.Command(split[0], split[1:]...)
+		cmd.Dir = ct.workingDir
+		output, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, dependencyCmdErr(fmt.Errorf("getting dependencies from command: %q: %w", deps.Command, err))
+		}
+		if err := json.Unmarshal(output, &files); err != nil {
+			return nil, dependencyCmdErr(fmt.Errorf("unmarshalling dependency output into string array: %w", err))
+		}
+
+	default:
+		var err error
+		files, err = list.Files(ct.workingDir, deps.Paths, deps.Ignore)
+		if err != nil {
+			return nil, dependencyPathsErr(err)
+		}
+	}
+
+	for i, f := range files {
+		if !filepath.IsAbs(f) {
+			files[i] = filepath.Join(ct.workingDir, f)
+		}
+	}
+	return files, nil
+}
+
+// env returns the current process environment along with the image tag and any extra environment.
+func (ct *Runner) env(imageTag string) []string {
+	env := []string{fmt.Sprintf("%s=%s", constants.Image, imageTag)}
+	env = append(env, ct.extraEnv...)
+	return append(env, util.OSEnviron()...)
+}
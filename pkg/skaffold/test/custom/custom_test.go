@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"runtime"
+	"testing"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCustomCommand(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return []string{"PATH=/bin"} })
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv(
+			"sh -c go test ./...",
+			[]string{"IMAGE=image:tag", "DOCKER_HOST=tcp://minikube:2376", "PATH=/bin"},
+		))
+		if runtime.GOOS == "windows" {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun("cmd.exe /C go test ./..."))
+		}
+
+		runner := New(latest.CustomTest{Command: "go test ./..."}, "", []string{"DOCKER_HOST=tcp://minikube:2376"})
+		err := runner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestCustomCommandError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr("sh -c unknown-cmd", errors.New("not found")))
+		if runtime.GOOS == "windows" {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunErr("cmd.exe /C unknown-cmd", errors.New("not found")))
+		}
+
+		runner := New(latest.CustomTest{Command: "unknown-cmd"}, "", nil)
+		err := runner.Test(context.Background(), ioutil.Discard, "image:tag")
+
+		t.CheckErrorContains(`running custom test command "unknown-cmd": not found`, err)
+		t.CheckDeepEqual(proto.StatusCode_TEST_CUSTOM_CMD_RUN_EXECUTION_ERR, err.(sErrors.Error).StatusCode())
+	})
+}
+
+func TestCustomCommandExit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("custom test commands are run with sh")
+	}
+
+	tests := []struct {
+		description  string
+		test         latest.CustomTest
+		expectedCode proto.StatusCode
+	}{
+		{
+			description: "success",
+			test:        latest.CustomTest{Command: `test "$IMAGE" = "image:tag"`},
+		},
+		{
+			description:  "non zero exit code",
+			test:         latest.CustomTest{Command: "exit 1"},
+			expectedCode: proto.StatusCode_TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR,
+		},
+		{
+			description:  "timeout",
+			test:         latest.CustomTest{Command: "sleep 10", TimeoutSeconds: 1},
+			expectedCode: proto.StatusCode_TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := New(test.test, "", nil).Test(context.Background(), ioutil.Discard, "image:tag")
+
+			if test.expectedCode == proto.StatusCode_OK {
+				t.CheckNoError(err)
+				return
+			}
+			t.CheckDeepEqual(test.expectedCode, err.(sErrors.Error).StatusCode())
+		})
+	}
+}
+
+func TestTestDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		deps        *latest.CustomTestDependencies
+		command     util.Command
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "no dependencies",
+		},
+		{
+			description: "paths",
+			deps:        &latest.CustomTestDependencies{Paths: []string{"test"}, Ignore: []string{"test/ignored.go"}},
+			expected:    []string{"test/main_test.go", "test/util_test.go"},
+		},
+		{
+			description: "paths not found",
+			deps:        &latest.CustomTestDependencies{Paths: []string{"missing"}},
+			shouldErr:   true,
+		},
+		{
+			description: "command",
+			deps:        &latest.CustomTestDependencies{Command: "list deps"},
+			command:     testutil.CmdRunOut("list deps", `["test/main_test.go", "other.go"]`),
+			expected:    []string{"test/main_test.go", "other.go"},
+		},
+		{
+			description: "command with invalid output",
+			deps:        &latest.CustomTestDependencies{Command: "list deps"},
+			command:     testutil.CmdRunOut("list deps", "test/main_test.go"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("test/main_test.go", "test/util_test.go", "test/ignored.go")
+			if test.command != nil {
+				t.Override(&util.DefaultExecCommand, test.command)
+			}
+
+			runner := New(latest.CustomTest{Command: "go test", Dependencies: test.deps}, tmpDir.Root(), nil)
+			deps, err := runner.TestDependencies()
+
+			var expected []string
+			for _, p := range test.expected {
+				expected = append(expected, tmpDir.Path(p))
+			}
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, expected, deps)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
@@ -28,12 +29,17 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
+// structureTestName is the name under which container-structure-test runs are reported.
+const structureTestName = "container-structure-test"
+
 type Config interface {
 	docker.Config
 
@@ -60,17 +66,28 @@ func NewTester(cfg Config, imagesAreLocal bool) Tester {
 	}
 }
 
-// TestDependencies returns the watch dependencies to the runner.
-func (t FullTester) TestDependencies() ([]string, error) {
+// TestDependencies returns the watch dependencies of the tests for the given artifact.
+func (t FullTester) TestDependencies(artifact *latest.Artifact) ([]string, error) {
 	var deps []string
 
 	for _, test := range t.testCases {
+		if test.ImageName != artifact.ImageName {
+			continue
+		}
+
 		files, err := util.ExpandPathsGlob(t.workingDir, test.StructureTests)
 		if err != nil {
-			return nil, fmt.Errorf("expanding test file paths: %w", err)
+			return nil, expandingFilePathsErr(err)
 		}
-
 		deps = append(deps, files...)
+
+		for _, ct := range test.CustomTests {
+			files, err := custom.New(ct, t.testWorkspace(test), nil).TestDependencies()
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, files...)
+		}
 	}
 
 	return deps, nil
@@ -112,24 +129,44 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifa
 }
 
 func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
-	for _, test := range t.testCases {
-		if err := t.runStructureTests(ctx, out, bRes, test); err != nil {
+	for _, tc := range t.testCases {
+		fqn, found := resolveArtifactImageTag(tc.ImageName, bRes)
+		if !found {
+			logrus.Debugln("Skipping tests for", tc.ImageName, "since it wasn't built")
+			continue
+		}
+
+		if err := t.runStructureTests(ctx, out, fqn, tc); err != nil {
 			return fmt.Errorf("running structure tests: %w", err)
 		}
+
+		for _, ct := range tc.CustomTests {
+			runner := custom.New(ct, t.testWorkspace(tc), t.localDaemon.ExtraEnv())
+			if err := runTest(ctx, out, tc.ImageName, ct.Command, fqn, runner); err != nil {
+				return fmt.Errorf("running custom test %q: %w", ct.Command, err)
+			}
+		}
 	}
 
 	return nil
 }
 
-func (t FullTester) runStructureTests(ctx context.Context, out io.Writer, bRes []build.Artifact, tc *latest.TestCase) error {
+// testWorkspace returns the directory that the custom tests of a test case run from.
+func (t FullTester) testWorkspace(tc *latest.TestCase) string {
+	if filepath.IsAbs(tc.Workspace) {
+		return tc.Workspace
+	}
+	return filepath.Join(t.workingDir, tc.Workspace)
+}
+
+func (t FullTester) runStructureTests(ctx context.Context, out io.Writer, fqn string, tc *latest.TestCase) error {
 	if len(tc.StructureTests) == 0 {
 		return nil
 	}
 
-	fqn, found := resolveArtifactImageTag(tc.ImageName, bRes)
-	if !found {
-		logrus.Debugln("Skipping tests for", tc.ImageName, "since it wasn't built")
-		return nil
+	files, err := util.ExpandPathsGlob(t.workingDir, tc.StructureTests)
+	if err != nil {
+		return expandingFilePathsErr(err)
 	}
 
 	if !t.imagesAreLocal {
@@ -141,14 +178,22 @@ func (t FullTester) runStructureTests(ctx context.Context, out io.Writer, bRes [
 		}
 	}
 
-	files, err := util.ExpandPathsGlob(t.workingDir, tc.StructureTests)
-	if err != nil {
-		return fmt.Errorf("expanding test file paths: %w", err)
-	}
-
 	runner := structure.NewRunner(files, t.localDaemon.ExtraEnv())
 
-	return runner.Test(ctx, out, fqn)
+	return runTest(ctx, out, tc.ImageName, structureTestName, fqn, runner)
+}
+
+// runTest runs a single test on an image and reports its progress as events.
+func runTest(ctx context.Context, out io.Writer, imageName, testName, fqn string, runner Runner) error {
+	event.TestInProgress(imageName, testName)
+
+	if err := runner.Test(ctx, out, fqn); err != nil {
+		event.TestFailed(imageName, testName, err)
+		return err
+	}
+
+	event.TestSucceeded(imageName, testName)
+	return nil
 }
 
 func resolveArtifactImageTag(imageName string, bRes []build.Artifact) (string, bool) {
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/docker/docker/client"
@@ -41,7 +42,7 @@ func TestNoTestDependencies(t *testing.T) {
 		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) { return nil, nil })
 
 		cfg := &mockConfig{}
-		deps, err := NewTester(cfg, true).TestDependencies()
+		deps, err := NewTester(cfg, true).TestDependencies(&latest.Artifact{ImageName: "image"})
 
 		t.CheckNoError(err)
 		t.CheckEmpty(deps)
@@ -50,19 +51,28 @@ func TestNoTestDependencies(t *testing.T) {
 
 func TestTestDependencies(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
-		tmpDir := t.NewTempDir().Touch("tests/test1.yaml", "tests/test2.yaml", "test3.yaml")
+		tmpDir := t.NewTempDir().Touch("tests/test1.yaml", "tests/test2.yaml", "test3.yaml", "custom/main_test.go", "backend/src/main_test.go", "other.yaml")
 
 		cfg := &mockConfig{
 			workingDir: tmpDir.Root(),
 			tests: []*latest.TestCase{
-				{StructureTests: []string{"./tests/*"}},
-				{},
-				{StructureTests: []string{"test3.yaml"}},
+				{ImageName: "image", StructureTests: []string{"./tests/*"}},
+				{ImageName: "image"},
+				{ImageName: "image", StructureTests: []string{"test3.yaml"}},
+				{ImageName: "image", CustomTests: []latest.CustomTest{{
+					Command:      "go test ./custom",
+					Dependencies: &latest.CustomTestDependencies{Paths: []string{"custom"}},
+				}}},
+				{ImageName: "image", Workspace: "backend", CustomTests: []latest.CustomTest{{
+					Command:      "go test ./src",
+					Dependencies: &latest.CustomTestDependencies{Paths: []string{"src"}},
+				}}},
+				{ImageName: "other", StructureTests: []string{"other.yaml"}},
 			},
 		}
-		deps, err := NewTester(cfg, true).TestDependencies()
+		deps, err := NewTester(cfg, true).TestDependencies(&latest.Artifact{ImageName: "image"})
 
-		expectedDeps := tmpDir.Paths("tests/test1.yaml", "tests/test2.yaml", "test3.yaml")
+		expectedDeps := tmpDir.Paths("tests/test1.yaml", "tests/test2.yaml", "test3.yaml", "custom/main_test.go", "backend/src/main_test.go")
 		t.CheckNoError(err)
 		t.CheckDeepEqual(expectedDeps, deps)
 	})
@@ -79,7 +89,7 @@ func TestWrongPattern(t *testing.T) {
 
 		tester := NewTester(cfg, true)
 
-		_, err := tester.TestDependencies()
+		_, err := tester.TestDependencies(&latest.Artifact{ImageName: "image"})
 		t.CheckError(true, err)
 
 		err = tester.Test(context.Background(), ioutil.Discard, []build.Artifact{{
@@ -227,6 +237,57 @@ func TestTestFailure(t *testing.T) {
 	})
 }
 
+func TestCustomTest(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("test.yaml")
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("container-structure-test test -v warn --image image:tag --config "+tmpDir.Path("test.yaml")).
+			AndRun(customTestCommand("go test ./...")))
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+		})
+
+		cfg := &mockConfig{
+			workingDir: tmpDir.Root(),
+			tests: []*latest.TestCase{{
+				ImageName:      "image",
+				StructureTests: []string{"test.yaml"},
+				CustomTests:    []latest.CustomTest{{Command: "go test ./..."}},
+			}},
+		}
+
+		err := NewTester(cfg, true).Test(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestCustomTestFailure(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunErr(customTestCommand("go test ./..."), errors.New("FAIL")))
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+		})
+
+		cfg := &mockConfig{
+			tests: []*latest.TestCase{{
+				ImageName:   "image",
+				CustomTests: []latest.CustomTest{{Command: "go test ./..."}},
+			}},
+		}
+
+		err := NewTester(cfg, true).Test(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+
+		t.CheckErrorContains(`running custom test "go test ./..."`, err)
+	})
+}
+
 func TestTestMuted(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Touch("test.yaml")
@@ -254,6 +315,13 @@ func TestTestMuted(t *testing.T) {
 	})
 }
 
+func customTestCommand(command string) string {
+	if runtime.GOOS == "windows" {
+		return "cmd.exe /C " + command
+	}
+	return "sh -c " + command
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+const (
+	tracesPath    = "/v1/traces"
+	exportTimeout = 10 * time.Second
+
+	// OTLP span kind and status codes.
+	spanKindInternal = 1
+	statusCodeError  = 2
+)
+
+var errExporterClosed = errors.New("trace exporter closed")
+
+// for tests
+var randRead = rand.Read
+
+// Initialize starts exporting each dev iteration as a trace to an OTLP/HTTP endpoint.
+// It returns a shutdown callback that exports the pending spans,
+// which the runner is responsible for calling.
+func Initialize(opts config.SkaffoldOptions) (func() error, error) {
+	if opts.OTLPEndpoint == "" {
+		return func() error { return nil }, nil
+	}
+
+	url, err := tracesURL(opts.OTLPEndpoint)
+	if err != nil {
+		return func() error { return nil }, err
+	}
+
+	e := newExporter(url, opts.Command)
+	go e.run()
+	go event.ForEachEvent(e.handle)
+
+	return e.shutdown, nil
+}
+
+// tracesURL returns the url where traces are posted for a given OTLP endpoint.
+func tracesURL(endpoint string) (string, error) {
+	url := strings.TrimSuffix(endpoint, "/")
+	switch {
+	case url == "":
+		return "", fmt.Errorf("invalid OTLP endpoint %q", endpoint)
+	case !strings.Contains(url, "://"):
+		url = "http://" + url
+	case !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://"):
+		return "", fmt.Errorf("invalid OTLP endpoint %q: only http and https are supported", endpoint)
+	}
+	if !strings.HasSuffix(url, tracesPath) {
+		url += tracesPath
+	}
+	return url, nil
+}
+
+type exporter struct {
+	url     string
+	command string
+	client  *http.Client
+
+	lock     sync.Mutex
+	timeline *Timeline
+	closed   bool
+	roots    chan *Span
+	done     chan struct{}
+}
+
+func newExporter(url, command string) *exporter {
+	return &exporter{
+		url:      url,
+		command:  command,
+		client:   &http.Client{Timeout: exportTimeout},
+		timeline: NewTimeline(),
+		roots:    make(chan *Span, 100),
+		done:     make(chan struct{}),
+	}
+}
+
+// handle is called synchronously by the event handler, so it must not block.
+func (e *exporter) handle(entry *proto.LogEntry) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.closed {
+		return errExporterClosed
+	}
+
+	if root := e.timeline.Add(entry); root != nil {
+		select {
+		case e.roots <- root:
+		default:
+			logrus.Debugf("dropping trace for %s: too many pending exports", root.Name)
+		}
+	}
+	return nil
+}
+
+func (e *exporter) run() {
+	defer close(e.done)
+
+	for root := range e.roots {
+		if err := e.export(context.Background(), root); err != nil {
+			logrus.Warnf("exporting trace to %s: %s", e.url, err)
+		}
+	}
+}
+
+func (e *exporter) shutdown() error {
+	e.lock.Lock()
+	if e.closed {
+		e.lock.Unlock()
+		return nil
+	}
+	e.closed = true
+	if root := e.timeline.Flush(); root != nil {
+		select {
+		case e.roots <- root:
+		default:
+		}
+	}
+	close(e.roots)
+	e.lock.Unlock()
+
+	select {
+	case <-e.done:
+		return nil
+	case <-time.After(exportTimeout):
+		return fmt.Errorf("timed out exporting traces to %s", e.url)
+	}
+}
+
+func (e *exporter) export(ctx context.Context, root *Span) error {
+	root.Attributes["skaffold.command"] = e.command
+
+	body, err := json.Marshal(e.request(root))
+	if err != nil {
+		return fmt.Errorf("marshalling spans: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+// request builds the OTLP/HTTP JSON payload for a tree of spans.
+func (e *exporter) request(root *Span) otlpRequest {
+	traceID := randomID(16)
+
+	var spans []otlpSpan
+	var add func(s *Span, parentID string)
+	add = func(s *Span, parentID string) {
+		spanID := randomID(8)
+		span := otlpSpan{
+			TraceID:           traceID,
+			SpanID:            spanID,
+			ParentSpanID:      parentID,
+			Name:              s.Name,
+			Kind:              spanKindInternal,
+			StartTimeUnixNano: fmt.Sprint(s.Start.UnixNano()),
+			EndTimeUnixNano:   fmt.Sprint(s.End.UnixNano()),
+			Attributes:        attributes(s.Attributes),
+		}
+		if s.Failed {
+			span.Status = &otlpStatus{Code: statusCodeError, Message: s.Message}
+		}
+		spans = append(spans, span)
+
+		for _, child := range s.Children {
+			add(child, spanID)
+		}
+	}
+	add(root, "")
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: attributes(map[string]string{
+					"service.name":    "skaffold",
+					"service.version": version.Get().Version,
+				}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "skaffold"},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+func attributes(m map[string]string) []otlpKeyValue {
+	var kvs []otlpKeyValue
+	for _, k := range sortedKeys(m) {
+		kvs = append(kvs, otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: m[k]}})
+	}
+	return kvs
+}
+
+func randomID(size int) string {
+	b := make([]byte, size)
+	if _, err := randRead(b); err != nil {
+		// the IDs only need to be unique within the exported traces
+		logrus.Debugf("Cannot read random trace ID, falling back to pseudo-random: %v", err)
+		mathrand.Read(b)
+	}
+	return hex.EncodeToString(b)
+}
+
+// The following types mirror the JSON encoding of the OTLP trace service request.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
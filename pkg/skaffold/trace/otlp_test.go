@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTracesURL(t *testing.T) {
+	tests := []struct {
+		description string
+		endpoint    string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "host and port",
+			endpoint:    "localhost:4318",
+			expected:    "http://localhost:4318/v1/traces",
+		},
+		{
+			description: "https url",
+			endpoint:    "https://collector.example.com/",
+			expected:    "https://collector.example.com/v1/traces",
+		},
+		{
+			description: "full url",
+			endpoint:    "http://localhost:4318/v1/traces",
+			expected:    "http://localhost:4318/v1/traces",
+		},
+		{
+			description: "unsupported scheme",
+			endpoint:    "grpc://localhost:4317",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			url, err := tracesURL(test.endpoint)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, url)
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var received otlpRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.CheckDeepEqual("/v1/traces", r.URL.Path)
+			t.CheckDeepEqual("application/json", r.Header.Get("Content-Type"))
+			t.CheckNoError(json.NewDecoder(r.Body).Decode(&received))
+		}))
+		defer server.Close()
+
+		e := newExporter(server.URL+"/v1/traces", "dev")
+		err := e.export(context.Background(), &Span{
+			Name:       "dev iteration 0",
+			Start:      start,
+			End:        start.Add(2 * time.Second),
+			Failed:     true,
+			Attributes: map[string]string{"skaffold.iteration": "0"},
+			Children: []*Span{{
+				Name:       "build img",
+				Start:      start,
+				End:        start.Add(time.Second),
+				Failed:     true,
+				Message:    "build failed",
+				Attributes: map[string]string{"skaffold.artifact": "img"},
+			}},
+		})
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual(1, len(received.ResourceSpans))
+		spans := received.ResourceSpans[0].ScopeSpans[0].Spans
+		t.CheckDeepEqual(2, len(spans))
+
+		root, child := spans[0], spans[1]
+		t.CheckDeepEqual("dev iteration 0", root.Name)
+		t.CheckDeepEqual("", root.ParentSpanID)
+		t.CheckDeepEqual(32, len(root.TraceID))
+		t.CheckDeepEqual(16, len(root.SpanID))
+		t.CheckDeepEqual([]otlpKeyValue{
+			{Key: "skaffold.command", Value: otlpAnyValue{StringValue: "dev"}},
+			{Key: "skaffold.iteration", Value: otlpAnyValue{StringValue: "0"}},
+		}, root.Attributes)
+		t.CheckDeepEqual("1606816800000000000", root.StartTimeUnixNano)
+		t.CheckDeepEqual("1606816802000000000", root.EndTimeUnixNano)
+
+		t.CheckDeepEqual("build img", child.Name)
+		t.CheckDeepEqual(root.TraceID, child.TraceID)
+		t.CheckDeepEqual(root.SpanID, child.ParentSpanID)
+		t.CheckDeepEqual(&otlpStatus{Code: statusCodeError, Message: "build failed"}, child.Status)
+	})
+}
+
+func TestExportError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		e := newExporter(server.URL+"/v1/traces", "dev")
+		err := e.export(context.Background(), &Span{Name: "dev iteration 0", Attributes: map[string]string{}})
+
+		t.CheckErrorContains("503", err)
+	})
+}
+
+func TestExporterShutdown(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		names := make(chan string, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req otlpRequest
+			t.CheckNoError(json.NewDecoder(r.Body).Decode(&req))
+			names <- req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name
+		}))
+		defer server.Close()
+
+		e := newExporter(server.URL+"/v1/traces", "build")
+		go e.run()
+
+		t.CheckNoError(e.handle(at(0, build("img", "In Progress"))))
+		t.CheckNoError(e.handle(at(1, build("img", "Complete"))))
+		t.CheckNoError(e.shutdown())
+
+		t.CheckDeepEqual("skaffold", <-names)
+		t.CheckErrorContains("closed", e.handle(at(2, &proto.Event{})))
+	})
+}
+
+func TestRandomIDFallback(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&randRead, func([]byte) (int, error) { return 0, errors.New("no entropy") })
+
+		first := randomID(8)
+		second := randomID(8)
+
+		t.CheckDeepEqual(16, len(first))
+		t.CheckFalse(first == second)
+	})
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// Span is a timed phase of a Skaffold run, reconstructed from the event log.
+type Span struct {
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Failed     bool
+	Message    string
+	Attributes map[string]string
+	Children   []*Span
+}
+
+// Duration returns the time spent in the span.
+func (s *Span) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// Timeline turns a stream of events into a tree of spans.
+// Each dev iteration is a root span; build, test, sync, deploy
+// and status check phases are its children.
+type Timeline struct {
+	root     *Span
+	open     map[string]*Span
+	build    map[string]string
+	deploy   map[string]string
+	lastSeen time.Time
+}
+
+// NewTimeline creates an empty Timeline.
+func NewTimeline() *Timeline {
+	return &Timeline{
+		open:   map[string]*Span{},
+		build:  map[string]string{},
+		deploy: map[string]string{},
+	}
+}
+
+// Add records an event. It returns the root span when the event completes a dev iteration.
+func (t *Timeline) Add(entry *proto.LogEntry) *Span {
+	ts, err := ptypes.Timestamp(entry.GetTimestamp())
+	if err != nil {
+		ts = t.lastSeen
+	}
+	t.lastSeen = ts
+
+	switch e := entry.GetEvent().GetEventType().(type) {
+	case *proto.Event_MetaEvent:
+		t.addMetadata(e.MetaEvent.GetMetadata())
+	case *proto.Event_DevLoopEvent:
+		de := e.DevLoopEvent
+		switch de.Status {
+		case event.InProgress:
+			// Events seen outside of a dev iteration belong to the next one.
+			if t.root == nil {
+				t.startRoot(ts)
+			}
+			t.root.Name = fmt.Sprintf("dev iteration %d", de.Iteration)
+			t.root.Attributes["skaffold.iteration"] = fmt.Sprint(de.Iteration)
+		case event.Succeeded, event.Failed:
+			return t.endRoot(ts, de.Status == event.Failed, de.GetErr().GetMessage())
+		}
+	case *proto.Event_BuildEvent:
+		be := e.BuildEvent
+		key := "build/" + be.Artifact
+		switch be.Status {
+		case event.InProgress:
+			t.start(key, "build "+be.Artifact, ts, merge(t.build, map[string]string{"skaffold.artifact": be.Artifact}))
+		case event.Complete:
+			t.end(key, ts, false, "")
+		case event.Failed:
+			t.end(key, ts, true, be.GetActionableErr().GetMessage())
+		case event.Canceled:
+			t.end(key, ts, true, "canceled")
+		}
+	case *proto.Event_TestEvent:
+		te := e.TestEvent
+		key := "test/" + te.Artifact + "/" + te.Name
+		switch te.Status {
+		case event.InProgress:
+			t.start(key, "test "+te.Artifact, ts, map[string]string{"skaffold.artifact": te.Artifact, "skaffold.test": te.Name})
+		case event.Succeeded:
+			t.end(key, ts, false, "")
+		case event.Failed:
+			t.end(key, ts, true, te.GetActionableErr().GetMessage())
+		}
+	case *proto.Event_FileSyncEvent:
+		fse := e.FileSyncEvent
+		key := "sync/" + fse.Image
+		switch fse.Status {
+		case event.InProgress:
+			if ps := fse.PodSync; ps != nil {
+				t.addPodSync(key, ts, ps)
+				break
+			}
+			t.start(key, "sync "+fse.Image, ts, map[string]string{"skaffold.image": fse.Image, "skaffold.sync.files": fmt.Sprint(fse.FileCount)})
+		case event.Succeeded:
+			t.end(key, ts, false, "")
+		case event.Failed:
+			t.end(key, ts, true, fse.GetActionableErr().GetMessage())
+		}
+	case *proto.Event_DeployEvent:
+		de := e.DeployEvent
+		switch de.Status {
+		case event.InProgress:
+			t.start("deploy", "deploy", ts, merge(t.deploy))
+		case event.Complete:
+			t.end("deploy", ts, false, "")
+		case event.Failed:
+			t.end("deploy", ts, true, de.GetActionableErr().GetMessage())
+		}
+	case *proto.Event_StatusCheckEvent:
+		se := e.StatusCheckEvent
+		switch se.Status {
+		case event.Started:
+			t.start("status-check", "status check", ts, nil)
+		case event.Succeeded:
+			t.end("status-check", ts, false, "")
+		case event.Failed:
+			t.end("status-check", ts, true, se.GetActionableErr().GetMessage())
+		}
+	case *proto.Event_ResourceStatusCheckEvent:
+		rse := e.ResourceStatusCheckEvent
+		t.addResourceStatus(ts, rse)
+	}
+
+	return nil
+}
+
+// Flush closes the spans that are still open and returns the pending root span, if any.
+func (t *Timeline) Flush() *Span {
+	if t.root == nil || (len(t.root.Children) == 0 && len(t.open) == 0) {
+		t.root = nil
+		return nil
+	}
+	return t.endRoot(t.lastSeen, false, "")
+}
+
+func (t *Timeline) startRoot(ts time.Time) {
+	t.open = map[string]*Span{}
+	t.root = &Span{
+		Name:       "skaffold",
+		Start:      ts,
+		Attributes: merge(t.build, t.deploy),
+	}
+}
+
+func (t *Timeline) endRoot(ts time.Time, failed bool, message string) *Span {
+	if t.root == nil {
+		return nil
+	}
+	for _, s := range t.open {
+		s.End = ts
+	}
+	root := t.root
+	root.End = ts
+	root.Failed = failed || root.Failed
+	root.Message = message
+	t.root = nil
+	t.open = map[string]*Span{}
+	return root
+}
+
+func (t *Timeline) start(key, name string, ts time.Time, attributes map[string]string) *Span {
+	if t.root == nil {
+		t.startRoot(ts)
+	}
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+	s := &Span{Name: name, Start: ts, Attributes: attributes}
+	if prev, found := t.open[key]; found {
+		prev.End = ts
+	}
+	t.open[key] = s
+	t.root.Children = append(t.root.Children, s)
+	return s
+}
+
+func (t *Timeline) end(key string, ts time.Time, failed bool, message string) {
+	s, found := t.open[key]
+	if !found {
+		return
+	}
+	delete(t.open, key)
+	s.End = ts
+	s.Failed = failed
+	s.Message = message
+	if failed {
+		t.root.Failed = true
+	}
+}
+
+// addPodSync records the copy of files to a single container as a child of the sync span.
+func (t *Timeline) addPodSync(key string, ts time.Time, ps *proto.PodSync) {
+	parent, found := t.open[key]
+	if !found {
+		return
+	}
+	parent.Children = append(parent.Children, &Span{
+		Name:  fmt.Sprintf("sync pod/%s:%s", ps.PodName, ps.ContainerName),
+		Start: ts.Add(-time.Duration(ps.DurationMs) * time.Millisecond),
+		End:   ts,
+		Attributes: map[string]string{
+			"k8s.namespace.name": ps.Namespace,
+			"k8s.pod.name":       ps.PodName,
+			"k8s.container.name": ps.ContainerName,
+		},
+	})
+}
+
+// addResourceStatus records the rollout of a resource as a child of the status check span.
+func (t *Timeline) addResourceStatus(ts time.Time, rse *proto.ResourceStatusCheckEvent) {
+	parent, found := t.open["status-check"]
+	if !found {
+		return
+	}
+	key := "status-check/" + rse.Resource
+	switch rse.Status {
+	case event.InProgress:
+		if _, found := t.open[key]; found {
+			return
+		}
+		s := &Span{Name: rse.Resource, Start: ts, Attributes: map[string]string{"skaffold.resource": rse.Resource}}
+		t.open[key] = s
+		parent.Children = append(parent.Children, s)
+	case event.Succeeded, event.Failed:
+		s, found := t.open[key]
+		if !found {
+			s = &Span{Name: rse.Resource, Start: ts, Attributes: map[string]string{"skaffold.resource": rse.Resource}}
+			parent.Children = append(parent.Children, s)
+		}
+		delete(t.open, key)
+		s.End = ts
+		if rse.Status == event.Failed {
+			s.Failed = true
+			s.Message = rse.GetActionableErr().GetMessage()
+		}
+	}
+}
+
+func (t *Timeline) addMetadata(m *proto.Metadata) {
+	if m == nil {
+		return
+	}
+	if b := m.Build; b != nil {
+		t.build["skaffold.build.artifacts"] = fmt.Sprint(b.NumberOfArtifacts)
+		t.build["skaffold.build.type"] = b.Type.String()
+		var builders []string
+		for _, ib := range b.Builders {
+			builders = append(builders, fmt.Sprintf("%s:%d", ib.Type, ib.Count))
+		}
+		t.build["skaffold.build.builders"] = strings.Join(builders, ",")
+		for k, v := range b.Additional {
+			t.build["skaffold.build."+k] = v
+		}
+	}
+	if d := m.Deploy; d != nil {
+		t.deploy["skaffold.deploy.cluster"] = d.Cluster.String()
+		var deployers []string
+		for _, dd := range d.Deployers {
+			deployers = append(deployers, fmt.Sprintf("%s:%d", dd.Type, dd.Count))
+		}
+		t.deploy["skaffold.deploy.deployers"] = strings.Join(deployers, ",")
+	}
+	if t.root != nil {
+		for k, v := range merge(t.build, t.deploy) {
+			t.root.Attributes[k] = v
+		}
+	}
+}
+
+func merge(maps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// sortedKeys returns the keys of a map in a stable order.
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
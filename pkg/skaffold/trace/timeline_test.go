@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var start = time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+
+func at(seconds int, e *proto.Event) *proto.LogEntry {
+	ts, _ := ptypes.TimestampProto(start.Add(time.Duration(seconds) * time.Second))
+	return &proto.LogEntry{Timestamp: ts, Event: e}
+}
+
+func devLoop(iteration int32, status string) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_DevLoopEvent{DevLoopEvent: &proto.DevLoopEvent{Iteration: iteration, Status: status}}}
+}
+
+func build(artifact, status string) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_BuildEvent{BuildEvent: &proto.BuildEvent{Artifact: artifact, Status: status}}}
+}
+
+func deploy(status string, err string) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_DeployEvent{DeployEvent: &proto.DeployEvent{Status: status, ActionableErr: &proto.ActionableErr{Message: err}}}}
+}
+
+func statusCheck(status string) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_StatusCheckEvent{StatusCheckEvent: &proto.StatusCheckEvent{Status: status}}}
+}
+
+func resourceStatusCheck(resource, status string) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_ResourceStatusCheckEvent{ResourceStatusCheckEvent: &proto.ResourceStatusCheckEvent{Resource: resource, Status: status}}}
+}
+
+func fileSync(image, status string, podSync *proto.PodSync) *proto.Event {
+	return &proto.Event{EventType: &proto.Event_FileSyncEvent{FileSyncEvent: &proto.FileSyncEvent{Image: image, FileCount: 2, Status: status, PodSync: podSync}}}
+}
+
+func metadata() *proto.Event {
+	return &proto.Event{EventType: &proto.Event_MetaEvent{MetaEvent: &proto.MetaEvent{Metadata: &proto.Metadata{
+		Build: &proto.BuildMetadata{
+			NumberOfArtifacts: 1,
+			Type:              proto.BuildType_LOCAL,
+			Builders:          []*proto.BuildMetadata_ImageBuilder{{Type: proto.BuilderType_DOCKER, Count: 1}},
+		},
+		Deploy: &proto.DeployMetadata{
+			Cluster:   proto.ClusterType_MINIKUBE,
+			Deployers: []*proto.DeployMetadata_Deployer{{Type: proto.DeployerType_KUBECTL, Count: 1}},
+		},
+	}}}}
+}
+
+func TestTimeline(t *testing.T) {
+	tests := []struct {
+		description string
+		entries     []*proto.LogEntry
+		expected    []*Span
+		flushed     *Span
+	}{
+		{
+			description: "dev iteration",
+			entries: []*proto.LogEntry{
+				at(0, metadata()),
+				at(0, devLoop(0, "In Progress")),
+				at(1, build("img", "In Progress")),
+				at(4, build("img", "Complete")),
+				at(5, deploy("In Progress", "")),
+				at(6, deploy("Complete", "")),
+				at(6, statusCheck("Started")),
+				at(6, resourceStatusCheck("deployment/app", "In Progress")),
+				at(8, resourceStatusCheck("deployment/app", "Succeeded")),
+				at(8, statusCheck("Succeeded")),
+				at(9, devLoop(0, "Succeeded")),
+			},
+			expected: []*Span{{
+				Name:  "dev iteration 0",
+				Start: start,
+				End:   start.Add(9 * time.Second),
+				Attributes: map[string]string{
+					"skaffold.iteration":        "0",
+					"skaffold.build.artifacts":  "1",
+					"skaffold.build.type":       "LOCAL",
+					"skaffold.build.builders":   "DOCKER:1",
+					"skaffold.deploy.cluster":   "MINIKUBE",
+					"skaffold.deploy.deployers": "KUBECTL:1",
+				},
+				Children: []*Span{
+					{
+						Name:  "build img",
+						Start: start.Add(1 * time.Second),
+						End:   start.Add(4 * time.Second),
+						Attributes: map[string]string{
+							"skaffold.artifact":        "img",
+							"skaffold.build.artifacts": "1",
+							"skaffold.build.type":      "LOCAL",
+							"skaffold.build.builders":  "DOCKER:1",
+						},
+					},
+					{
+						Name:  "deploy",
+						Start: start.Add(5 * time.Second),
+						End:   start.Add(6 * time.Second),
+						Attributes: map[string]string{
+							"skaffold.deploy.cluster":   "MINIKUBE",
+							"skaffold.deploy.deployers": "KUBECTL:1",
+						},
+					},
+					{
+						Name:       "status check",
+						Start:      start.Add(6 * time.Second),
+						End:        start.Add(8 * time.Second),
+						Attributes: map[string]string{},
+						Children: []*Span{{
+							Name:       "deployment/app",
+							Start:      start.Add(6 * time.Second),
+							End:        start.Add(8 * time.Second),
+							Attributes: map[string]string{"skaffold.resource": "deployment/app"},
+						}},
+					},
+				},
+			}},
+		},
+		{
+			description: "failed deploy marks the iteration as failed",
+			entries: []*proto.LogEntry{
+				at(0, devLoop(1, "In Progress")),
+				at(1, deploy("In Progress", "")),
+				at(2, deploy("Failed", "kubectl apply failed")),
+				at(3, devLoop(1, "Failed")),
+			},
+			expected: []*Span{{
+				Name:       "dev iteration 1",
+				Start:      start,
+				End:        start.Add(3 * time.Second),
+				Failed:     true,
+				Attributes: map[string]string{"skaffold.iteration": "1"},
+				Children: []*Span{{
+					Name:       "deploy",
+					Start:      start.Add(1 * time.Second),
+					End:        start.Add(2 * time.Second),
+					Failed:     true,
+					Message:    "kubectl apply failed",
+					Attributes: map[string]string{},
+				}},
+			}},
+		},
+		{
+			description: "file sync with pod syncs",
+			entries: []*proto.LogEntry{
+				at(0, devLoop(2, "In Progress")),
+				at(0, fileSync("img", "In Progress", nil)),
+				at(2, fileSync("img", "In Progress", &proto.PodSync{PodName: "pod", ContainerName: "app", Namespace: "ns", DurationMs: 1500})),
+				at(2, fileSync("img", "Succeeded", nil)),
+				at(2, devLoop(2, "Succeeded")),
+			},
+			expected: []*Span{{
+				Name:       "dev iteration 2",
+				Start:      start,
+				End:        start.Add(2 * time.Second),
+				Attributes: map[string]string{"skaffold.iteration": "2"},
+				Children: []*Span{{
+					Name:       "sync img",
+					Start:      start,
+					End:        start.Add(2 * time.Second),
+					Attributes: map[string]string{"skaffold.image": "img", "skaffold.sync.files": "2"},
+					Children: []*Span{{
+						Name:  "sync pod/pod:app",
+						Start: start.Add(500 * time.Millisecond),
+						End:   start.Add(2 * time.Second),
+						Attributes: map[string]string{
+							"k8s.namespace.name": "ns",
+							"k8s.pod.name":       "pod",
+							"k8s.container.name": "app",
+						},
+					}},
+				}},
+			}},
+		},
+		{
+			description: "events outside of a dev iteration are flushed",
+			entries: []*proto.LogEntry{
+				at(0, build("img", "In Progress")),
+				at(3, build("img", "Canceled")),
+				at(4, deploy("In Progress", "")),
+			},
+			flushed: &Span{
+				Name:       "skaffold",
+				Start:      start,
+				End:        start.Add(4 * time.Second),
+				Failed:     true,
+				Attributes: map[string]string{},
+				Children: []*Span{
+					{
+						Name:       "build img",
+						Start:      start,
+						End:        start.Add(3 * time.Second),
+						Failed:     true,
+						Message:    "canceled",
+						Attributes: map[string]string{"skaffold.artifact": "img"},
+					},
+					{
+						Name:       "deploy",
+						Start:      start.Add(4 * time.Second),
+						End:        start.Add(4 * time.Second),
+						Attributes: map[string]string{},
+					},
+				},
+			},
+		},
+		{
+			description: "no events",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			timeline := NewTimeline()
+
+			var roots []*Span
+			for _, entry := range test.entries {
+				if root := timeline.Add(entry); root != nil {
+					roots = append(roots, root)
+				}
+			}
+
+			t.CheckDeepEqual(test.expected, roots)
+			t.CheckDeepEqual(test.flushed, timeline.Flush())
+		})
+	}
+}
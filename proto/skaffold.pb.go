@@ -45,6 +45,8 @@ const (
 	BuilderType_KANIKO BuilderType = 5
 	// Docker Builder
 	BuilderType_DOCKER BuilderType = 6
+	// Ko Builder
+	BuilderType_KO BuilderType = 7
 )
 
 var BuilderType_name = map[int32]string{
@@ -55,6 +57,7 @@ var BuilderType_name = map[int32]string{
 	4: "CUSTOM",
 	5: "KANIKO",
 	6: "DOCKER",
+	7: "KO",
 }
 
 var BuilderType_value = map[string]int32{
@@ -65,6 +68,7 @@ var BuilderType_value = map[string]int32{
 	"CUSTOM":               4,
 	"KANIKO":               5,
 	"DOCKER":               6,
+	"KO":                   7,
 }
 
 func (x BuilderType) String() string {
@@ -249,6 +253,8 @@ const (
 	StatusCode_INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME StatusCode = 123
 	// Docker build error indicating the container referenced does not exists in the docker context used.
 	StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST StatusCode = 124
+	// Images built for several platforms need to be pushed
+	StatusCode_BUILD_MULTI_PLATFORM_NO_PUSH StatusCode = 125
 	// Container image pull error
 	StatusCode_STATUSCHECK_IMAGE_PULL_ERR StatusCode = 300
 	// Container creating error
@@ -259,6 +265,20 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// StatefulSet waiting for rollout
+	StatusCode_STATUSCHECK_STATEFULSET_ROLLOUT_PENDING StatusCode = 305
+	// DaemonSet waiting for rollout
+	StatusCode_STATUSCHECK_DAEMONSET_ROLLOUT_PENDING StatusCode = 306
+	// Job waiting for completion
+	StatusCode_STATUSCHECK_JOB_PENDING StatusCode = 307
+	// Job failed
+	StatusCode_STATUSCHECK_JOB_FAILED StatusCode = 308
+	// Standalone pod waiting to be ready
+	StatusCode_STATUSCHECK_POD_PENDING StatusCode = 309
+	// Standalone pod failed
+	StatusCode_STATUSCHECK_POD_FAILED StatusCode = 310
+	// Custom resource waiting for its readiness condition
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING StatusCode = 311
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -286,6 +306,16 @@ const (
 	// Kubectl client fetch err
 	StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR StatusCode = 411
 	StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR     StatusCode = 412
+	// Error fetching statefulsets
+	StatusCode_STATUSCHECK_STATEFULSET_FETCH_ERR StatusCode = 413
+	// Error fetching daemonsets
+	StatusCode_STATUSCHECK_DAEMONSET_FETCH_ERR StatusCode = 414
+	// Error fetching jobs
+	StatusCode_STATUSCHECK_JOB_FETCH_ERR StatusCode = 415
+	// Error fetching standalone pods
+	StatusCode_STATUSCHECK_POD_FETCH_ERR StatusCode = 416
+	// Error fetching custom resources
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR StatusCode = 417
 	// Pod Initializing
 	StatusCode_STATUSCHECK_POD_INITIALIZING StatusCode = 451
 	// Could not determine error and phase
@@ -312,8 +342,14 @@ const (
 	StatusCode_INIT_UNKNOWN StatusCode = 510
 	// Build failed due to docker unknown error
 	StatusCode_BUILD_DOCKER_UNKNOWN StatusCode = 511
+	// Test failed due to unknown reason
+	StatusCode_TEST_UNKNOWN StatusCode = 512
 	// File Sync Initialize failure
 	StatusCode_SYNC_INIT_ERROR StatusCode = 601
+	// Files could not be streamed to a container by the native syncer
+	StatusCode_SYNC_NATIVE_EXEC_ERR StatusCode = 602
+	// The native syncer could not inject its helper container into a pod
+	StatusCode_SYNC_HELPER_ERR StatusCode = 603
 	// Failed to configure watcher for build dependencies in dev loop
 	StatusCode_DEVINIT_REGISTER_BUILD_DEPS StatusCode = 701
 	// Failed to configure watcher for test dependencies in dev loop
@@ -398,6 +434,46 @@ const (
 	StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR StatusCode = 1021
 	// Helm config `createNamespace` not available
 	StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE StatusCode = 1022
+	// Error rolling out the manifests with a canary or blue/green deploy strategy
+	StatusCode_DEPLOY_STRATEGY_ERR StatusCode = 1023
+	// Deployed resources failed the status check and were restored to their previous version
+	StatusCode_DEPLOY_ROLLED_BACK StatusCode = 1024
+	// Error restoring the previous version of the deployed resources
+	StatusCode_DEPLOY_ROLLBACK_ERR StatusCode = 1025
+	// Error pruning the resources that are no longer part of the deployed manifests
+	StatusCode_DEPLOY_PRUNE_ERR StatusCode = 1026
+	// Manifests don't match the OpenAPI schema of the cluster
+	StatusCode_DEPLOY_MANIFEST_SCHEMA_ERR StatusCode = 1027
+	// Manifests violate a validation policy
+	StatusCode_DEPLOY_MANIFEST_POLICY_ERR StatusCode = 1028
+	// Error running the manifest validation
+	StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR StatusCode = 1029
+	// Error expanding paths of the test files or dependencies
+	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1201
+	// Container structure tests failed
+	StatusCode_TEST_CST_USER_ERR StatusCode = 1202
+	// Custom test command exited with a non-zero exit code
+	StatusCode_TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR StatusCode = 1203
+	// Custom test command did not complete within its timeout
+	StatusCode_TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR StatusCode = 1204
+	// Custom test command was cancelled
+	StatusCode_TEST_CUSTOM_CMD_RUN_CANCELLED_ERR StatusCode = 1205
+	// Custom test command could not be run
+	StatusCode_TEST_CUSTOM_CMD_RUN_EXECUTION_ERR StatusCode = 1206
+	// Error running the command listing the dependencies of a custom test
+	StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR StatusCode = 1207
+	// Lifecycle hook command exited with a non-zero exit code
+	StatusCode_HOOK_NON_ZERO_EXIT_ERR StatusCode = 1301
+	// Lifecycle hook command could not be run
+	StatusCode_HOOK_EXECUTION_ERR StatusCode = 1302
+	// Lifecycle hook command was cancelled
+	StatusCode_HOOK_CANCELLED_ERR StatusCode = 1303
+	// Error listing the pods to run a container lifecycle hook in
+	StatusCode_HOOK_LIST_PODS_ERR StatusCode = 1304
+	// No running pod backs the forwarded resource
+	StatusCode_PORTFORWARD_POD_NOT_FOUND_ERR StatusCode = 1401
+	// The connection to the forwarded pod could not be established or was lost
+	StatusCode_PORTFORWARD_CONNECTION_ERR StatusCode = 1402
 )
 
 var StatusCode_name = map[int32]string{
@@ -429,11 +505,19 @@ var StatusCode_name = map[int32]string{
 	122:  "INIT_DOCKER_NETWORK_LISTING_CONTAINERS",
 	123:  "INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME",
 	124:  "INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST",
+	125:  "BUILD_MULTI_PLATFORM_NO_PUSH",
 	300:  "STATUSCHECK_IMAGE_PULL_ERR",
 	301:  "STATUSCHECK_CONTAINER_CREATING",
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_STATEFULSET_ROLLOUT_PENDING",
+	306:  "STATUSCHECK_DAEMONSET_ROLLOUT_PENDING",
+	307:  "STATUSCHECK_JOB_PENDING",
+	308:  "STATUSCHECK_JOB_FAILED",
+	309:  "STATUSCHECK_POD_PENDING",
+	310:  "STATUSCHECK_POD_FAILED",
+	311:  "STATUSCHECK_CUSTOM_RESOURCE_PENDING",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
@@ -448,6 +532,11 @@ var StatusCode_name = map[int32]string{
 	410:  "STATUSCHECK_KUBECTL_PID_KILLED",
 	411:  "STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR",
 	412:  "STATUSCHECK_DEPLOYMENT_FETCH_ERR",
+	413:  "STATUSCHECK_STATEFULSET_FETCH_ERR",
+	414:  "STATUSCHECK_DAEMONSET_FETCH_ERR",
+	415:  "STATUSCHECK_JOB_FETCH_ERR",
+	416:  "STATUSCHECK_POD_FETCH_ERR",
+	417:  "STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR",
 	451:  "STATUSCHECK_POD_INITIALIZING",
 	500:  "UNKNOWN_ERROR",
 	501:  "STATUSCHECK_UNKNOWN",
@@ -461,7 +550,10 @@ var StatusCode_name = map[int32]string{
 	508:  "CLEANUP_UNKNOWN",
 	510:  "INIT_UNKNOWN",
 	511:  "BUILD_DOCKER_UNKNOWN",
+	512:  "TEST_UNKNOWN",
 	601:  "SYNC_INIT_ERROR",
+	602:  "SYNC_NATIVE_EXEC_ERR",
+	603:  "SYNC_HELPER_ERR",
 	701:  "DEVINIT_REGISTER_BUILD_DEPS",
 	702:  "DEVINIT_REGISTER_TEST_DEPS",
 	703:  "DEVINIT_REGISTER_DEPLOY_DEPS",
@@ -504,6 +596,26 @@ var StatusCode_name = map[int32]string{
 	1020: "DEPLOY_MANIFEST_WRITE_ERR",
 	1021: "DEPLOY_PARSE_MANIFEST_IMAGES_ERR",
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
+	1023: "DEPLOY_STRATEGY_ERR",
+	1024: "DEPLOY_ROLLED_BACK",
+	1025: "DEPLOY_ROLLBACK_ERR",
+	1026: "DEPLOY_PRUNE_ERR",
+	1027: "DEPLOY_MANIFEST_SCHEMA_ERR",
+	1028: "DEPLOY_MANIFEST_POLICY_ERR",
+	1029: "DEPLOY_MANIFEST_VALIDATION_ERR",
+	1201: "TEST_USER_CONFIG_ERR",
+	1202: "TEST_CST_USER_ERR",
+	1203: "TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR",
+	1204: "TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR",
+	1205: "TEST_CUSTOM_CMD_RUN_CANCELLED_ERR",
+	1206: "TEST_CUSTOM_CMD_RUN_EXECUTION_ERR",
+	1207: "TEST_CUSTOM_DEPENDENCIES_CMD_ERR",
+	1301: "HOOK_NON_ZERO_EXIT_ERR",
+	1302: "HOOK_EXECUTION_ERR",
+	1303: "HOOK_CANCELLED_ERR",
+	1304: "HOOK_LIST_PODS_ERR",
+	1401: "PORTFORWARD_POD_NOT_FOUND_ERR",
+	1402: "PORTFORWARD_CONNECTION_ERR",
 }
 
 var StatusCode_value = map[string]int32{
@@ -535,11 +647,19 @@ var StatusCode_value = map[string]int32{
 	"INIT_DOCKER_NETWORK_LISTING_CONTAINERS":       122,
 	"INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME":   123,
 	"INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST": 124,
+	"BUILD_MULTI_PLATFORM_NO_PUSH":                 125,
 	"STATUSCHECK_IMAGE_PULL_ERR":                   300,
 	"STATUSCHECK_CONTAINER_CREATING":               301,
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_STATEFULSET_ROLLOUT_PENDING":      305,
+	"STATUSCHECK_DAEMONSET_ROLLOUT_PENDING":        306,
+	"STATUSCHECK_JOB_PENDING":                      307,
+	"STATUSCHECK_JOB_FAILED":                       308,
+	"STATUSCHECK_POD_PENDING":                      309,
+	"STATUSCHECK_POD_FAILED":                       310,
+	"STATUSCHECK_CUSTOM_RESOURCE_PENDING":          311,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
@@ -554,6 +674,11 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_KUBECTL_PID_KILLED":               410,
 	"STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR":         411,
 	"STATUSCHECK_DEPLOYMENT_FETCH_ERR":             412,
+	"STATUSCHECK_STATEFULSET_FETCH_ERR":            413,
+	"STATUSCHECK_DAEMONSET_FETCH_ERR":              414,
+	"STATUSCHECK_JOB_FETCH_ERR":                    415,
+	"STATUSCHECK_POD_FETCH_ERR":                    416,
+	"STATUSCHECK_CUSTOM_RESOURCE_FETCH_ERR":        417,
 	"STATUSCHECK_POD_INITIALIZING":                 451,
 	"UNKNOWN_ERROR":                                500,
 	"STATUSCHECK_UNKNOWN":                          501,
@@ -567,7 +692,10 @@ var StatusCode_value = map[string]int32{
 	"CLEANUP_UNKNOWN":                              508,
 	"INIT_UNKNOWN":                                 510,
 	"BUILD_DOCKER_UNKNOWN":                         511,
+	"TEST_UNKNOWN":                                 512,
 	"SYNC_INIT_ERROR":                              601,
+	"SYNC_NATIVE_EXEC_ERR":                         602,
+	"SYNC_HELPER_ERR":                              603,
 	"DEVINIT_REGISTER_BUILD_DEPS":                  701,
 	"DEVINIT_REGISTER_TEST_DEPS":                   702,
 	"DEVINIT_REGISTER_DEPLOY_DEPS":                 703,
@@ -610,6 +738,26 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_MANIFEST_WRITE_ERR":                    1020,
 	"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":             1021,
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
+	"DEPLOY_STRATEGY_ERR":                          1023,
+	"DEPLOY_ROLLED_BACK":                           1024,
+	"DEPLOY_ROLLBACK_ERR":                          1025,
+	"DEPLOY_PRUNE_ERR":                             1026,
+	"DEPLOY_MANIFEST_SCHEMA_ERR":                   1027,
+	"DEPLOY_MANIFEST_POLICY_ERR":                   1028,
+	"DEPLOY_MANIFEST_VALIDATION_ERR":               1029,
+	"TEST_USER_CONFIG_ERR":                         1201,
+	"TEST_CST_USER_ERR":                            1202,
+	"TEST_CUSTOM_CMD_RUN_NON_ZERO_EXIT_ERR":        1203,
+	"TEST_CUSTOM_CMD_RUN_TIMEDOUT_ERR":             1204,
+	"TEST_CUSTOM_CMD_RUN_CANCELLED_ERR":            1205,
+	"TEST_CUSTOM_CMD_RUN_EXECUTION_ERR":            1206,
+	"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":             1207,
+	"HOOK_NON_ZERO_EXIT_ERR":                       1301,
+	"HOOK_EXECUTION_ERR":                           1302,
+	"HOOK_CANCELLED_ERR":                           1303,
+	"HOOK_LIST_PODS_ERR":                           1304,
+	"PORTFORWARD_POD_NOT_FOUND_ERR":                1401,
+	"PORTFORWARD_CONNECTION_ERR":                   1402,
 }
 
 func (x StatusCode) String() string {
@@ -655,6 +803,8 @@ const (
 	SuggestionCode_FIX_DOCKER_NETWORK_CONTAINER_NAME SuggestionCode = 112
 	// Docker build network container not existing in the current context.
 	SuggestionCode_CHECK_DOCKER_NETWORK_CONTAINER_RUNNING SuggestionCode = 113
+	// Push the images built for several platforms, or build a single platform
+	SuggestionCode_PUSH_MULTI_PLATFORM_IMAGES SuggestionCode = 114
 	// Check cluster connection
 	SuggestionCode_CHECK_CLUSTER_CONNECTION SuggestionCode = 201
 	// Check minikube status
@@ -669,8 +819,14 @@ const (
 	SuggestionCode_UPGRADE_HELM32 SuggestionCode = 206
 	// Set `releases.createNamespace` to false.
 	SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE SuggestionCode = 207
+	// Fix the manifests so that they match the OpenAPI schema of the cluster
+	SuggestionCode_FIX_MANIFEST_SCHEMA SuggestionCode = 208
+	// Fix the manifests so that they satisfy the validation policies
+	SuggestionCode_FIX_MANIFEST_POLICY_VIOLATION SuggestionCode = 209
 	// Install kubectl tool
 	SuggestionCode_INSTALL_KUBECTL SuggestionCode = 220
+	// Install the opa tool
+	SuggestionCode_INSTALL_OPA SuggestionCode = 221
 	// Container run error
 	SuggestionCode_CHECK_CONTAINER_LOGS SuggestionCode = 301
 	// Pod Health check error
@@ -723,6 +879,7 @@ var SuggestionCode_name = map[int32]string{
 	111: "FIX_JIB_PLUGIN_CONFIGURATION",
 	112: "FIX_DOCKER_NETWORK_CONTAINER_NAME",
 	113: "CHECK_DOCKER_NETWORK_CONTAINER_RUNNING",
+	114: "PUSH_MULTI_PLATFORM_IMAGES",
 	201: "CHECK_CLUSTER_CONNECTION",
 	202: "CHECK_MINIKUBE_STATUS",
 	203: "INSTALL_HELM",
@@ -730,7 +887,10 @@ var SuggestionCode_name = map[int32]string{
 	205: "FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES",
 	206: "UPGRADE_HELM32",
 	207: "FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE",
+	208: "FIX_MANIFEST_SCHEMA",
+	209: "FIX_MANIFEST_POLICY_VIOLATION",
 	220: "INSTALL_KUBECTL",
+	221: "INSTALL_OPA",
 	301: "CHECK_CONTAINER_LOGS",
 	302: "CHECK_READINESS_PROBE",
 	303: "CHECK_CONTAINER_IMAGE",
@@ -766,6 +926,7 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_JIB_PLUGIN_CONFIGURATION":                111,
 	"FIX_DOCKER_NETWORK_CONTAINER_NAME":           112,
 	"CHECK_DOCKER_NETWORK_CONTAINER_RUNNING":      113,
+	"PUSH_MULTI_PLATFORM_IMAGES":                  114,
 	"CHECK_CLUSTER_CONNECTION":                    201,
 	"CHECK_MINIKUBE_STATUS":                       202,
 	"INSTALL_HELM":                                203,
@@ -773,7 +934,10 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES": 205,
 	"UPGRADE_HELM32":                              206,
 	"FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE":   207,
+	"FIX_MANIFEST_SCHEMA":                         208,
+	"FIX_MANIFEST_POLICY_VIOLATION":               209,
 	"INSTALL_KUBECTL":                             220,
+	"INSTALL_OPA":                                 221,
 	"CHECK_CONTAINER_LOGS":                        301,
 	"CHECK_READINESS_PROBE":                       302,
 	"CHECK_CONTAINER_IMAGE":                       303,
@@ -1511,6 +1675,8 @@ type Event struct {
 	//	*Event_DebuggingContainerEvent
 	//	*Event_DevLoopEvent
 	//	*Event_TerminationEvent
+	//	*Event_TestEvent
+	//	*Event_ApplicationLogEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1586,6 +1752,14 @@ type Event_TerminationEvent struct {
 	TerminationEvent *TerminationEvent `protobuf:"bytes,10,opt,name=terminationEvent,proto3,oneof"`
 }
 
+type Event_TestEvent struct {
+	TestEvent *TestEvent `protobuf:"bytes,11,opt,name=testEvent,proto3,oneof"`
+}
+
+type Event_ApplicationLogEvent struct {
+	ApplicationLogEvent *ApplicationLogEvent `protobuf:"bytes,12,opt,name=applicationLogEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -1606,6 +1780,10 @@ func (*Event_DevLoopEvent) isEvent_EventType() {}
 
 func (*Event_TerminationEvent) isEvent_EventType() {}
 
+func (*Event_TestEvent) isEvent_EventType() {}
+
+func (*Event_ApplicationLogEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1683,6 +1861,20 @@ func (m *Event) GetTerminationEvent() *TerminationEvent {
 	return nil
 }
 
+func (m *Event) GetTestEvent() *TestEvent {
+	if x, ok := m.GetEventType().(*Event_TestEvent); ok {
+		return x.TestEvent
+	}
+	return nil
+}
+
+func (m *Event) GetApplicationLogEvent() *ApplicationLogEvent {
+	if x, ok := m.GetEventType().(*Event_ApplicationLogEvent); ok {
+		return x.ApplicationLogEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1696,7 +1888,106 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_DebuggingContainerEvent)(nil),
 		(*Event_DevLoopEvent)(nil),
 		(*Event_TerminationEvent)(nil),
+		(*Event_TestEvent)(nil),
+		(*Event_ApplicationLogEvent)(nil),
+	}
+}
+
+// `ApplicationLogEvent` is a line of the logs of a deployed container.
+// Unlike other events, these events are not replayed to clients that connect later.
+type ApplicationLogEvent struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Image                string   `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Timestamp            string   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level                string   `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	Message              string   `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Sequence             int64    `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationLogEvent) Reset()         { *m = ApplicationLogEvent{} }
+func (m *ApplicationLogEvent) String() string { return proto.CompactTextString(m) }
+func (*ApplicationLogEvent) ProtoMessage()    {}
+func (*ApplicationLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
+}
+
+func (m *ApplicationLogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationLogEvent.Unmarshal(m, b)
+}
+func (m *ApplicationLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationLogEvent.Marshal(b, m, deterministic)
+}
+func (m *ApplicationLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationLogEvent.Merge(m, src)
+}
+func (m *ApplicationLogEvent) XXX_Size() int {
+	return xxx_messageInfo_ApplicationLogEvent.Size(m)
+}
+func (m *ApplicationLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationLogEvent proto.InternalMessageInfo
+
+func (m *ApplicationLogEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
 	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
 // `TerminationEvent` marks the end of the skaffold session
@@ -1712,7 +2003,7 @@ func (m *TerminationEvent) Reset()         { *m = TerminationEvent{} }
 func (m *TerminationEvent) String() string { return proto.CompactTextString(m) }
 func (*TerminationEvent) ProtoMessage()    {}
 func (*TerminationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
 }
 
 func (m *TerminationEvent) XXX_Unmarshal(b []byte) error {
@@ -1761,7 +2052,7 @@ func (m *DevLoopEvent) Reset()         { *m = DevLoopEvent{} }
 func (m *DevLoopEvent) String() string { return proto.CompactTextString(m) }
 func (*DevLoopEvent) ProtoMessage()    {}
 func (*DevLoopEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
 }
 
 func (m *DevLoopEvent) XXX_Unmarshal(b []byte) error {
@@ -1817,7 +2108,7 @@ func (m *ActionableErr) Reset()         { *m = ActionableErr{} }
 func (m *ActionableErr) String() string { return proto.CompactTextString(m) }
 func (*ActionableErr) ProtoMessage()    {}
 func (*ActionableErr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
 }
 
 func (m *ActionableErr) XXX_Unmarshal(b []byte) error {
@@ -1874,7 +2165,7 @@ func (m *MetaEvent) Reset()         { *m = MetaEvent{} }
 func (m *MetaEvent) String() string { return proto.CompactTextString(m) }
 func (*MetaEvent) ProtoMessage()    {}
 func (*MetaEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
 }
 
 func (m *MetaEvent) XXX_Unmarshal(b []byte) error {
@@ -1917,6 +2208,7 @@ type BuildEvent struct {
 	Err                  string         `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
 	ErrCode              StatusCode     `protobuf:"varint,4,opt,name=errCode,proto3,enum=proto.StatusCode" json:"errCode,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,5,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	CacheMissReasons     []string       `protobuf:"bytes,6,rep,name=cacheMissReasons,proto3" json:"cacheMissReasons,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -1926,7 +2218,7 @@ func (m *BuildEvent) Reset()         { *m = BuildEvent{} }
 func (m *BuildEvent) String() string { return proto.CompactTextString(m) }
 func (*BuildEvent) ProtoMessage()    {}
 func (*BuildEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{17}
 }
 
 func (m *BuildEvent) XXX_Unmarshal(b []byte) error {
@@ -1982,6 +2274,78 @@ func (m *BuildEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *BuildEvent) GetCacheMissReasons() []string {
+	if m != nil {
+		return m.CacheMissReasons
+	}
+	return nil
+}
+
+// `TestEvent` represents the status of a test run on an artifact, and is emitted by Skaffold
+// anytime a test starts or completes, successfully or not.
+type TestEvent struct {
+	Artifact             string         `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Name                 string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status               string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,4,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TestEvent) Reset()         { *m = TestEvent{} }
+func (m *TestEvent) String() string { return proto.CompactTextString(m) }
+func (*TestEvent) ProtoMessage()    {}
+func (*TestEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{18}
+}
+
+func (m *TestEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestEvent.Unmarshal(m, b)
+}
+func (m *TestEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestEvent.Marshal(b, m, deterministic)
+}
+func (m *TestEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestEvent.Merge(m, src)
+}
+func (m *TestEvent) XXX_Size() int {
+	return xxx_messageInfo_TestEvent.Size(m)
+}
+func (m *TestEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestEvent proto.InternalMessageInfo
+
+func (m *TestEvent) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *TestEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TestEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TestEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // `DeployEvent` represents the status of a deployment, and is emitted by Skaffold
 // anytime a deployment starts or completes, successfully or not.
 type DeployEvent struct {
@@ -1998,7 +2362,7 @@ func (m *DeployEvent) Reset()         { *m = DeployEvent{} }
 func (m *DeployEvent) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent) ProtoMessage()    {}
 func (*DeployEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{17}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{19}
 }
 
 func (m *DeployEvent) XXX_Unmarshal(b []byte) error {
@@ -2063,7 +2427,7 @@ func (m *StatusCheckEvent) Reset()         { *m = StatusCheckEvent{} }
 func (m *StatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckEvent) ProtoMessage()    {}
 func (*StatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{18}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{20}
 }
 
 func (m *StatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -2140,7 +2504,7 @@ func (m *ResourceStatusCheckEvent) Reset()         { *m = ResourceStatusCheckEve
 func (m *ResourceStatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*ResourceStatusCheckEvent) ProtoMessage()    {}
 func (*ResourceStatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{19}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{21}
 }
 
 func (m *ResourceStatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -2205,26 +2569,28 @@ func (m *ResourceStatusCheckEvent) GetActionableErr() *ActionableErr {
 
 // PortEvent Event describes each port forwarding event.
 type PortEvent struct {
-	LocalPort            int32        `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
-	RemotePort           int32        `protobuf:"varint,2,opt,name=remotePort,proto3" json:"remotePort,omitempty"`
-	PodName              string       `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
-	ContainerName        string       `protobuf:"bytes,4,opt,name=containerName,proto3" json:"containerName,omitempty"`
-	Namespace            string       `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	PortName             string       `protobuf:"bytes,6,opt,name=portName,proto3" json:"portName,omitempty"`
-	ResourceType         string       `protobuf:"bytes,7,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
-	ResourceName         string       `protobuf:"bytes,8,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
-	Address              string       `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
-	TargetPort           *IntOrString `protobuf:"bytes,10,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	LocalPort            int32          `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	RemotePort           int32          `protobuf:"varint,2,opt,name=remotePort,proto3" json:"remotePort,omitempty"`
+	PodName              string         `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string         `protobuf:"bytes,4,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string         `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string         `protobuf:"bytes,6,opt,name=portName,proto3" json:"portName,omitempty"`
+	ResourceType         string         `protobuf:"bytes,7,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	ResourceName         string         `protobuf:"bytes,8,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Address              string         `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	TargetPort           *IntOrString   `protobuf:"bytes,10,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	Status               string         `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	ActionableErr        *ActionableErr `protobuf:"bytes,12,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *PortEvent) Reset()         { *m = PortEvent{} }
 func (m *PortEvent) String() string { return proto.CompactTextString(m) }
 func (*PortEvent) ProtoMessage()    {}
 func (*PortEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{20}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
 }
 
 func (m *PortEvent) XXX_Unmarshal(b []byte) error {
@@ -2315,6 +2681,20 @@ func (m *PortEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PortEvent) GetActionableErr() *ActionableErr {
+	if m != nil {
+		return m.ActionableErr
+	}
+	return nil
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	FileCount            int32          `protobuf:"varint,1,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
@@ -2323,6 +2703,7 @@ type FileSyncEvent struct {
 	Err                  string         `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
 	ErrCode              StatusCode     `protobuf:"varint,5,opt,name=errCode,proto3,enum=proto.StatusCode" json:"errCode,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	PodSync              *PodSync       `protobuf:"bytes,7,opt,name=podSync,proto3" json:"podSync,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2332,7 +2713,7 @@ func (m *FileSyncEvent) Reset()         { *m = FileSyncEvent{} }
 func (m *FileSyncEvent) String() string { return proto.CompactTextString(m) }
 func (*FileSyncEvent) ProtoMessage()    {}
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{21}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
 }
 
 func (m *FileSyncEvent) XXX_Unmarshal(b []byte) error {
@@ -2395,6 +2776,77 @@ func (m *FileSyncEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *FileSyncEvent) GetPodSync() *PodSync {
+	if m != nil {
+		return m.PodSync
+	}
+	return nil
+}
+
+// `PodSync` describes the sync of files to a single container.
+type PodSync struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ContainerName        string   `protobuf:"bytes,3,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	DurationMs           int64    `protobuf:"varint,4,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodSync) Reset()         { *m = PodSync{} }
+func (m *PodSync) String() string { return proto.CompactTextString(m) }
+func (*PodSync) ProtoMessage()    {}
+func (*PodSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
+}
+
+func (m *PodSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodSync.Unmarshal(m, b)
+}
+func (m *PodSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodSync.Marshal(b, m, deterministic)
+}
+func (m *PodSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodSync.Merge(m, src)
+}
+func (m *PodSync) XXX_Size() int {
+	return xxx_messageInfo_PodSync.Size(m)
+}
+func (m *PodSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodSync proto.InternalMessageInfo
+
+func (m *PodSync) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *PodSync) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PodSync) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *PodSync) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
 // DebuggingContainerEvent is raised when a debugging container is started or terminated
 type DebuggingContainerEvent struct {
 	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -2414,7 +2866,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2505,7 +2957,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
 }
 
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
@@ -2558,7 +3010,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2597,7 +3049,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2639,7 +3091,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2705,7 +3157,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2760,7 +3212,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2795,6 +3247,182 @@ func (m *Suggestion) GetAction() string {
 	return ""
 }
 
+// AttachDebuggerRequest identifies a debugging container, as reported by a `DebuggingContainerEvent`
+type AttachDebuggerRequest struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string   `protobuf:"bytes,4,opt,name=portName,proto3" json:"portName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachDebuggerRequest) Reset()         { *m = AttachDebuggerRequest{} }
+func (m *AttachDebuggerRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachDebuggerRequest) ProtoMessage()    {}
+func (*AttachDebuggerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{32}
+}
+
+func (m *AttachDebuggerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachDebuggerRequest.Unmarshal(m, b)
+}
+func (m *AttachDebuggerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachDebuggerRequest.Marshal(b, m, deterministic)
+}
+func (m *AttachDebuggerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDebuggerRequest.Merge(m, src)
+}
+func (m *AttachDebuggerRequest) XXX_Size() int {
+	return xxx_messageInfo_AttachDebuggerRequest.Size(m)
+}
+func (m *AttachDebuggerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDebuggerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachDebuggerRequest proto.InternalMessageInfo
+
+func (m *AttachDebuggerRequest) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *AttachDebuggerRequest) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *AttachDebuggerRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AttachDebuggerRequest) GetPortName() string {
+	if m != nil {
+		return m.PortName
+	}
+	return ""
+}
+
+// AttachDebuggerResponse describes the port forward set up to connect a debugger to a debugging container
+type AttachDebuggerResponse struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Artifact             string   `protobuf:"bytes,4,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Runtime              string   `protobuf:"bytes,5,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	WorkingDir           string   `protobuf:"bytes,6,opt,name=workingDir,proto3" json:"workingDir,omitempty"`
+	Protocol             string   `protobuf:"bytes,7,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address              string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	LocalPort            int32    `protobuf:"varint,9,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	RemotePort           int32    `protobuf:"varint,10,opt,name=remotePort,proto3" json:"remotePort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttachDebuggerResponse) Reset()         { *m = AttachDebuggerResponse{} }
+func (m *AttachDebuggerResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachDebuggerResponse) ProtoMessage()    {}
+func (*AttachDebuggerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{33}
+}
+
+func (m *AttachDebuggerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttachDebuggerResponse.Unmarshal(m, b)
+}
+func (m *AttachDebuggerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttachDebuggerResponse.Marshal(b, m, deterministic)
+}
+func (m *AttachDebuggerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDebuggerResponse.Merge(m, src)
+}
+func (m *AttachDebuggerResponse) XXX_Size() int {
+	return xxx_messageInfo_AttachDebuggerResponse.Size(m)
+}
+func (m *AttachDebuggerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDebuggerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttachDebuggerResponse proto.InternalMessageInfo
+
+func (m *AttachDebuggerResponse) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetWorkingDir() string {
+	if m != nil {
+		return m.WorkingDir
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AttachDebuggerResponse) GetLocalPort() int32 {
+	if m != nil {
+		return m.LocalPort
+	}
+	return 0
+}
+
+func (m *AttachDebuggerResponse) GetRemotePort() int32 {
+	if m != nil {
+		return m.RemotePort
+	}
+	return 0
+}
+
 // IntOrString is a type that can hold an int32 or a string.
 type IntOrString struct {
 	Type                 int32    `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -2809,7 +3437,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{34}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -2877,16 +3505,19 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "proto.StatusCheckState.ResourcesEntry")
 	proto.RegisterType((*FileSyncState)(nil), "proto.FileSyncState")
 	proto.RegisterType((*Event)(nil), "proto.Event")
+	proto.RegisterType((*ApplicationLogEvent)(nil), "proto.ApplicationLogEvent")
 	proto.RegisterType((*TerminationEvent)(nil), "proto.TerminationEvent")
 	proto.RegisterType((*DevLoopEvent)(nil), "proto.DevLoopEvent")
 	proto.RegisterType((*ActionableErr)(nil), "proto.ActionableErr")
 	proto.RegisterType((*MetaEvent)(nil), "proto.MetaEvent")
 	proto.RegisterType((*BuildEvent)(nil), "proto.BuildEvent")
+	proto.RegisterType((*TestEvent)(nil), "proto.TestEvent")
 	proto.RegisterType((*DeployEvent)(nil), "proto.DeployEvent")
 	proto.RegisterType((*StatusCheckEvent)(nil), "proto.StatusCheckEvent")
 	proto.RegisterType((*ResourceStatusCheckEvent)(nil), "proto.ResourceStatusCheckEvent")
 	proto.RegisterType((*PortEvent)(nil), "proto.PortEvent")
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.FileSyncEvent")
+	proto.RegisterType((*PodSync)(nil), "proto.PodSync")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.DebuggingContainerEvent.DebugPortsEntry")
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
@@ -2895,261 +3526,314 @@ func init() {
 	proto.RegisterType((*TriggerState)(nil), "proto.TriggerState")
 	proto.RegisterType((*Intent)(nil), "proto.Intent")
 	proto.RegisterType((*Suggestion)(nil), "proto.Suggestion")
+	proto.RegisterType((*AttachDebuggerRequest)(nil), "proto.AttachDebuggerRequest")
+	proto.RegisterType((*AttachDebuggerResponse)(nil), "proto.AttachDebuggerResponse")
 	proto.RegisterType((*IntOrString)(nil), "proto.IntOrString")
 }
 
 func init() { proto.RegisterFile("skaffold.proto", fileDescriptor_4f2d38e344f9dbf5) }
 
 var fileDescriptor_4f2d38e344f9dbf5 = []byte{
-	// 3965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x8c, 0x1b, 0x59,
-	0x5a, 0x8e, 0xed, 0xf6, 0xed, 0xef, 0x4b, 0x2a, 0x27, 0xe9, 0xc4, 0x71, 0x6e, 0x1d, 0x4f, 0x92,
-	0xcd, 0xf4, 0x0c, 0x9d, 0x4c, 0x82, 0xd0, 0x12, 0x66, 0x40, 0xd5, 0xae, 0x63, 0xbb, 0xd2, 0xe5,
-	0x2a, 0x73, 0xaa, 0xdc, 0x99, 0x8e, 0x84, 0x4a, 0x4e, 0x77, 0xc5, 0xe3, 0x8d, 0xdb, 0xee, 0xb1,
-	0xab, 0x33, 0xdb, 0x0b, 0xe2, 0x01, 0x71, 0x5f, 0x90, 0x80, 0x65, 0xb9, 0x3f, 0x2c, 0x2c, 0x68,
-	0x79, 0xe0, 0xf6, 0x8a, 0x10, 0x2c, 0x88, 0x07, 0xee, 0xf0, 0x80, 0x40, 0xac, 0x84, 0x84, 0x90,
-	0x76, 0x1f, 0x96, 0xbb, 0x60, 0x66, 0xf6, 0xbe, 0xa0, 0x73, 0xab, 0x3a, 0xe5, 0x4b, 0x7a, 0x32,
-	0x08, 0xf1, 0x14, 0xd7, 0xf9, 0xbf, 0xff, 0x7a, 0xfe, 0xf3, 0xff, 0xff, 0x39, 0x69, 0x58, 0x19,
-	0x3f, 0xe9, 0x3c, 0x7e, 0x3c, 0xec, 0xef, 0x6d, 0x1c, 0x8c, 0x86, 0xe1, 0x10, 0x65, 0xd9, 0x3f,
-	0xe5, 0x8b, 0xdd, 0xe1, 0xb0, 0xdb, 0x0f, 0x6e, 0x75, 0x0e, 0x7a, 0xb7, 0x3a, 0x83, 0xc1, 0x30,
-	0xec, 0x84, 0xbd, 0xe1, 0x60, 0xcc, 0x41, 0xe5, 0x2b, 0x82, 0xca, 0xbe, 0x1e, 0x1d, 0x3e, 0xbe,
-	0x15, 0xf6, 0xf6, 0x83, 0x71, 0xd8, 0xd9, 0x3f, 0x10, 0x80, 0x0b, 0x93, 0x80, 0x60, 0xff, 0x20,
-	0x3c, 0xe2, 0xc4, 0xca, 0x5d, 0x58, 0x76, 0xc3, 0x4e, 0x18, 0x90, 0x60, 0x7c, 0x30, 0x1c, 0x8c,
-	0x03, 0x54, 0x81, 0xec, 0x98, 0x2e, 0x94, 0x52, 0x6b, 0xa9, 0x9b, 0x8b, 0x77, 0x96, 0x38, 0x6e,
-	0x83, 0x83, 0x38, 0xa9, 0x72, 0x11, 0x0a, 0x11, 0x5e, 0x83, 0xcc, 0xfe, 0xb8, 0xcb, 0xd0, 0x45,
-	0x42, 0x7f, 0x56, 0x2e, 0x41, 0x9e, 0x04, 0x6f, 0x1e, 0x06, 0xe3, 0x10, 0x21, 0x58, 0x18, 0x74,
-	0xf6, 0x03, 0x41, 0x65, 0xbf, 0x2b, 0x1f, 0x5f, 0x80, 0x2c, 0x93, 0x86, 0x5e, 0x01, 0x78, 0x74,
-	0xd8, 0xeb, 0xef, 0xb9, 0x8a, 0xbe, 0x53, 0x42, 0xdf, 0x66, 0x44, 0x20, 0x0a, 0x08, 0x7d, 0x23,
-	0x2c, 0xee, 0x05, 0x07, 0xfd, 0xe1, 0x11, 0xe7, 0x49, 0x33, 0x1e, 0x24, 0x78, 0x8c, 0x98, 0x42,
-	0x54, 0x18, 0x6a, 0xc0, 0xca, 0xe3, 0xe1, 0xe8, 0xad, 0xce, 0x68, 0x2f, 0xd8, 0x6b, 0x0d, 0x47,
-	0xe1, 0xb8, 0xb4, 0xb0, 0x96, 0xb9, 0xb9, 0x78, 0x67, 0x4d, 0x75, 0x6e, 0xa3, 0x96, 0x80, 0xe0,
-	0x41, 0x38, 0x3a, 0x22, 0x13, 0x7c, 0xa8, 0x0a, 0x1a, 0x0d, 0xc1, 0xe1, 0xb8, 0xfa, 0x46, 0xb0,
-	0xfb, 0x84, 0x1b, 0x91, 0x65, 0x46, 0x9c, 0x53, 0x64, 0xa9, 0x64, 0x32, 0xc5, 0x80, 0xee, 0xc1,
-	0xf2, 0xe3, 0x5e, 0x3f, 0x70, 0x8f, 0x06, 0xbb, 0x5c, 0x42, 0x8e, 0x49, 0x38, 0x23, 0x24, 0xd4,
-	0x54, 0x1a, 0x49, 0x42, 0x51, 0x0b, 0x4e, 0xef, 0x05, 0x8f, 0x0e, 0xbb, 0xdd, 0xde, 0xa0, 0x5b,
-	0x1d, 0x0e, 0xc2, 0x4e, 0x6f, 0x10, 0x8c, 0xc6, 0xa5, 0x3c, 0xf3, 0xe7, 0x72, 0x14, 0x88, 0x49,
-	0x04, 0x7e, 0x1a, 0x0c, 0x42, 0x32, 0x8b, 0x15, 0xbd, 0x04, 0x85, 0xfd, 0x20, 0xec, 0xec, 0x75,
-	0xc2, 0x4e, 0xa9, 0xc0, 0x0c, 0x39, 0x29, 0xc4, 0x34, 0xc5, 0x32, 0x89, 0x00, 0x65, 0x17, 0x4e,
-	0xcf, 0x08, 0x13, 0x4d, 0x82, 0x27, 0xc1, 0x11, 0xdb, 0xc2, 0x2c, 0xa1, 0x3f, 0xd1, 0x0d, 0xc8,
-	0x3e, 0xed, 0xf4, 0x0f, 0xe5, 0x16, 0x69, 0x42, 0x24, 0xe5, 0xe1, 0xb6, 0x70, 0xf2, 0xbd, 0xf4,
-	0x07, 0x53, 0xf7, 0x17, 0x0a, 0x19, 0x6d, 0xa1, 0xf2, 0xb9, 0x14, 0x14, 0xa4, 0x46, 0xb4, 0x0e,
-	0x59, 0xb6, 0xeb, 0x22, 0x2b, 0xce, 0xa8, 0x59, 0x11, 0x99, 0xc5, 0x21, 0xe8, 0x1b, 0x20, 0xc7,
-	0x37, 0x5b, 0xe8, 0x5a, 0x4d, 0xa4, 0x43, 0x84, 0x16, 0x20, 0xf4, 0x6d, 0x00, 0x9d, 0xbd, 0xbd,
-	0x1e, 0x3d, 0x42, 0x9d, 0x7e, 0x69, 0x97, 0x05, 0xee, 0xca, 0x84, 0xc7, 0x1b, 0x7a, 0x84, 0xe0,
-	0x79, 0xa0, 0xb0, 0x94, 0x5f, 0x83, 0x93, 0x13, 0x64, 0xd5, 0xff, 0x22, 0xf7, 0xff, 0x8c, 0xea,
-	0x7f, 0x51, 0xf1, 0xb6, 0xf2, 0x4e, 0x1a, 0x96, 0x13, 0x7e, 0xa0, 0x97, 0xe1, 0xd4, 0xe0, 0x70,
-	0xff, 0x51, 0x30, 0x72, 0x1e, 0xeb, 0xa3, 0xb0, 0xf7, 0xb8, 0xb3, 0x1b, 0x8e, 0x45, 0x2c, 0xa7,
-	0x09, 0xe8, 0x35, 0x28, 0x30, 0xbf, 0xe9, 0xb6, 0xa7, 0x99, 0xf5, 0x57, 0x67, 0x45, 0x67, 0xc3,
-	0xdc, 0xef, 0x74, 0x83, 0x4d, 0x8e, 0x24, 0x11, 0x0b, 0xba, 0x06, 0x0b, 0xe1, 0xd1, 0x41, 0x50,
-	0xca, 0xac, 0xa5, 0x6e, 0xae, 0x44, 0xfb, 0xc2, 0x70, 0xde, 0xd1, 0x41, 0x40, 0x18, 0x15, 0x19,
-	0x33, 0x82, 0x74, 0x6d, 0xa6, 0x9a, 0x67, 0x45, 0xca, 0x82, 0x25, 0xd5, 0x0a, 0x74, 0x43, 0xe8,
-	0x4e, 0x31, 0xdd, 0x48, 0x95, 0x17, 0x8c, 0x14, 0xed, 0x67, 0x20, 0xbb, 0x3b, 0x3c, 0x1c, 0x84,
-	0x2c, 0x78, 0x59, 0xc2, 0x3f, 0xfe, 0xb7, 0x71, 0xff, 0xa3, 0x14, 0xac, 0x24, 0x53, 0x02, 0xbd,
-	0x0a, 0x45, 0x9e, 0x14, 0x34, 0x96, 0xa9, 0x89, 0x23, 0xa4, 0x22, 0xc5, 0x67, 0x30, 0x22, 0x31,
-	0x03, 0x7a, 0x19, 0xf2, 0xbb, 0xfd, 0xc3, 0x71, 0x18, 0x8c, 0x98, 0xb2, 0xd8, 0xa1, 0x2a, 0x5f,
-	0x65, 0x0e, 0x49, 0x48, 0xd9, 0x84, 0x82, 0x14, 0x82, 0x3e, 0x90, 0x88, 0xc3, 0xe9, 0x84, 0xca,
-	0xe3, 0x03, 0x51, 0xf9, 0x87, 0x14, 0x40, 0x5c, 0x1f, 0xd1, 0xb7, 0x42, 0xb1, 0xa3, 0xa4, 0x8d,
-	0x5a, 0xd8, 0x62, 0xd4, 0x46, 0x94, 0x40, 0x7c, 0x9b, 0x62, 0x16, 0xb4, 0x06, 0x8b, 0x9d, 0xc3,
-	0x70, 0xe8, 0x8d, 0x7a, 0xdd, 0xae, 0xf0, 0xa5, 0x40, 0xd4, 0x25, 0x5a, 0xa8, 0x45, 0x11, 0x1b,
-	0xee, 0xc9, 0xcc, 0x39, 0x95, 0xac, 0x77, 0xc3, 0xbd, 0x80, 0x28, 0xa0, 0xf2, 0xab, 0xb0, 0x92,
-	0xd4, 0xf8, 0x5c, 0x7b, 0xf5, 0x11, 0x58, 0x54, 0x8a, 0x39, 0x3a, 0x0b, 0x39, 0x2e, 0x5a, 0x70,
-	0x8b, 0xaf, 0xff, 0x13, 0xcb, 0x2b, 0xff, 0x98, 0x02, 0x6d, 0xb2, 0x88, 0xcf, 0xb5, 0xc0, 0x80,
-	0xe2, 0x28, 0x18, 0x0f, 0x0f, 0x47, 0xbb, 0x81, 0x3c, 0x8d, 0x37, 0xe6, 0x34, 0x82, 0x0d, 0x22,
-	0x81, 0x62, 0x07, 0x22, 0xc6, 0xf7, 0x19, 0xdf, 0xa4, 0xbc, 0xe7, 0x8a, 0xaf, 0x09, 0xcb, 0x89,
-	0x2e, 0xf3, 0xfe, 0x23, 0x5c, 0xf9, 0xd5, 0x2c, 0x64, 0x59, 0x45, 0x47, 0xb7, 0xa1, 0x48, 0xfb,
-	0x04, 0xfb, 0x10, 0x75, 0x5b, 0x53, 0xea, 0x2a, 0x5b, 0x6f, 0x9c, 0x20, 0x31, 0x08, 0xdd, 0x15,
-	0x03, 0x00, 0x67, 0x49, 0x4f, 0x0f, 0x00, 0x92, 0x47, 0x81, 0xa1, 0x6f, 0x92, 0x23, 0x00, 0xe7,
-	0xca, 0xcc, 0x18, 0x01, 0x24, 0x9b, 0x0a, 0xa4, 0xe6, 0x1d, 0xc8, 0xee, 0x53, 0x5a, 0x98, 0xdd,
-	0x95, 0xa8, 0x79, 0x11, 0x08, 0xe1, 0x44, 0xb3, 0xe7, 0x8c, 0x73, 0x9b, 0xbd, 0xe4, 0x9f, 0x62,
-	0x41, 0xdf, 0x01, 0x25, 0xb9, 0xd5, 0x93, 0x78, 0xd1, 0xf9, 0x65, 0xfb, 0x21, 0x73, 0x60, 0x8d,
-	0x13, 0x64, 0xae, 0x08, 0xf4, 0x6a, 0x3c, 0x4d, 0x70, 0x99, 0xf9, 0x99, 0xd3, 0x84, 0x14, 0x94,
-	0x04, 0xa3, 0x87, 0x70, 0x6e, 0x6f, 0xf6, 0xb4, 0x20, 0x86, 0x81, 0x63, 0x66, 0x8a, 0xc6, 0x09,
-	0x32, 0x4f, 0x00, 0xfa, 0x66, 0x58, 0xda, 0x0b, 0x9e, 0x5a, 0xc3, 0xe1, 0x01, 0x17, 0x58, 0x64,
-	0x02, 0xe3, 0x72, 0x17, 0x93, 0x1a, 0x27, 0x48, 0x02, 0x4a, 0x43, 0x1f, 0x06, 0xa3, 0xfd, 0xde,
-	0x80, 0x8d, 0xba, 0x9c, 0x1d, 0x12, 0xa1, 0xf7, 0x26, 0xc8, 0x34, 0xf4, 0x93, 0x2c, 0x9b, 0x4b,
-	0x00, 0x01, 0xfd, 0xe1, 0xd3, 0x6a, 0x5a, 0x21, 0xa0, 0x4d, 0x72, 0xcd, 0x4d, 0xfc, 0x1b, 0x90,
-	0x09, 0x46, 0x23, 0x91, 0x93, 0x32, 0x96, 0xfa, 0x2e, 0x6b, 0x3e, 0x8f, 0xfa, 0x01, 0x1e, 0x8d,
-	0x08, 0x05, 0x54, 0xfa, 0xb0, 0xa4, 0x3a, 0x82, 0x2e, 0x42, 0xb1, 0x17, 0x06, 0x23, 0xa6, 0x41,
-	0xf4, 0xf0, 0x78, 0x41, 0xd1, 0x96, 0x9e, 0xa5, 0x2d, 0x73, 0x9c, 0xb6, 0x8f, 0xa6, 0x60, 0x39,
-	0xb1, 0x8c, 0x5e, 0x82, 0x7c, 0x30, 0x1a, 0xb1, 0xba, 0x91, 0x9a, 0x57, 0x37, 0x24, 0x02, 0x95,
-	0x20, 0xbf, 0x1f, 0x8c, 0xc7, 0x9d, 0xae, 0x2c, 0x09, 0xf2, 0x13, 0xdd, 0x85, 0xc5, 0xf1, 0x61,
-	0xb7, 0x1b, 0x8c, 0xd9, 0xcd, 0xa2, 0x94, 0x61, 0x95, 0x2c, 0x12, 0x15, 0x51, 0x88, 0x8a, 0xaa,
-	0xd8, 0x50, 0x8c, 0x0e, 0x36, 0x2d, 0x36, 0x01, 0xad, 0x43, 0x22, 0x8e, 0xfc, 0x23, 0x31, 0x5c,
-	0xa6, 0x8f, 0x19, 0x2e, 0x2b, 0xbf, 0x23, 0xfb, 0x1a, 0x97, 0x58, 0x86, 0x82, 0x6c, 0x52, 0x42,
-	0x68, 0xf4, 0x3d, 0x37, 0x90, 0x5a, 0x1c, 0xc8, 0x22, 0x0b, 0x99, 0x1a, 0xa0, 0x85, 0x63, 0x03,
-	0x74, 0x0f, 0x96, 0x3b, 0x6a, 0x78, 0xc5, 0x71, 0x9f, 0xbd, 0x23, 0x49, 0x68, 0xe5, 0x13, 0x29,
-	0xd9, 0xb4, 0x9e, 0x9d, 0x59, 0x5a, 0x9c, 0x59, 0xd3, 0x26, 0x66, 0x9e, 0xdf, 0xc4, 0x85, 0xf7,
-	0x6e, 0xe2, 0xa7, 0x93, 0xad, 0xed, 0xd9, 0x76, 0xce, 0x4f, 0x96, 0xff, 0xc7, 0x20, 0x7f, 0x3e,
-	0x05, 0xa5, 0x79, 0x55, 0x92, 0x26, 0x8c, 0xac, 0x92, 0x32, 0x61, 0xe4, 0xf7, 0xdc, 0x84, 0x51,
-	0xbc, 0xcc, 0xcc, 0xf4, 0x72, 0x21, 0xf6, 0x32, 0xd9, 0xa6, 0xb3, 0xef, 0xa1, 0x4d, 0x4f, 0xfb,
-	0x9a, 0x7b, 0xef, 0xbe, 0x7e, 0x26, 0x0d, 0xc5, 0xa8, 0x33, 0xd1, 0xc2, 0xd2, 0x1f, 0xee, 0x76,
-	0xfa, 0x74, 0x45, 0x16, 0x96, 0x68, 0x01, 0x5d, 0x06, 0x18, 0x05, 0xfb, 0xc3, 0x30, 0x60, 0x64,
-	0x3e, 0x2d, 0x2a, 0x2b, 0xd4, 0xcd, 0x83, 0xe1, 0x9e, 0x4d, 0xef, 0xe2, 0xc2, 0x4d, 0xf1, 0x89,
-	0xae, 0xc1, 0xf2, 0xae, 0x2c, 0xdb, 0x8c, 0xce, 0x1d, 0x4e, 0x2e, 0x52, 0xed, 0xf4, 0xf2, 0x3e,
-	0x3e, 0xe8, 0xec, 0x72, 0xcf, 0x8b, 0x24, 0x5e, 0xa0, 0x81, 0xa7, 0x5d, 0x93, 0xb1, 0xe7, 0x78,
-	0xe0, 0xe5, 0x37, 0xaa, 0xc0, 0x92, 0xdc, 0x04, 0x3a, 0xd8, 0xb2, 0xee, 0x54, 0x24, 0x89, 0x35,
-	0x15, 0xc3, 0x64, 0x14, 0x92, 0x18, 0x26, 0xa7, 0x04, 0xf9, 0xce, 0xde, 0xde, 0x28, 0x18, 0x8f,
-	0x59, 0x1f, 0x29, 0x12, 0xf9, 0x89, 0xee, 0x00, 0x84, 0x9d, 0x51, 0x37, 0x08, 0x99, 0xef, 0x90,
-	0x98, 0x07, 0xcc, 0x41, 0xe8, 0x8c, 0xdc, 0x70, 0xd4, 0x1b, 0x74, 0x89, 0x82, 0xaa, 0xfc, 0x6d,
-	0x2a, 0x9e, 0x80, 0xa2, 0xf8, 0xd2, 0xce, 0x58, 0x65, 0xe3, 0xb6, 0x88, 0x6f, 0xb4, 0x40, 0xab,
-	0x5b, 0x6f, 0x3f, 0x3e, 0x0a, 0xfc, 0x43, 0x49, 0xaa, 0xcc, 0xac, 0x23, 0xbe, 0x30, 0xf3, 0x80,
-	0x64, 0x9f, 0xff, 0x80, 0x3c, 0x47, 0xd2, 0xbc, 0x9d, 0x86, 0x73, 0x73, 0x5a, 0xf5, 0xb3, 0x4e,
-	0xba, 0x4c, 0x8e, 0xf4, 0x31, 0xc9, 0x91, 0x39, 0x36, 0x39, 0x16, 0x66, 0x24, 0x47, 0x54, 0xc6,
-	0xb3, 0x13, 0x65, 0xbc, 0x04, 0xf9, 0xd1, 0xe1, 0x20, 0xec, 0x45, 0x79, 0x23, 0x3f, 0x69, 0x42,
-	0xbf, 0x35, 0x1c, 0x3d, 0xe9, 0x0d, 0xba, 0x46, 0x6f, 0x24, 0x92, 0x46, 0x59, 0x41, 0x36, 0x00,
-	0x1b, 0x3b, 0xf8, 0x73, 0x4e, 0x81, 0xf5, 0xab, 0x8d, 0x67, 0x8f, 0x2a, 0x7c, 0x5d, 0x79, 0xdc,
-	0x51, 0x24, 0xd0, 0xcb, 0xe5, 0x04, 0xf9, 0xb8, 0x81, 0x7a, 0x59, 0x1d, 0xa8, 0xbf, 0x1b, 0x0a,
-	0xd6, 0xb0, 0xcb, 0xf9, 0x3e, 0x08, 0xc5, 0xe8, 0x09, 0x4e, 0xcc, 0xc1, 0xe5, 0x0d, 0xfe, 0x06,
-	0xb7, 0x21, 0xdf, 0xe0, 0x36, 0x3c, 0x89, 0x20, 0x31, 0x18, 0x55, 0x20, 0x1b, 0x28, 0xa3, 0xb0,
-	0x7c, 0x7b, 0x13, 0x0f, 0x26, 0x41, 0xb2, 0xcf, 0x66, 0x94, 0x3e, 0x5b, 0xb9, 0x07, 0xa7, 0xda,
-	0xe3, 0x60, 0x64, 0x0e, 0x42, 0x0a, 0x15, 0xaf, 0x6f, 0xd7, 0x21, 0xd7, 0x63, 0x0b, 0xc2, 0x8a,
-	0xe5, 0xf8, 0x50, 0x50, 0x94, 0x20, 0x56, 0xbe, 0x05, 0x56, 0xc4, 0x30, 0x2f, 0x19, 0x5f, 0x4c,
-	0xbe, 0x01, 0xca, 0x89, 0x4d, 0xa0, 0x12, 0x4f, 0x81, 0xaf, 0xc0, 0x92, 0xba, 0x8c, 0xca, 0x90,
-	0x0f, 0x58, 0x32, 0xf2, 0xa7, 0x9b, 0x42, 0xe3, 0x04, 0x91, 0x0b, 0x9b, 0x59, 0xc8, 0x3c, 0xed,
-	0xf4, 0x2b, 0xf7, 0x21, 0xc7, 0x2d, 0xa0, 0xbe, 0xc4, 0xaf, 0x3c, 0x05, 0xf9, 0x9e, 0x83, 0x60,
-	0x61, 0x7c, 0x34, 0xd8, 0x15, 0x97, 0x0d, 0xf6, 0x9b, 0xa6, 0xae, 0x78, 0xe3, 0xc9, 0xb0, 0x55,
-	0xf1, 0x55, 0xd9, 0x05, 0x88, 0xa7, 0x13, 0xf4, 0x1a, 0xac, 0xc4, 0xf3, 0x89, 0x32, 0x13, 0xad,
-	0x4e, 0x0d, 0x32, 0xec, 0xc0, 0x4d, 0x80, 0xa9, 0x12, 0x7e, 0x98, 0x64, 0x8f, 0xe0, 0x5f, 0x95,
-	0x6f, 0x87, 0x45, 0xa5, 0x8e, 0x50, 0xfb, 0xa2, 0xdb, 0x7b, 0x56, 0x5c, 0xd4, 0xcf, 0xb2, 0x50,
-	0x6f, 0x77, 0xfa, 0xa2, 0xf6, 0x8a, 0x2f, 0x7e, 0xe4, 0x46, 0x74, 0x3d, 0xaa, 0x10, 0xf4, 0x6b,
-	0x7d, 0x08, 0x8b, 0xca, 0xb3, 0x07, 0x2a, 0xc1, 0x99, 0xb6, 0xbd, 0x65, 0x3b, 0x0f, 0x6c, 0x7f,
-	0xb3, 0x6d, 0x5a, 0x06, 0x26, 0xbe, 0xb7, 0xd3, 0xc2, 0xda, 0x09, 0x94, 0x87, 0xcc, 0x7d, 0x73,
-	0x53, 0x4b, 0xa1, 0x22, 0x64, 0x37, 0xf5, 0x87, 0xd8, 0xd2, 0xd2, 0x68, 0x05, 0x80, 0xa1, 0x5a,
-	0x7a, 0x75, 0xcb, 0xd5, 0x32, 0x08, 0x20, 0x57, 0x6d, 0xbb, 0x9e, 0xd3, 0xd4, 0x16, 0xe8, 0xef,
-	0x2d, 0xdd, 0x36, 0xb7, 0x1c, 0x2d, 0x4b, 0x7f, 0x1b, 0x4e, 0x75, 0x0b, 0x13, 0x2d, 0xb7, 0x6e,
-	0x40, 0x31, 0x7a, 0xe3, 0x41, 0x67, 0x01, 0x25, 0xd4, 0x49, 0x65, 0x8b, 0x90, 0xaf, 0x5a, 0x6d,
-	0xd7, 0xc3, 0x44, 0x4b, 0x51, 0xcd, 0xf5, 0xea, 0xa6, 0x96, 0xa6, 0x9a, 0x2d, 0xa7, 0xaa, 0x5b,
-	0x5a, 0x66, 0xdd, 0xa1, 0xd3, 0x6e, 0xfc, 0x4a, 0x81, 0xce, 0xc3, 0xaa, 0x14, 0x64, 0xe0, 0x96,
-	0xe5, 0xec, 0xc4, 0x86, 0x17, 0x60, 0xa1, 0x81, 0xad, 0xa6, 0x96, 0x42, 0xcb, 0x50, 0xdc, 0x62,
-	0xe6, 0x99, 0x0f, 0xb1, 0x96, 0xa6, 0x4a, 0xb6, 0xda, 0x9b, 0xb8, 0xea, 0x51, 0x81, 0x26, 0x2c,
-	0x2a, 0xaf, 0x25, 0x6a, 0x1c, 0x84, 0x21, 0x52, 0xdc, 0x12, 0x14, 0x9a, 0xa6, 0x6d, 0x52, 0x4e,
-	0x61, 0xdb, 0x16, 0xe6, 0xb6, 0x39, 0x5e, 0x03, 0x13, 0x2d, 0xb3, 0xfe, 0xc9, 0x32, 0x40, 0x5c,
-	0x4d, 0x51, 0x0e, 0xd2, 0xce, 0x96, 0x76, 0x02, 0x95, 0xe0, 0xb4, 0xeb, 0xe9, 0x5e, 0xdb, 0xad,
-	0x36, 0x70, 0x75, 0xcb, 0x77, 0xdb, 0xd5, 0x2a, 0x76, 0x5d, 0xed, 0x8f, 0x53, 0x08, 0xc1, 0x32,
-	0xf7, 0x5e, 0xae, 0xfd, 0x49, 0x0a, 0x9d, 0x86, 0x15, 0xee, 0x48, 0xb4, 0xf8, 0xa7, 0x29, 0x74,
-	0x11, 0x4a, 0x1c, 0xd8, 0x6a, 0xbb, 0x0d, 0x5f, 0x67, 0xeb, 0xbe, 0x81, 0x6d, 0x13, 0x1b, 0x5a,
-	0x80, 0x2e, 0xc0, 0x39, 0x41, 0x25, 0xce, 0x7d, 0x5c, 0xf5, 0x7c, 0xdb, 0xf1, 0xfc, 0x9a, 0xd3,
-	0xb6, 0x0d, 0xed, 0x31, 0x7a, 0x01, 0xae, 0x70, 0x22, 0xdf, 0x08, 0xdf, 0xd0, 0x71, 0xd3, 0xb1,
-	0x19, 0x84, 0xb4, 0x6d, 0xdb, 0xb4, 0xeb, 0x5a, 0x17, 0x9d, 0x01, 0x8d, 0x83, 0xda, 0x2e, 0x26,
-	0x3e, 0x26, 0xc4, 0x21, 0xda, 0x1b, 0xb1, 0x56, 0xc1, 0xda, 0xb6, 0xf5, 0x6d, 0xdd, 0xb4, 0xf4,
-	0x4d, 0x0b, 0x6b, 0x3d, 0x74, 0x09, 0xce, 0x4f, 0x52, 0xdb, 0x5e, 0xc3, 0x21, 0xe6, 0x43, 0x6c,
-	0x68, 0x1f, 0x8a, 0x8d, 0x12, 0x64, 0x77, 0xc7, 0xf5, 0x70, 0x93, 0xca, 0xd6, 0x9e, 0xa0, 0xab,
-	0x70, 0x29, 0x41, 0xa4, 0xd6, 0x34, 0x1d, 0xc3, 0xac, 0x99, 0xd8, 0x60, 0x90, 0x3e, 0xba, 0x06,
-	0x6b, 0x53, 0x10, 0xb3, 0xd9, 0xb2, 0x70, 0x13, 0xdb, 0x9e, 0x40, 0xed, 0xa3, 0xcb, 0x50, 0x9e,
-	0xf0, 0xce, 0xd3, 0x7d, 0xcb, 0x71, 0x5d, 0x46, 0x1f, 0x4c, 0xd1, 0x6b, 0x0e, 0xd9, 0x34, 0x0d,
-	0x03, 0xdb, 0x8c, 0x3e, 0x9c, 0x72, 0xa2, 0xea, 0xd8, 0x35, 0xcb, 0xac, 0x7a, 0x8c, 0x7c, 0x80,
-	0xd6, 0xe0, 0x62, 0x82, 0xcc, 0x22, 0xa3, 0x84, 0xf7, 0x4d, 0x54, 0x81, 0xcb, 0x09, 0x84, 0x69,
-	0x6f, 0xeb, 0x96, 0x69, 0xf8, 0x2d, 0x9d, 0xe8, 0xdc, 0xdb, 0xd1, 0xa4, 0x11, 0x35, 0xd3, 0xc2,
-	0x8a, 0x8c, 0xf1, 0x94, 0xab, 0x55, 0xbd, 0xda, 0xc0, 0x7e, 0x8d, 0x38, 0x4d, 0xbf, 0xd5, 0xb6,
-	0x2c, 0x26, 0x25, 0x44, 0x57, 0xe0, 0x42, 0x02, 0x55, 0xc7, 0x9e, 0x6f, 0x98, 0x75, 0xec, 0x72,
-	0x63, 0x0f, 0xe3, 0xa0, 0x12, 0x5c, 0x37, 0x5d, 0x8f, 0xec, 0x4c, 0x42, 0x9e, 0xc6, 0x10, 0x99,
-	0xe3, 0xf7, 0xcd, 0x4d, 0xbf, 0x65, 0xb5, 0xeb, 0xa6, 0xcd, 0xd3, 0xfc, 0xad, 0x78, 0xd3, 0x29,
-	0xa9, 0x4e, 0x74, 0xc3, 0xc2, 0xf4, 0x64, 0x31, 0x01, 0x1f, 0x8e, 0x77, 0x95, 0x52, 0x9b, 0xfa,
-	0x36, 0xb6, 0x23, 0xe2, 0x11, 0x5a, 0x87, 0x1b, 0xa6, 0x6d, 0x7a, 0xd1, 0x8e, 0x61, 0xef, 0x81,
-	0x43, 0xb6, 0x7c, 0xcb, 0x74, 0x3d, 0xd3, 0xae, 0xd3, 0xd8, 0x7a, 0xba, 0x69, 0x63, 0xe2, 0x6a,
-	0x1f, 0x41, 0x1b, 0xb0, 0x3e, 0x0b, 0x2b, 0xc3, 0x17, 0x61, 0x7d, 0x5b, 0x6f, 0x62, 0xed, 0x3b,
-	0xd1, 0x6d, 0x78, 0x79, 0x16, 0x3e, 0xc6, 0x19, 0x0e, 0x76, 0x59, 0x54, 0xf1, 0xeb, 0xa6, 0xeb,
-	0x69, 0xdf, 0x85, 0xae, 0x40, 0x59, 0x3d, 0x76, 0x66, 0x53, 0xaf, 0xe3, 0x38, 0x9e, 0xbf, 0x96,
-	0x46, 0x2f, 0xc0, 0x65, 0x15, 0x10, 0x8b, 0xaa, 0x12, 0xac, 0x53, 0x8b, 0xb5, 0x5f, 0x4f, 0xa3,
-	0x0a, 0x5c, 0x52, 0x41, 0xa4, 0x6d, 0x2b, 0x40, 0x2a, 0xe8, 0x37, 0xd2, 0xe8, 0x3a, 0xac, 0xcd,
-	0x16, 0xe4, 0x61, 0xd2, 0x34, 0x6d, 0xdd, 0xc3, 0x86, 0xf6, 0x9b, 0x69, 0xf4, 0x12, 0xdc, 0x50,
-	0x61, 0xfc, 0x94, 0xd3, 0x6c, 0xf6, 0x89, 0x63, 0x59, 0x4e, 0xdb, 0xf3, 0x5b, 0xd8, 0x36, 0xa8,
-	0xde, 0xdf, 0x7a, 0x86, 0x4c, 0x82, 0x5d, 0x4f, 0x27, 0xcc, 0xbc, 0xcf, 0xa6, 0x51, 0x19, 0x56,
-	0x55, 0x58, 0xdb, 0x6e, 0x60, 0xdd, 0xf2, 0x1a, 0x3b, 0xda, 0xe7, 0xa6, 0x44, 0xd8, 0x8e, 0x81,
-	0xfd, 0x26, 0x6e, 0x3a, 0x64, 0xc7, 0x6f, 0x11, 0xec, 0xba, 0x6d, 0x82, 0xb5, 0x1f, 0xcb, 0x4c,
-	0x86, 0x81, 0xc1, 0x0c, 0xd3, 0xdd, 0x8a, 0x41, 0x3f, 0x9e, 0x41, 0x2f, 0xc2, 0xb5, 0x29, 0x90,
-	0xdc, 0x03, 0xb5, 0x2c, 0xfc, 0x44, 0x66, 0x32, 0x62, 0x0c, 0xda, 0xa2, 0x27, 0x42, 0x8a, 0xfb,
-	0xd8, 0x6c, 0x9d, 0x6d, 0x9b, 0x7e, 0x19, 0x6d, 0x2e, 0xe8, 0x27, 0x33, 0xe8, 0x2a, 0x5c, 0x9c,
-	0x01, 0x22, 0x58, 0xaf, 0x36, 0x18, 0xe4, 0xe3, 0x99, 0xc9, 0x3d, 0xe6, 0x66, 0xd1, 0xca, 0x86,
-	0x75, 0x63, 0x47, 0xfb, 0xa9, 0x29, 0x63, 0x6a, 0xba, 0x69, 0x61, 0xc3, 0x17, 0x8a, 0x68, 0x0c,
-	0x7f, 0x3a, 0x83, 0x3e, 0x00, 0x15, 0x15, 0x23, 0x5a, 0x03, 0x0d, 0xb9, 0x8d, 0xab, 0x9e, 0xe9,
-	0xf0, 0x5a, 0xf1, 0xb3, 0x53, 0x56, 0x4b, 0x20, 0x75, 0x6e, 0xcb, 0xb4, 0x2c, 0x6c, 0x68, 0x3f,
-	0x37, 0x15, 0xa9, 0x48, 0x9a, 0x65, 0xd2, 0x9d, 0xae, 0x61, 0xaf, 0xda, 0x60, 0xf2, 0x7e, 0x3e,
-	0x33, 0xb9, 0x41, 0x4a, 0x42, 0xc4, 0xb0, 0x5f, 0x98, 0x8a, 0x43, 0xcb, 0x31, 0x7c, 0x7a, 0x14,
-	0x4c, 0xdd, 0x32, 0x1f, 0x52, 0x17, 0xfe, 0x30, 0x43, 0x1b, 0x89, 0x3c, 0xd1, 0xbc, 0x78, 0xbf,
-	0x9d, 0x99, 0x6c, 0x3b, 0x82, 0xae, 0xbd, 0x93, 0x41, 0x37, 0xe0, 0xea, 0x0c, 0xca, 0xc4, 0x06,
-	0xbc, 0x9b, 0x41, 0xeb, 0x70, 0x7d, 0x76, 0x0e, 0x3e, 0xd0, 0x4d, 0x76, 0xa2, 0xa5, 0xcc, 0x2f,
-	0x64, 0xd0, 0x65, 0x38, 0x3f, 0x4b, 0x26, 0xde, 0xc6, 0xb6, 0xa7, 0x7d, 0x2d, 0xa3, 0xb4, 0x35,
-	0xc9, 0xf4, 0xc5, 0x0c, 0x3a, 0x05, 0x4b, 0xee, 0x8e, 0x5d, 0x8d, 0x96, 0xbe, 0x94, 0x89, 0x5b,
-	0xa2, 0x5c, 0xfb, 0x72, 0x06, 0x9d, 0x81, 0x93, 0x06, 0xde, 0x66, 0xc7, 0x5f, 0xae, 0x7e, 0x85,
-	0xad, 0x56, 0x2d, 0xac, 0xdb, 0xed, 0x56, 0xb4, 0xfa, 0x55, 0x26, 0x32, 0x01, 0xfc, 0x7a, 0x06,
-	0x9d, 0x87, 0x33, 0x13, 0x8d, 0x8a, 0x93, 0xfe, 0x9b, 0xc9, 0x60, 0x06, 0x30, 0x16, 0x1e, 0xb9,
-	0xcf, 0x2c, 0xa0, 0x35, 0xb8, 0x20, 0xf5, 0xf1, 0x52, 0x8a, 0x89, 0x98, 0x52, 0x0c, 0xdc, 0x72,
-	0xb5, 0xdf, 0xcd, 0xd2, 0xbc, 0x9b, 0x42, 0x78, 0xb4, 0xcc, 0x32, 0xc0, 0xef, 0x65, 0xe9, 0x9e,
-	0x4d, 0x01, 0x84, 0xff, 0x0c, 0xf2, 0xe9, 0xec, 0x4c, 0x2d, 0xb4, 0xfd, 0x98, 0x75, 0x0a, 0xd1,
-	0x7e, 0x3f, 0x8b, 0xae, 0xc1, 0x95, 0xd8, 0x6f, 0xb7, 0xdd, 0x6a, 0x39, 0x84, 0x76, 0xbe, 0xed,
-	0x57, 0xfc, 0xa6, 0x6e, 0x9b, 0x35, 0xec, 0x7a, 0xda, 0x1f, 0x64, 0x27, 0xcf, 0x00, 0xeb, 0xe0,
-	0x55, 0xdd, 0xae, 0x62, 0x96, 0x91, 0x9f, 0xc8, 0x4d, 0x9e, 0x01, 0x03, 0xeb, 0x86, 0x65, 0xda,
-	0xd8, 0xc7, 0xaf, 0x57, 0x31, 0x36, 0xb0, 0xa1, 0xfd, 0x62, 0x8e, 0x06, 0x82, 0x7b, 0x18, 0x73,
-	0xfe, 0x52, 0x0e, 0xad, 0x82, 0x26, 0x8c, 0x8e, 0x97, 0x3f, 0x99, 0x43, 0x17, 0xe0, 0xec, 0x44,
-	0xbf, 0x92, 0xc4, 0x5f, 0xce, 0xd1, 0x8a, 0x94, 0xec, 0xc8, 0x42, 0x9d, 0xf6, 0x2b, 0x39, 0x74,
-	0x09, 0x4a, 0xcc, 0x1b, 0x56, 0x60, 0xb1, 0xef, 0xe9, 0xf5, 0x7a, 0x34, 0x6e, 0x7c, 0x5f, 0x9e,
-	0x7a, 0xc2, 0xc8, 0x72, 0xcc, 0xf2, 0x5b, 0x7a, 0xdb, 0xe5, 0xad, 0xde, 0x21, 0xda, 0xf7, 0xe7,
-	0x69, 0x40, 0x92, 0x00, 0x65, 0x8a, 0x11, 0xa8, 0x1f, 0xc8, 0xd3, 0x54, 0x54, 0xb5, 0xc8, 0x71,
-	0x96, 0xd3, 0x7f, 0x30, 0x56, 0x23, 0xe8, 0xd1, 0xd8, 0xc8, 0x01, 0x3f, 0x34, 0x05, 0x90, 0x1b,
-	0x2b, 0x00, 0x3f, 0x9c, 0xa7, 0x71, 0xe1, 0x00, 0xd6, 0xa8, 0xf9, 0xf2, 0x47, 0x63, 0xf3, 0x04,
-	0xdf, 0x03, 0x9d, 0x1e, 0x62, 0x8f, 0x98, 0x8a, 0x97, 0x3f, 0x92, 0xa7, 0x55, 0x44, 0x45, 0xd1,
-	0x5a, 0x5e, 0xd3, 0xab, 0xaa, 0x86, 0x1f, 0xcd, 0xd3, 0x3d, 0x93, 0x91, 0x17, 0x53, 0xe8, 0x44,
-	0x39, 0xfa, 0x7c, 0x9e, 0x96, 0x8f, 0x28, 0xa5, 0x36, 0xdb, 0x75, 0xbf, 0x81, 0xad, 0x16, 0x6b,
-	0x10, 0x1e, 0x31, 0xf1, 0x36, 0xb3, 0x4b, 0xfb, 0xa7, 0x3c, 0x3a, 0x07, 0x28, 0x12, 0xc5, 0x8f,
-	0x0b, 0x25, 0xfc, 0x73, 0x9e, 0xee, 0x86, 0x20, 0xd0, 0x31, 0xd9, 0xd7, 0x5b, 0x2d, 0x6b, 0xc7,
-	0xb7, 0xf4, 0x4d, 0x6c, 0xb9, 0xda, 0xbf, 0xe4, 0xe9, 0xb1, 0x51, 0xc9, 0x72, 0x32, 0xd4, 0xfe,
-	0x55, 0xe5, 0xb4, 0x1d, 0xbf, 0x49, 0xdd, 0xa4, 0x1b, 0xc0, 0x02, 0xad, 0xfd, 0x5b, 0x1e, 0x5d,
-	0x84, 0x73, 0x2a, 0xe7, 0x36, 0x26, 0xae, 0x34, 0xfb, 0xdf, 0xf3, 0x3c, 0xef, 0x63, 0x6a, 0xd3,
-	0xb4, 0x13, 0x88, 0xff, 0xc8, 0xf3, 0xd3, 0xc5, 0x10, 0xb2, 0x7a, 0xaa, 0x80, 0xbf, 0x29, 0xf0,
-	0x83, 0x91, 0x00, 0x38, 0xb5, 0x1a, 0xcb, 0xe9, 0x26, 0xed, 0x00, 0x14, 0xf5, 0x9f, 0x79, 0x05,
-	0x85, 0x49, 0x5c, 0xb3, 0x6a, 0x0e, 0xcd, 0x49, 0x0b, 0xd3, 0x48, 0x6a, 0xff, 0xa5, 0xfa, 0x42,
-	0x9b, 0x46, 0x74, 0xb2, 0x98, 0x90, 0xb7, 0x55, 0x21, 0x8c, 0x4c, 0x70, 0xd3, 0xf1, 0x70, 0x12,
-	0xf5, 0x8e, 0x2a, 0x84, 0x0e, 0x3b, 0x49, 0xf2, 0xbb, 0x6a, 0x40, 0xa4, 0xbd, 0x51, 0x34, 0xbf,
-	0xc0, 0xf2, 0x35, 0xa2, 0x8a, 0x4b, 0x4a, 0x4c, 0xff, 0x62, 0xd2, 0xc2, 0x96, 0xa5, 0x57, 0xb1,
-	0x98, 0x65, 0x28, 0xf9, 0x4b, 0x6a, 0xaa, 0x78, 0x44, 0xb7, 0xdd, 0x9a, 0x43, 0x9a, 0x49, 0x03,
-	0xbe, 0xac, 0xee, 0xa5, 0x8b, 0x3d, 0xbe, 0xc7, 0x8c, 0xf4, 0x15, 0x55, 0x7b, 0xc4, 0xf4, 0x80,
-	0x98, 0x1e, 0x17, 0xff, 0x55, 0x35, 0xcb, 0x5a, 0x3a, 0x71, 0x15, 0xd7, 0x99, 0x11, 0x7c, 0xce,
-	0xfe, 0x5a, 0x1e, 0xdd, 0x84, 0x17, 0xd4, 0x5d, 0x15, 0xc9, 0x6d, 0xf3, 0x91, 0x2c, 0x9e, 0x0f,
-	0xbe, 0x9e, 0x5f, 0xff, 0x54, 0x11, 0x56, 0x92, 0xd7, 0x60, 0x7a, 0x99, 0xb2, 0x4d, 0x4b, 0x3b,
-	0x41, 0xef, 0x21, 0xba, 0x41, 0xcb, 0x6c, 0x4d, 0x6f, 0x5b, 0xb4, 0x2e, 0xb6, 0x1c, 0x6d, 0x8f,
-	0x5e, 0x16, 0x65, 0xe9, 0x52, 0xd6, 0x03, 0x3a, 0x37, 0x4f, 0xaf, 0xfb, 0x75, 0xcb, 0xd9, 0xd4,
-	0x2d, 0x51, 0x4a, 0xb5, 0xc7, 0x74, 0x86, 0xaf, 0x57, 0x2d, 0xa7, 0x1d, 0x55, 0x24, 0x7a, 0x4d,
-	0x11, 0x64, 0x3a, 0x8e, 0x74, 0xe9, 0x1d, 0x72, 0x36, 0xe9, 0x0d, 0x7a, 0x1d, 0xe4, 0x2a, 0x84,
-	0x08, 0x71, 0xc3, 0xd2, 0x7a, 0x31, 0x45, 0xb0, 0xca, 0xcb, 0xd4, 0x87, 0xa8, 0xb9, 0x35, 0xf3,
-	0x75, 0xbe, 0x85, 0xbc, 0x14, 0xf2, 0x4b, 0xcf, 0x59, 0x40, 0x02, 0x2b, 0xc7, 0x74, 0x8f, 0xec,
-	0x68, 0x7d, 0x7a, 0x85, 0xa0, 0x78, 0x65, 0xea, 0x8f, 0x6a, 0x82, 0x70, 0x62, 0x5f, 0x62, 0xdc,
-	0x2d, 0xbd, 0x56, 0x73, 0x2c, 0x23, 0x6a, 0x14, 0xd1, 0x85, 0x42, 0x1b, 0x50, 0x47, 0x29, 0x46,
-	0x19, 0xe9, 0xa5, 0x27, 0x3a, 0x4b, 0xf6, 0x21, 0xba, 0x0e, 0x57, 0x29, 0x62, 0xee, 0x0c, 0xcd,
-	0x66, 0xed, 0x03, 0x3a, 0xc7, 0x27, 0x5c, 0x9b, 0x06, 0x4a, 0x67, 0xdf, 0xa4, 0xc9, 0x29, 0xa6,
-	0x83, 0xa9, 0x3a, 0x45, 0x6f, 0xb3, 0x65, 0x58, 0xe5, 0xe4, 0xa8, 0x64, 0xf3, 0x56, 0x44, 0x2f,
-	0xb5, 0xac, 0x55, 0xbb, 0x9e, 0x6e, 0x59, 0x2c, 0x67, 0xb4, 0x3f, 0x63, 0x4b, 0xed, 0x16, 0xbd,
-	0x74, 0x60, 0xbe, 0xf4, 0xe7, 0x29, 0x74, 0x1b, 0x5e, 0x9a, 0xe5, 0x39, 0x2f, 0x59, 0x32, 0x4e,
-	0xce, 0x36, 0x26, 0xc4, 0x34, 0xb0, 0xab, 0xfd, 0x05, 0xbb, 0x41, 0xab, 0x42, 0xee, 0xde, 0xd1,
-	0xfe, 0x32, 0x85, 0x36, 0xe0, 0xc5, 0xb9, 0x62, 0x64, 0xb2, 0xea, 0x4d, 0xec, 0xb6, 0xf4, 0x2a,
-	0xd6, 0xfe, 0x2a, 0x45, 0x1b, 0xa2, 0x34, 0x4e, 0xbe, 0x15, 0xfc, 0x7d, 0x8a, 0x9e, 0xa3, 0xc9,
-	0x59, 0xc8, 0x72, 0xea, 0x2e, 0xbd, 0x28, 0x44, 0x9e, 0xd2, 0x3a, 0x61, 0xda, 0xf4, 0x82, 0xde,
-	0x22, 0xce, 0x26, 0xa6, 0x17, 0x84, 0x88, 0x16, 0xb3, 0xb1, 0xd3, 0x43, 0x6f, 0x05, 0x57, 0xe1,
-	0xa2, 0x6e, 0x18, 0x74, 0x36, 0x9e, 0x3b, 0xa1, 0x5f, 0x81, 0x72, 0x02, 0x32, 0x35, 0x9d, 0x5f,
-	0x87, 0xb5, 0x04, 0x60, 0xce, 0x64, 0x7e, 0x19, 0xce, 0x27, 0x60, 0x93, 0x53, 0xf9, 0xa4, 0x9e,
-	0xa9, 0x89, 0xfc, 0x12, 0x94, 0x26, 0x00, 0x89, 0x69, 0xfc, 0x02, 0x9c, 0x4d, 0x9a, 0xa1, 0x4e,
-	0xe2, 0x8a, 0xf2, 0x99, 0x53, 0x78, 0x14, 0xa3, 0x86, 0xe3, 0x7a, 0x6a, 0x16, 0xfd, 0x0c, 0x1b,
-	0x1e, 0xd9, 0xa5, 0x27, 0xca, 0x22, 0x3a, 0xc5, 0xae, 0x82, 0xd6, 0xb6, 0xd9, 0x84, 0x10, 0x2f,
-	0xbf, 0xcb, 0x46, 0x3a, 0x7a, 0x49, 0x13, 0xa9, 0x4b, 0xef, 0x7b, 0xda, 0xa7, 0x16, 0xd8, 0x0c,
-	0x84, 0xa9, 0x35, 0x36, 0x1d, 0x05, 0x6a, 0x96, 0x5e, 0x8f, 0x5a, 0x46, 0x4d, 0xb7, 0x5c, 0xac,
-	0xfd, 0xdd, 0x02, 0x3a, 0x09, 0xe0, 0xb4, 0xb0, 0xed, 0x9b, 0xae, 0xdb, 0xc6, 0xda, 0xf7, 0xe6,
-	0xef, 0xfc, 0x76, 0x16, 0x4e, 0xba, 0xe2, 0xef, 0x21, 0xdd, 0x60, 0xf4, 0xb4, 0xb7, 0x1b, 0xa0,
-	0x2a, 0x14, 0xea, 0x41, 0x28, 0xfe, 0x64, 0x61, 0xea, 0x4d, 0x15, 0xef, 0x1f, 0x84, 0x47, 0xe5,
-	0xc4, 0x5f, 0x2c, 0x56, 0x4e, 0x7d, 0xcf, 0x5f, 0x7f, 0xf6, 0x63, 0xe9, 0x45, 0x54, 0xbc, 0xf5,
-	0xf4, 0x95, 0x5b, 0xec, 0xc9, 0x12, 0xd5, 0xa1, 0xc0, 0x5e, 0x54, 0xad, 0x61, 0x17, 0xc9, 0xff,
-	0x8d, 0x94, 0x8f, 0xb7, 0xe5, 0xc9, 0x85, 0xca, 0x2a, 0x13, 0x70, 0x12, 0x2d, 0x53, 0x01, 0xfc,
-	0x3f, 0x93, 0xfb, 0xc3, 0xee, 0xcd, 0xd4, 0xed, 0x14, 0xaa, 0x43, 0x8e, 0x09, 0x1a, 0xcf, 0xb5,
-	0x65, 0x4a, 0x1a, 0x62, 0xd2, 0x96, 0x10, 0x44, 0xd2, 0xc6, 0xb7, 0x53, 0xe8, 0x75, 0xc8, 0xe3,
-	0x0f, 0x07, 0xbb, 0x87, 0x61, 0x80, 0x4a, 0x82, 0x63, 0xea, 0x35, 0xb7, 0x3c, 0x47, 0x47, 0xe5,
-	0x02, 0x13, 0xb9, 0x5a, 0x59, 0x64, 0x22, 0xb9, 0x98, 0x7b, 0xe2, 0x6d, 0x17, 0x75, 0xa0, 0xa8,
-	0x1f, 0x86, 0x43, 0xf6, 0xf4, 0x87, 0x56, 0x93, 0xef, 0xb8, 0xc7, 0x09, 0xbe, 0xce, 0x04, 0x5f,
-	0x29, 0x9f, 0xa5, 0x82, 0xd9, 0xd3, 0xec, 0xad, 0xce, 0x61, 0x38, 0xf4, 0xa5, 0x0e, 0xfe, 0x02,
-	0x8c, 0x7c, 0x28, 0x50, 0x15, 0xee, 0xd1, 0x60, 0xf7, 0x79, 0x35, 0x5c, 0x63, 0x1a, 0x2e, 0x97,
-	0x57, 0xd9, 0xe6, 0x1c, 0x0d, 0x76, 0x67, 0x2a, 0xd8, 0x05, 0xa0, 0x0a, 0xf8, 0xc3, 0xe3, 0xf3,
-	0xaa, 0xb8, 0xc1, 0x54, 0xac, 0x95, 0xcf, 0x51, 0x15, 0xfc, 0xd1, 0x78, 0xa6, 0x12, 0x0b, 0x72,
-	0x8d, 0xce, 0x60, 0xaf, 0x1f, 0xa0, 0xc4, 0xab, 0xfb, 0x5c, 0xb9, 0x17, 0x99, 0xdc, 0xb3, 0x95,
-	0x53, 0xf1, 0x46, 0xde, 0x7a, 0x83, 0x09, 0xb8, 0x97, 0x5a, 0x7f, 0x94, 0x63, 0xe8, 0xbb, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x84, 0x69, 0xe1, 0xf8, 0xd1, 0x2b, 0x00, 0x00,
+	// 4776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x6b, 0x8c, 0x1b, 0xc9,
+	0x71, 0x16, 0x39, 0xcb, 0x25, 0x59, 0xfb, 0xd0, 0xa8, 0xa5, 0x95, 0x56, 0xd4, 0x6b, 0x8f, 0x27,
+	0xc9, 0xf2, 0xde, 0x65, 0x75, 0x8f, 0x20, 0x70, 0x94, 0xbb, 0x04, 0xb3, 0x9c, 0xe6, 0xee, 0x68,
+	0x87, 0x33, 0x4c, 0xcf, 0x70, 0x75, 0x12, 0x10, 0x10, 0xd4, 0xee, 0x68, 0xc5, 0x3b, 0x2e, 0xb9,
+	0x47, 0x72, 0x75, 0x96, 0xf3, 0xc0, 0xc5, 0xb1, 0xe3, 0x24, 0x4e, 0x90, 0x38, 0xce, 0xf9, 0x9c,
+	0xf7, 0x39, 0x89, 0x11, 0x20, 0xc8, 0xd9, 0xf1, 0xf9, 0xf5, 0x2b, 0xb1, 0x83, 0xfc, 0x48, 0xec,
+	0xbc, 0x11, 0x04, 0xc8, 0x13, 0x41, 0x00, 0x1b, 0x81, 0xf3, 0x46, 0x7c, 0x77, 0xb6, 0xcf, 0xaf,
+	0xa0, 0xba, 0x7b, 0x66, 0x7a, 0x86, 0xa4, 0x74, 0x72, 0x70, 0xc8, 0x2f, 0xb1, 0xbb, 0xbe, 0xaa,
+	0xae, 0xaa, 0xae, 0xae, 0xaa, 0xee, 0x59, 0xc1, 0xfc, 0xe0, 0xa9, 0xd6, 0x8d, 0x1b, 0xbd, 0xce,
+	0xf6, 0xca, 0x5e, 0xbf, 0x37, 0xec, 0x91, 0x1c, 0xff, 0xa7, 0x74, 0x72, 0xa7, 0xd7, 0xdb, 0xe9,
+	0x04, 0x17, 0x5b, 0x7b, 0xed, 0x8b, 0xad, 0x6e, 0xb7, 0x37, 0x6c, 0x0d, 0xdb, 0xbd, 0xee, 0x40,
+	0x80, 0x4a, 0x67, 0x24, 0x95, 0x8f, 0xae, 0xef, 0xdf, 0xb8, 0x38, 0x6c, 0xef, 0x06, 0x83, 0x61,
+	0x6b, 0x77, 0x4f, 0x02, 0x4e, 0xa4, 0x01, 0xc1, 0xee, 0xde, 0xf0, 0xb6, 0x20, 0x96, 0x1f, 0x85,
+	0x39, 0x6f, 0xd8, 0x1a, 0x06, 0x2c, 0x18, 0xec, 0xf5, 0xba, 0x83, 0x80, 0x94, 0x21, 0x37, 0xc0,
+	0x89, 0xc5, 0xcc, 0x52, 0xe6, 0xc2, 0xcc, 0x23, 0xb3, 0x02, 0xb7, 0x22, 0x40, 0x82, 0x54, 0x3e,
+	0x09, 0x85, 0x08, 0xaf, 0x83, 0xb6, 0x3b, 0xd8, 0xe1, 0xe8, 0x22, 0xc3, 0x9f, 0xe5, 0x53, 0x90,
+	0x67, 0xc1, 0xd3, 0xfb, 0xc1, 0x60, 0x48, 0x08, 0x4c, 0x75, 0x5b, 0xbb, 0x81, 0xa4, 0xf2, 0xdf,
+	0xe5, 0xe7, 0xa6, 0x20, 0xc7, 0xa5, 0x91, 0x87, 0x01, 0xae, 0xef, 0xb7, 0x3b, 0xdb, 0x9e, 0xb2,
+	0xde, 0x21, 0xb9, 0xde, 0x6a, 0x44, 0x60, 0x0a, 0x88, 0x7c, 0x3b, 0xcc, 0x6c, 0x07, 0x7b, 0x9d,
+	0xde, 0x6d, 0xc1, 0x93, 0xe5, 0x3c, 0x44, 0xf2, 0x98, 0x31, 0x85, 0xa9, 0x30, 0xb2, 0x0e, 0xf3,
+	0x37, 0x7a, 0xfd, 0x67, 0x5a, 0xfd, 0xed, 0x60, 0xbb, 0xde, 0xeb, 0x0f, 0x07, 0x8b, 0x53, 0x4b,
+	0xda, 0x85, 0x99, 0x47, 0x96, 0x54, 0xe3, 0x56, 0xaa, 0x09, 0x08, 0xed, 0x0e, 0xfb, 0xb7, 0x59,
+	0x8a, 0x8f, 0x54, 0x40, 0x47, 0x17, 0xec, 0x0f, 0x2a, 0x37, 0x83, 0xad, 0xa7, 0x84, 0x12, 0x39,
+	0xae, 0xc4, 0x31, 0x45, 0x96, 0x4a, 0x66, 0x23, 0x0c, 0xe4, 0x12, 0xcc, 0xdd, 0x68, 0x77, 0x02,
+	0xef, 0x76, 0x77, 0x4b, 0x48, 0x98, 0xe6, 0x12, 0x8e, 0x48, 0x09, 0x55, 0x95, 0xc6, 0x92, 0x50,
+	0x52, 0x87, 0xc3, 0xdb, 0xc1, 0xf5, 0xfd, 0x9d, 0x9d, 0x76, 0x77, 0xa7, 0xd2, 0xeb, 0x0e, 0x5b,
+	0xed, 0x6e, 0xd0, 0x1f, 0x2c, 0xe6, 0xb9, 0x3d, 0xa7, 0x23, 0x47, 0xa4, 0x11, 0xf4, 0x56, 0xd0,
+	0x1d, 0xb2, 0x71, 0xac, 0xe4, 0x01, 0x28, 0xec, 0x06, 0xc3, 0xd6, 0x76, 0x6b, 0xd8, 0x5a, 0x2c,
+	0x70, 0x45, 0x0e, 0x4a, 0x31, 0x35, 0x39, 0xcd, 0x22, 0x40, 0xc9, 0x83, 0xc3, 0x63, 0xdc, 0x84,
+	0x41, 0xf0, 0x54, 0x70, 0x9b, 0x6f, 0x61, 0x8e, 0xe1, 0x4f, 0x72, 0x1e, 0x72, 0xb7, 0x5a, 0x9d,
+	0xfd, 0x70, 0x8b, 0x74, 0x29, 0x12, 0x79, 0x84, 0x2e, 0x82, 0x7c, 0x29, 0xfb, 0x96, 0xcc, 0xe5,
+	0xa9, 0x82, 0xa6, 0x4f, 0x95, 0x3f, 0x9f, 0x81, 0x42, 0xb8, 0x22, 0x59, 0x86, 0x1c, 0xdf, 0x75,
+	0x19, 0x15, 0x47, 0xd4, 0xa8, 0x88, 0xd4, 0x12, 0x10, 0xf2, 0x6d, 0x30, 0x2d, 0x36, 0x5b, 0xae,
+	0xb5, 0x90, 0x08, 0x87, 0x08, 0x2d, 0x41, 0xe4, 0x7b, 0x00, 0x5a, 0xdb, 0xdb, 0x6d, 0x3c, 0x42,
+	0xad, 0xce, 0xe2, 0x16, 0x77, 0xdc, 0x99, 0x94, 0xc5, 0x2b, 0x46, 0x84, 0x10, 0x71, 0xa0, 0xb0,
+	0x94, 0x1e, 0x87, 0x83, 0x29, 0xb2, 0x6a, 0x7f, 0x51, 0xd8, 0x7f, 0x44, 0xb5, 0xbf, 0xa8, 0x58,
+	0x5b, 0x7e, 0x25, 0x0b, 0x73, 0x09, 0x3b, 0xc8, 0x83, 0x70, 0xa8, 0xbb, 0xbf, 0x7b, 0x3d, 0xe8,
+	0xbb, 0x37, 0x8c, 0xfe, 0xb0, 0x7d, 0xa3, 0xb5, 0x35, 0x1c, 0x48, 0x5f, 0x8e, 0x12, 0xc8, 0xe3,
+	0x50, 0xe0, 0x76, 0xe3, 0xb6, 0x67, 0xb9, 0xf6, 0xf7, 0x8d, 0xf3, 0xce, 0x8a, 0xb5, 0xdb, 0xda,
+	0x09, 0x56, 0x05, 0x92, 0x45, 0x2c, 0xe4, 0x2c, 0x4c, 0x0d, 0x6f, 0xef, 0x05, 0x8b, 0xda, 0x52,
+	0xe6, 0xc2, 0x7c, 0xb4, 0x2f, 0x1c, 0xe7, 0xdf, 0xde, 0x0b, 0x18, 0xa7, 0x12, 0x73, 0x8c, 0x93,
+	0xce, 0x8e, 0x5d, 0xe6, 0x4e, 0x9e, 0xb2, 0x61, 0x56, 0xd5, 0x82, 0x9c, 0x97, 0x6b, 0x67, 0xf8,
+	0xda, 0x44, 0x95, 0x17, 0xf4, 0x95, 0xd5, 0x8f, 0x40, 0x6e, 0xab, 0xb7, 0xdf, 0x1d, 0x72, 0xe7,
+	0xe5, 0x98, 0x18, 0xfc, 0x5f, 0xfd, 0xfe, 0x87, 0x19, 0x98, 0x4f, 0x86, 0x04, 0x79, 0x0c, 0x8a,
+	0x22, 0x28, 0xd0, 0x97, 0x99, 0xd4, 0x11, 0x52, 0x91, 0x72, 0x18, 0xf4, 0x59, 0xcc, 0x40, 0x1e,
+	0x84, 0xfc, 0x56, 0x67, 0x7f, 0x30, 0x0c, 0xfa, 0x7c, 0xb1, 0xd8, 0xa0, 0x8a, 0x98, 0xe5, 0x06,
+	0x85, 0x90, 0x92, 0x05, 0x85, 0x50, 0x08, 0x79, 0x53, 0xc2, 0x0f, 0x87, 0x13, 0x4b, 0xde, 0xdd,
+	0x11, 0xe5, 0x7f, 0xca, 0x00, 0xc4, 0xf9, 0x91, 0x7c, 0x37, 0x14, 0x5b, 0x4a, 0xd8, 0xa8, 0x89,
+	0x2d, 0x46, 0xad, 0x44, 0x01, 0x24, 0xb6, 0x29, 0x66, 0x21, 0x4b, 0x30, 0xd3, 0xda, 0x1f, 0xf6,
+	0xfc, 0x7e, 0x7b, 0x67, 0x47, 0xda, 0x52, 0x60, 0xea, 0x14, 0x26, 0x6a, 0x99, 0xc4, 0x7a, 0xdb,
+	0x61, 0xe4, 0x1c, 0x4a, 0xe6, 0xbb, 0xde, 0x76, 0xc0, 0x14, 0x50, 0xe9, 0x31, 0x98, 0x4f, 0xae,
+	0x78, 0x4f, 0x7b, 0xf5, 0x36, 0x98, 0x51, 0x92, 0x39, 0x39, 0x0a, 0xd3, 0x42, 0xb4, 0xe4, 0x96,
+	0xa3, 0x37, 0x44, 0xf3, 0xf2, 0x3f, 0x67, 0x40, 0x4f, 0x27, 0xf1, 0x89, 0x1a, 0x98, 0x50, 0xec,
+	0x07, 0x83, 0xde, 0x7e, 0x7f, 0x2b, 0x08, 0x4f, 0xe3, 0xf9, 0x09, 0x85, 0x60, 0x85, 0x85, 0x40,
+	0xb9, 0x03, 0x11, 0xe3, 0xb7, 0xe8, 0xdf, 0xa4, 0xbc, 0x7b, 0xf2, 0xaf, 0x05, 0x73, 0x89, 0x2a,
+	0xf3, 0xad, 0x7b, 0xb8, 0xfc, 0x5b, 0xd3, 0x90, 0xe3, 0x19, 0x9d, 0x3c, 0x04, 0x45, 0xac, 0x13,
+	0x7c, 0x20, 0xf3, 0xb6, 0xae, 0xe4, 0x55, 0x3e, 0xbf, 0x7e, 0x80, 0xc5, 0x20, 0xf2, 0xa8, 0x6c,
+	0x00, 0x04, 0x4b, 0x76, 0xb4, 0x01, 0x08, 0x79, 0x14, 0x18, 0xf9, 0x8e, 0xb0, 0x05, 0x10, 0x5c,
+	0xda, 0x98, 0x16, 0x20, 0x64, 0x53, 0x81, 0xa8, 0xde, 0x5e, 0x58, 0x7d, 0x16, 0xa7, 0xc6, 0x57,
+	0x25, 0x54, 0x2f, 0x02, 0x11, 0x9a, 0x28, 0xf6, 0x82, 0x71, 0x62, 0xb1, 0x0f, 0xf9, 0x47, 0x58,
+	0xc8, 0xf7, 0xc1, 0x62, 0xb8, 0xd5, 0x69, 0xbc, 0xac, 0xfc, 0x61, 0xf9, 0x61, 0x13, 0x60, 0xeb,
+	0x07, 0xd8, 0x44, 0x11, 0xe4, 0xb1, 0xb8, 0x9b, 0x10, 0x32, 0xf3, 0x63, 0xbb, 0x89, 0x50, 0x50,
+	0x12, 0x4c, 0xae, 0xc1, 0xb1, 0xed, 0xf1, 0xdd, 0x82, 0x6c, 0x06, 0xee, 0xd2, 0x53, 0xac, 0x1f,
+	0x60, 0x93, 0x04, 0x90, 0xef, 0x84, 0xd9, 0xed, 0xe0, 0x96, 0xdd, 0xeb, 0xed, 0x09, 0x81, 0x45,
+	0x2e, 0x30, 0x4e, 0x77, 0x31, 0x69, 0xfd, 0x00, 0x4b, 0x40, 0xd1, 0xf5, 0xc3, 0xa0, 0xbf, 0xdb,
+	0xee, 0xf2, 0x56, 0x57, 0xb0, 0x43, 0xc2, 0xf5, 0x7e, 0x8a, 0x8c, 0xae, 0x4f, 0xb3, 0xe0, 0x9e,
+	0x0f, 0x83, 0x81, 0xdc, 0xf3, 0x99, 0xc4, 0x9e, 0xfb, 0xe1, 0x3c, 0xee, 0x79, 0x04, 0x22, 0x0e,
+	0x1c, 0x6e, 0xed, 0xed, 0x75, 0xda, 0x5b, 0x5c, 0x8a, 0xdd, 0xdb, 0x11, 0xbc, 0xb3, 0x9c, 0xb7,
+	0x24, 0x79, 0x8d, 0x51, 0xc4, 0xfa, 0x01, 0x36, 0x8e, 0x71, 0x75, 0x16, 0x20, 0xc0, 0x1f, 0x4d,
+	0xcc, 0xe7, 0xe5, 0x2f, 0x66, 0xe0, 0xf0, 0x18, 0x66, 0xb2, 0x08, 0xf9, 0xbd, 0xde, 0xb6, 0x13,
+	0xb7, 0xca, 0xe1, 0x90, 0x9c, 0x85, 0xb9, 0xad, 0xd0, 0xab, 0x9c, 0x2e, 0xce, 0x72, 0x72, 0x92,
+	0x9c, 0x84, 0x22, 0xf6, 0xd6, 0x83, 0xbd, 0xd6, 0x96, 0xc8, 0x1f, 0x45, 0x16, 0x4f, 0x60, 0x1e,
+	0x68, 0x63, 0x19, 0xe6, 0x51, 0x5f, 0x64, 0x62, 0x80, 0x3c, 0xd1, 0x4d, 0x81, 0x87, 0x75, 0x91,
+	0xc5, 0x13, 0xc8, 0xd3, 0x09, 0x6e, 0x05, 0x1d, 0x1e, 0xa1, 0x45, 0x26, 0x06, 0xa8, 0xe7, 0x6e,
+	0x30, 0x18, 0xa0, 0xac, 0xbc, 0xd0, 0x53, 0x0e, 0x49, 0x09, 0x0a, 0x03, 0x6c, 0xfa, 0xbb, 0x5b,
+	0x01, 0x0f, 0x1c, 0x8d, 0x45, 0xe3, 0x32, 0x03, 0x3d, 0xbd, 0x5b, 0x13, 0x13, 0xce, 0x79, 0xd0,
+	0x82, 0x7e, 0x5f, 0xe6, 0x82, 0x30, 0x86, 0x8d, 0x2d, 0x5e, 0xf4, 0xaf, 0x77, 0x02, 0xda, 0xef,
+	0x33, 0x04, 0x94, 0x3b, 0x30, 0xab, 0x06, 0x10, 0x5a, 0xd3, 0x1e, 0x06, 0x7d, 0xbe, 0x82, 0xec,
+	0x9d, 0xe2, 0x09, 0x65, 0xb5, 0xec, 0xb8, 0xd5, 0xb4, 0xbb, 0xad, 0xf6, 0xee, 0x0c, 0xcc, 0x25,
+	0xa6, 0xc9, 0x03, 0x90, 0x0f, 0xfa, 0x7d, 0x9e, 0xaf, 0x33, 0x93, 0xf2, 0x75, 0x88, 0x50, 0xdd,
+	0x96, 0x4d, 0xba, 0xed, 0x51, 0x98, 0x19, 0xec, 0xef, 0xec, 0x04, 0x03, 0x7e, 0xa3, 0x5b, 0xd4,
+	0x78, 0x05, 0x89, 0x44, 0x45, 0x14, 0xa6, 0xa2, 0xca, 0x0e, 0x14, 0xa3, 0x84, 0x8a, 0x1b, 0x15,
+	0x60, 0xfe, 0x97, 0x7e, 0x14, 0x83, 0x44, 0x53, 0x9f, 0xbd, 0x4b, 0x53, 0x5f, 0xfe, 0x97, 0xb0,
+	0x9f, 0x10, 0x12, 0x4b, 0x50, 0x08, 0x9b, 0x03, 0x29, 0x34, 0x1a, 0x4f, 0x74, 0xa4, 0x1e, 0x3b,
+	0xb2, 0xc8, 0x5d, 0xa6, 0x3a, 0x68, 0xea, 0xae, 0x0e, 0xba, 0x04, 0x73, 0x2d, 0xd5, 0xbd, 0x32,
+	0xcd, 0x8e, 0xdf, 0x91, 0x24, 0x94, 0x2c, 0x83, 0xbe, 0xd5, 0xda, 0xba, 0x19, 0xd4, 0xda, 0x83,
+	0x01, 0x0b, 0x5a, 0x03, 0xf4, 0xe3, 0xf4, 0x92, 0x76, 0xa1, 0xc8, 0x46, 0xe6, 0xcb, 0x3f, 0x9d,
+	0x81, 0x62, 0x74, 0xf0, 0xef, 0x68, 0x68, 0x78, 0x73, 0xcd, 0xc6, 0x37, 0x57, 0xc5, 0x78, 0x2d,
+	0x61, 0xfc, 0x88, 0xf6, 0x53, 0xaf, 0x5b, 0xfb, 0xf2, 0x0b, 0x99, 0xb0, 0xd5, 0xb9, 0xf3, 0xb9,
+	0xd0, 0xe3, 0x73, 0x31, 0xea, 0x60, 0xed, 0xde, 0x1d, 0x7c, 0x0f, 0x2a, 0x7e, 0x2a, 0xd9, 0x10,
+	0xdd, 0x59, 0xcf, 0xc9, 0xa1, 0xfe, 0xff, 0x17, 0x22, 0xe5, 0x2f, 0x64, 0x60, 0x71, 0x52, 0x6d,
+	0xc5, 0x28, 0x08, 0x6b, 0x6b, 0x18, 0x05, 0xe1, 0x78, 0x62, 0xb8, 0x2b, 0x56, 0x6a, 0x63, 0xad,
+	0x9c, 0x8a, 0xad, 0x4c, 0x36, 0x77, 0xb9, 0xd7, 0xd1, 0xdc, 0x8d, 0xda, 0x3a, 0xfd, 0xfa, 0x6d,
+	0x7d, 0x51, 0x83, 0x62, 0xd4, 0xcf, 0x60, 0x5a, 0xec, 0xf4, 0xb6, 0x5a, 0x1d, 0x9c, 0x09, 0xd3,
+	0x62, 0x34, 0x41, 0x4e, 0x03, 0xf4, 0x83, 0xdd, 0xde, 0x30, 0xe0, 0x64, 0x71, 0xc7, 0x50, 0x66,
+	0xd4, 0xb2, 0xa4, 0xdd, 0xa5, 0x2c, 0x4d, 0xdd, 0xb5, 0x2c, 0xe5, 0xd2, 0x65, 0xa9, 0x04, 0x05,
+	0xec, 0xb5, 0x38, 0xbb, 0xa8, 0x32, 0xd1, 0x98, 0x94, 0x61, 0x36, 0xdc, 0x04, 0xbc, 0x0e, 0xc9,
+	0x6a, 0x93, 0x98, 0x53, 0x31, 0x5c, 0x46, 0x21, 0x89, 0xe1, 0x72, 0x16, 0x21, 0xdf, 0xda, 0xde,
+	0xee, 0x07, 0x83, 0x01, 0xef, 0x3e, 0x8a, 0x2c, 0x1c, 0x92, 0x47, 0x00, 0x86, 0xad, 0xfe, 0x4e,
+	0x30, 0xe4, 0xb6, 0x43, 0xa2, 0x8b, 0xb4, 0xba, 0x43, 0xb7, 0xef, 0x0d, 0xfb, 0xed, 0xee, 0x0e,
+	0x53, 0x50, 0x4a, 0x38, 0xcc, 0xdc, 0x39, 0x01, 0xcc, 0xbe, 0xfe, 0xfd, 0x7a, 0x36, 0x1b, 0xf7,
+	0xe2, 0xd1, 0x9e, 0x61, 0x8f, 0x56, 0xe1, 0x17, 0x3f, 0xb9, 0x67, 0xd1, 0x44, 0x5c, 0xcc, 0xb3,
+	0x6a, 0x31, 0x9f, 0x94, 0x9a, 0x46, 0xc3, 0x51, 0x39, 0x74, 0xb9, 0x7b, 0x3f, 0x74, 0xaf, 0x3f,
+	0x10, 0xc9, 0x05, 0x1e, 0x3c, 0x68, 0x96, 0xec, 0x48, 0xe7, 0xa3, 0x6e, 0x9b, 0xcf, 0xb2, 0x90,
+	0x5c, 0x7e, 0x57, 0x06, 0xf2, 0x72, 0xf2, 0x0e, 0x9d, 0x50, 0x22, 0x98, 0xb2, 0xe9, 0x60, 0x1a,
+	0x09, 0x48, 0x6d, 0x5c, 0x40, 0x9e, 0x06, 0xd8, 0xde, 0x17, 0x3d, 0x41, 0x6d, 0xc0, 0xbd, 0xa2,
+	0x31, 0x65, 0xa6, 0xfc, 0x72, 0x16, 0x8e, 0x4d, 0x68, 0x74, 0xef, 0x94, 0xf1, 0x42, 0x8d, 0xb3,
+	0x77, 0x39, 0x24, 0xda, 0x5d, 0x0f, 0xc9, 0xd4, 0x98, 0x43, 0x12, 0xd5, 0xa8, 0x5c, 0xaa, 0x46,
+	0x2d, 0x42, 0xbe, 0xbf, 0xdf, 0xc5, 0x9e, 0x4d, 0x9e, 0x9f, 0x70, 0x88, 0x76, 0x3e, 0xd3, 0xeb,
+	0x3f, 0xd5, 0xee, 0xee, 0x98, 0xed, 0xbe, 0x3c, 0x3c, 0xca, 0x0c, 0x71, 0x00, 0x78, 0xd3, 0x2e,
+	0x1e, 0x43, 0x0b, 0xbc, 0xeb, 0x58, 0xb9, 0x73, 0xa3, 0x2f, 0xe6, 0x95, 0xa7, 0x51, 0x45, 0x42,
+	0xe9, 0x71, 0x38, 0x98, 0x22, 0xdf, 0xed, 0x3a, 0x3a, 0xa7, 0x5e, 0x47, 0x7f, 0x08, 0x0a, 0xd8,
+	0x0a, 0x73, 0xbe, 0xb7, 0xa8, 0x6d, 0x69, 0x46, 0xb6, 0xdd, 0xe2, 0x05, 0x7b, 0x25, 0x7c, 0xc1,
+	0x5e, 0xf1, 0x43, 0x84, 0xda, 0xb2, 0x96, 0x21, 0x17, 0x28, 0x17, 0xc9, 0xf0, 0xe5, 0x5a, 0x3e,
+	0x37, 0x06, 0xc9, 0x6e, 0x49, 0x53, 0xba, 0xa5, 0xf2, 0x25, 0x38, 0xd4, 0x18, 0x04, 0x7d, 0xab,
+	0x3b, 0x44, 0xa8, 0x7c, 0xbb, 0x3e, 0x07, 0xd3, 0x6d, 0x3e, 0x21, 0xb5, 0x98, 0x8b, 0x93, 0x03,
+	0xa2, 0x24, 0xb1, 0xfc, 0x5d, 0x30, 0x2f, 0xaf, 0xc2, 0x21, 0xe3, 0x9b, 0x93, 0x2f, 0xe8, 0xe1,
+	0x7d, 0x47, 0xa2, 0x12, 0x0f, 0xe9, 0x0f, 0xc3, 0xac, 0x3a, 0x4d, 0x4a, 0x90, 0x0f, 0xf8, 0x01,
+	0x12, 0x0f, 0x9f, 0x85, 0xf5, 0x03, 0x2c, 0x9c, 0x58, 0xcd, 0x81, 0x76, 0xab, 0xd5, 0x29, 0x5f,
+	0x86, 0x69, 0xa1, 0x01, 0xda, 0x12, 0xbf, 0x91, 0x16, 0xc2, 0xd7, 0x50, 0x02, 0x53, 0x03, 0x3c,
+	0x73, 0xe2, 0xaa, 0xce, 0x7f, 0x63, 0xe8, 0xca, 0x17, 0x52, 0x8d, 0xcf, 0xca, 0x51, 0x79, 0x0b,
+	0x20, 0xee, 0x31, 0xc9, 0xe3, 0x30, 0x1f, 0x77, 0x99, 0x4a, 0x67, 0xbb, 0x30, 0xd2, 0x8e, 0xf2,
+	0x24, 0x91, 0x02, 0xe3, 0x22, 0x22, 0x01, 0x84, 0xb5, 0x52, 0x8c, 0xca, 0xef, 0xc9, 0xc0, 0x82,
+	0x31, 0x1c, 0xb6, 0xb6, 0x6e, 0x8a, 0xc8, 0x8a, 0x1d, 0xf5, 0xc6, 0xde, 0x7a, 0xd4, 0xf2, 0x32,
+	0x95, 0x2c, 0x2f, 0xe5, 0x4f, 0x67, 0xe1, 0x68, 0x5a, 0x27, 0xf9, 0x3d, 0xe3, 0x0d, 0x57, 0x2a,
+	0x3a, 0xce, 0x53, 0x93, 0x8f, 0x73, 0xee, 0x4e, 0xc7, 0x79, 0x7a, 0xe4, 0x38, 0xa3, 0xa9, 0xb8,
+	0x45, 0x5b, 0xbd, 0x8e, 0x3c, 0xec, 0xd1, 0x58, 0xad, 0x80, 0x85, 0x64, 0x05, 0x4c, 0xf4, 0x06,
+	0xc5, 0x3b, 0xf7, 0x06, 0x90, 0xee, 0x0d, 0xca, 0xdf, 0x0b, 0x33, 0x4a, 0x99, 0xc4, 0xb0, 0x8b,
+	0x9e, 0x34, 0x73, 0xf2, 0xf5, 0xf2, 0x28, 0x3f, 0x41, 0x9b, 0xad, 0x8e, 0x6c, 0x2d, 0xe4, 0x48,
+	0x64, 0xd2, 0x3e, 0xce, 0x47, 0xc5, 0x0a, 0x47, 0xcb, 0xcf, 0xc0, 0x8c, 0xf2, 0x16, 0x4c, 0x16,
+	0xe1, 0x48, 0xc3, 0xd9, 0x70, 0xdc, 0x2b, 0x4e, 0x73, 0xb5, 0x61, 0xd9, 0x26, 0x65, 0x4d, 0xff,
+	0x6a, 0x9d, 0xea, 0x07, 0x48, 0x1e, 0xb4, 0xcb, 0xd6, 0xaa, 0x9e, 0x21, 0x45, 0xc8, 0xad, 0x1a,
+	0xd7, 0xa8, 0xad, 0x67, 0xc9, 0x3c, 0x00, 0x47, 0xd5, 0x8d, 0xca, 0x86, 0xa7, 0x6b, 0x04, 0x60,
+	0xba, 0xd2, 0xf0, 0x7c, 0xb7, 0xa6, 0x4f, 0xe1, 0xef, 0x0d, 0xc3, 0xb1, 0x36, 0x5c, 0x3d, 0x87,
+	0xbf, 0x4d, 0xb7, 0xb2, 0x41, 0x99, 0x3e, 0x4d, 0xa6, 0x21, 0xbb, 0xe1, 0xea, 0xf9, 0x65, 0x13,
+	0x8a, 0xd1, 0x03, 0x38, 0x39, 0x0a, 0x24, 0xb1, 0x6c, 0xb8, 0xe8, 0x0c, 0xe4, 0x2b, 0x76, 0xc3,
+	0xf3, 0x29, 0xd3, 0x33, 0xa8, 0xc1, 0x5a, 0x65, 0x55, 0xcf, 0xa2, 0x06, 0xb6, 0x5b, 0x31, 0x6c,
+	0x5d, 0x5b, 0x76, 0xf1, 0x4a, 0x1a, 0x3f, 0xe1, 0x92, 0xe3, 0xb0, 0x10, 0x0a, 0x32, 0x69, 0xdd,
+	0x76, 0xaf, 0xc6, 0x06, 0x14, 0x60, 0x6a, 0x9d, 0xda, 0x35, 0x3d, 0x43, 0xe6, 0xa0, 0xb8, 0xc1,
+	0xd5, 0xb4, 0xae, 0x51, 0x3d, 0x8b, 0x8b, 0x6c, 0x34, 0x56, 0x69, 0xc5, 0x47, 0x81, 0x16, 0xcc,
+	0x28, 0x4f, 0xc9, 0xaa, 0x3f, 0xa4, 0x22, 0xa1, 0xb8, 0x59, 0x28, 0xd4, 0x2c, 0xc7, 0x42, 0x4e,
+	0xa9, 0xdb, 0x06, 0x15, 0xba, 0xb9, 0xfe, 0x3a, 0x65, 0xba, 0xb6, 0xfc, 0xd2, 0xfd, 0x00, 0x71,
+	0x81, 0x47, 0xc3, 0xdd, 0x0d, 0xfd, 0x00, 0x59, 0x84, 0xc3, 0x9e, 0x6f, 0xf8, 0x0d, 0xaf, 0xb2,
+	0x4e, 0x2b, 0x1b, 0x4d, 0xaf, 0x51, 0xa9, 0x50, 0xcf, 0xd3, 0xff, 0x28, 0x43, 0x08, 0xcc, 0x09,
+	0xeb, 0xc3, 0xb9, 0xcf, 0x64, 0xc8, 0x61, 0x98, 0x17, 0x86, 0x44, 0x93, 0x9f, 0xcd, 0x90, 0x93,
+	0xb0, 0x28, 0x80, 0xf5, 0x86, 0xb7, 0xde, 0x34, 0xf8, 0x7c, 0xd3, 0xa4, 0x8e, 0x45, 0x4d, 0x3d,
+	0x20, 0x27, 0xe0, 0x98, 0xa4, 0x32, 0xf7, 0x32, 0xad, 0xf8, 0x4d, 0xc7, 0xf5, 0x9b, 0x55, 0xb7,
+	0xe1, 0x98, 0xfa, 0x0d, 0x72, 0x3f, 0x9c, 0x11, 0x44, 0xb1, 0x21, 0x4d, 0xd3, 0xa0, 0x35, 0xd7,
+	0xe1, 0x10, 0xd6, 0x70, 0x1c, 0xcb, 0x59, 0xd3, 0x77, 0xc8, 0x11, 0xd0, 0x05, 0xa8, 0xe1, 0x51,
+	0xd6, 0xa4, 0x8c, 0xb9, 0x4c, 0xbf, 0x19, 0xaf, 0x2a, 0x59, 0x1b, 0x8e, 0xb1, 0x69, 0x58, 0xb6,
+	0xb1, 0x6a, 0x53, 0xbd, 0x4d, 0x4e, 0xc1, 0xf1, 0x34, 0xb5, 0xe1, 0xaf, 0xbb, 0xcc, 0xba, 0x46,
+	0x4d, 0xfd, 0xc9, 0x58, 0x29, 0x49, 0xf6, 0xae, 0x7a, 0x3e, 0xad, 0xa1, 0x6c, 0xfd, 0x29, 0x72,
+	0x1f, 0x9c, 0x4a, 0x10, 0x51, 0x9b, 0x9a, 0x6b, 0x5a, 0x55, 0x8b, 0x9a, 0x1c, 0xd2, 0x21, 0x67,
+	0x61, 0x69, 0x04, 0x62, 0xd5, 0xea, 0x36, 0xad, 0x51, 0xc7, 0x97, 0xa8, 0x5d, 0x72, 0x1a, 0x4a,
+	0x29, 0xeb, 0x7c, 0xa3, 0x69, 0xbb, 0x9e, 0xc7, 0xe9, 0xdd, 0x11, 0x7a, 0xd5, 0x65, 0xab, 0x96,
+	0x69, 0x52, 0x87, 0xd3, 0x7b, 0x23, 0x46, 0x54, 0x5c, 0xa7, 0x6a, 0x5b, 0x15, 0x9f, 0x93, 0xf7,
+	0xc8, 0x12, 0x9c, 0x4c, 0x90, 0xb9, 0x67, 0x14, 0xf7, 0x3e, 0x4d, 0xca, 0x70, 0x3a, 0x81, 0xb0,
+	0x9c, 0x4d, 0xc3, 0xb6, 0xcc, 0x66, 0xdd, 0x60, 0x86, 0xb0, 0xb6, 0x9f, 0x56, 0xa2, 0x6a, 0xd9,
+	0x54, 0x91, 0x31, 0x18, 0x31, 0xb5, 0x62, 0x54, 0xd6, 0x69, 0xb3, 0xca, 0xdc, 0x5a, 0xb3, 0xde,
+	0xb0, 0x6d, 0x2e, 0x65, 0x48, 0xce, 0xc0, 0x89, 0x04, 0x6a, 0x8d, 0xfa, 0x4d, 0xd3, 0x5a, 0xa3,
+	0x9e, 0x50, 0x76, 0x3f, 0x76, 0x2a, 0xa3, 0x6b, 0x96, 0xe7, 0xb3, 0xab, 0x69, 0xc8, 0xad, 0x18,
+	0x12, 0xc6, 0xf8, 0x65, 0x6b, 0xb5, 0x59, 0xb7, 0x1b, 0x6b, 0x96, 0x23, 0xc2, 0xfc, 0x99, 0x78,
+	0xd3, 0x91, 0xb4, 0xc6, 0x0c, 0xd3, 0xa6, 0x78, 0xb2, 0xb8, 0x80, 0xb7, 0xc6, 0xbb, 0x8a, 0xd4,
+	0x9a, 0xb1, 0x49, 0x9d, 0x88, 0x78, 0x9b, 0x2c, 0xc3, 0x79, 0xcb, 0xb1, 0xfc, 0x68, 0xc7, 0xa8,
+	0x7f, 0xc5, 0x65, 0x1b, 0x4d, 0xdb, 0xf2, 0x7c, 0xcb, 0x59, 0x43, 0xdf, 0xfa, 0x86, 0xe5, 0x50,
+	0xe6, 0xe9, 0x6f, 0x23, 0x2b, 0xb0, 0x3c, 0x0e, 0x1b, 0xba, 0x2f, 0xc2, 0x36, 0x1d, 0xa3, 0x46,
+	0xf5, 0xef, 0x27, 0x0f, 0xc1, 0x83, 0xe3, 0xf0, 0x31, 0xce, 0x74, 0xa9, 0xc7, 0xbd, 0x4a, 0x9f,
+	0xb0, 0x3c, 0x5f, 0xff, 0x81, 0x78, 0xef, 0x6a, 0x0d, 0xdb, 0xb7, 0x9a, 0x75, 0xdb, 0xf0, 0xab,
+	0x2e, 0xab, 0x35, 0x1d, 0x97, 0x9f, 0x22, 0xfd, 0x07, 0xc9, 0x19, 0x28, 0xa9, 0x07, 0xd3, 0xaa,
+	0x19, 0x6b, 0x34, 0xf6, 0xf8, 0x6f, 0x67, 0xc9, 0xfd, 0x70, 0x5a, 0x05, 0xc4, 0x8b, 0x55, 0x18,
+	0x35, 0xd0, 0x26, 0xfd, 0xc5, 0x2c, 0x29, 0xc3, 0x29, 0x15, 0xc4, 0x1a, 0x8e, 0x02, 0x44, 0x41,
+	0x1f, 0xca, 0x92, 0x73, 0xb0, 0x34, 0x5e, 0x90, 0x4f, 0x59, 0xcd, 0x72, 0x0c, 0x9f, 0x9a, 0xfa,
+	0x87, 0xb3, 0xe4, 0x01, 0x38, 0xaf, 0xc2, 0x44, 0x1e, 0xc0, 0x78, 0x6f, 0x32, 0xd7, 0xb6, 0xdd,
+	0x86, 0xdf, 0xac, 0x53, 0xc7, 0xc4, 0x75, 0x7f, 0x27, 0x4b, 0x1e, 0x84, 0x37, 0x25, 0xd2, 0x8a,
+	0x6f, 0xf8, 0xb4, 0xda, 0xb0, 0x3d, 0x3a, 0x8a, 0xfe, 0x48, 0x96, 0x2c, 0xc3, 0xb9, 0x84, 0x68,
+	0x9e, 0x05, 0xc6, 0x61, 0x5f, 0xca, 0x92, 0x93, 0x70, 0x4c, 0xc5, 0x5e, 0x76, 0x57, 0x23, 0xea,
+	0x47, 0xb3, 0xe4, 0x04, 0x1c, 0x4d, 0x53, 0xab, 0x86, 0x65, 0x53, 0x53, 0xff, 0xd8, 0x08, 0x6b,
+	0xdd, 0x35, 0x23, 0xd6, 0x8f, 0x8f, 0xb0, 0x22, 0x55, 0xb2, 0x7e, 0x22, 0x4b, 0x2e, 0xc0, 0xfd,
+	0x09, 0x1f, 0xf1, 0x84, 0xdd, 0x64, 0xd4, 0x73, 0x1b, 0xac, 0x42, 0x23, 0x31, 0x9f, 0xbc, 0x83,
+	0x37, 0x19, 0xf5, 0x7c, 0x83, 0xf1, 0x8d, 0xf9, 0x5c, 0x96, 0x94, 0x60, 0x41, 0x85, 0x35, 0x9c,
+	0x75, 0x6a, 0xd8, 0xfe, 0xfa, 0x55, 0xfd, 0xf3, 0x23, 0x22, 0x1c, 0xd7, 0xa4, 0xcd, 0x1a, 0xad,
+	0xb9, 0xec, 0x6a, 0xb3, 0xce, 0xa8, 0xe7, 0x35, 0x18, 0xd5, 0x7f, 0x46, 0x4b, 0x07, 0x00, 0x87,
+	0x99, 0x96, 0xb7, 0x11, 0x83, 0xde, 0xa3, 0x91, 0x37, 0xc3, 0xd9, 0x11, 0x50, 0x18, 0x9f, 0x6a,
+	0xca, 0xfc, 0x59, 0x2d, 0x1d, 0x2b, 0x1c, 0x5a, 0xc7, 0x6c, 0x11, 0x8a, 0x7b, 0xef, 0xf8, 0x35,
+	0x1b, 0x0e, 0x8e, 0xcc, 0x86, 0x10, 0xf4, 0x73, 0x1a, 0xb9, 0x0f, 0x4e, 0x8e, 0x01, 0x31, 0x6a,
+	0x54, 0xd6, 0x39, 0xe4, 0x39, 0x2d, 0x1d, 0xdd, 0x42, 0x2d, 0xcc, 0xfa, 0xd4, 0x30, 0xaf, 0xea,
+	0xef, 0x1b, 0x51, 0x46, 0xec, 0x44, 0x53, 0x2e, 0x84, 0x3e, 0x7c, 0x5e, 0x23, 0x6f, 0x82, 0xb2,
+	0x8a, 0x91, 0x65, 0x13, 0x5d, 0xee, 0xd0, 0x8a, 0x6f, 0xb9, 0x22, 0x8f, 0xfe, 0xfc, 0x88, 0xd6,
+	0x21, 0x10, 0x8d, 0xdb, 0xb0, 0x6c, 0xdc, 0xe2, 0x5f, 0x18, 0xf1, 0x54, 0x24, 0xcd, 0xb6, 0x30,
+	0xc6, 0xab, 0xd4, 0xaf, 0xac, 0x73, 0x79, 0xbf, 0xa8, 0xa5, 0x37, 0x48, 0x39, 0x0a, 0x31, 0xec,
+	0x97, 0x34, 0x72, 0x1e, 0xee, 0x9b, 0x74, 0x08, 0x62, 0xdc, 0x2f, 0x6b, 0xe4, 0x2c, 0x9c, 0x19,
+	0x1f, 0xfe, 0x31, 0xea, 0x57, 0x34, 0x72, 0x1a, 0x8e, 0x8f, 0x84, 0x76, 0x44, 0xff, 0xd5, 0x11,
+	0x3a, 0x8f, 0xdf, 0x88, 0xfe, 0x82, 0x96, 0x3e, 0x64, 0xe9, 0x10, 0x8e, 0xb1, 0x1f, 0x18, 0xd9,
+	0x41, 0x94, 0x85, 0x09, 0xce, 0x32, 0x6c, 0xeb, 0x1a, 0x3a, 0xff, 0x0f, 0x34, 0x6c, 0x0f, 0xc2,
+	0x3c, 0x2d, 0x4a, 0xf2, 0xcb, 0x5a, 0xba, 0x99, 0x90, 0x74, 0xfd, 0x95, 0x11, 0x57, 0x84, 0x9c,
+	0xc9, 0xd0, 0x79, 0x75, 0x54, 0xc9, 0xe8, 0xf4, 0x5c, 0x31, 0x2c, 0x9e, 0xa7, 0x43, 0x99, 0x5f,
+	0x1a, 0x31, 0x38, 0xd2, 0x66, 0x93, 0x3a, 0xbe, 0xfe, 0x75, 0x4d, 0x69, 0x56, 0x42, 0xa6, 0x2f,
+	0x6b, 0xe4, 0x10, 0xcc, 0x7a, 0x57, 0x9d, 0x4a, 0x34, 0xf5, 0x15, 0x2d, 0x6e, 0x74, 0xc2, 0xb9,
+	0xd7, 0x34, 0x72, 0x04, 0x0e, 0x9a, 0x74, 0x93, 0x27, 0xf5, 0x70, 0xf6, 0xab, 0x7c, 0xb6, 0x62,
+	0x53, 0xc3, 0x69, 0xd4, 0xa3, 0xd9, 0xaf, 0x71, 0x91, 0x09, 0xe0, 0x37, 0x34, 0x72, 0x1c, 0x8e,
+	0xa4, 0xda, 0x0f, 0x41, 0xfa, 0x26, 0x47, 0xfb, 0x58, 0xf3, 0xc2, 0xa9, 0x67, 0xa7, 0x50, 0x2c,
+	0xd7, 0x89, 0x4b, 0x11, 0xce, 0xfc, 0xdb, 0x29, 0x94, 0xc1, 0x67, 0x1d, 0xc3, 0xb7, 0x36, 0x69,
+	0x93, 0x3e, 0x41, 0x2b, 0x7c, 0x7b, 0xfe, 0x2e, 0x66, 0x58, 0xa7, 0x76, 0x5d, 0xe6, 0xf1, 0xbf,
+	0x9f, 0x22, 0x4b, 0x70, 0x22, 0xd4, 0x59, 0x14, 0x59, 0xca, 0x64, 0xff, 0x6a, 0xd2, 0xba, 0xa7,
+	0xff, 0x6e, 0x0e, 0x4f, 0xdd, 0x08, 0x82, 0x2b, 0xc3, 0x01, 0xbf, 0x97, 0xc3, 0x7d, 0x1f, 0x01,
+	0x48, 0x1f, 0x72, 0xc8, 0xa7, 0x72, 0x63, 0x57, 0xc1, 0xc6, 0xc4, 0x5a, 0x43, 0x88, 0xfe, 0xe9,
+	0x1c, 0x86, 0x73, 0xec, 0x3b, 0xaf, 0x51, 0xaf, 0xbb, 0x0c, 0x7b, 0xa2, 0xcd, 0x87, 0x9b, 0x35,
+	0xc3, 0xb1, 0xaa, 0xd4, 0xf3, 0xf5, 0xdf, 0xcf, 0xa5, 0x33, 0x00, 0xef, 0xed, 0x2a, 0x86, 0x53,
+	0xa1, 0xfc, 0x3c, 0xbe, 0x30, 0x9d, 0xce, 0x00, 0x26, 0x35, 0x4c, 0xdb, 0x72, 0xd0, 0x11, 0x15,
+	0x4a, 0x4d, 0x6a, 0xea, 0x1f, 0x98, 0x46, 0x47, 0x08, 0x0b, 0x63, 0xce, 0x5f, 0x9b, 0x26, 0x0b,
+	0xa0, 0x4b, 0xa5, 0xe3, 0xe9, 0x5f, 0x9f, 0xc6, 0x04, 0x9f, 0xea, 0x64, 0x42, 0xe2, 0x6f, 0x4c,
+	0x63, 0x3e, 0x4e, 0xf6, 0x6a, 0x72, 0x39, 0xfd, 0x83, 0xd3, 0xe4, 0x14, 0x2c, 0x72, 0x6b, 0x78,
+	0x61, 0xa5, 0x4d, 0xdf, 0x58, 0x5b, 0x8b, 0x1a, 0xd1, 0x77, 0xe6, 0xd1, 0x12, 0x4e, 0x0e, 0x1b,
+	0xf0, 0x66, 0xdd, 0x68, 0x78, 0xa2, 0x09, 0x74, 0x99, 0xfe, 0xa3, 0x79, 0x74, 0x48, 0x12, 0xa0,
+	0xf4, 0xb7, 0x12, 0xf5, 0xae, 0x3c, 0x86, 0xb3, 0xba, 0x4a, 0x78, 0xe1, 0x11, 0xf4, 0x1f, 0x8b,
+	0x97, 0x91, 0xf4, 0xe8, 0x42, 0x21, 0x00, 0x3f, 0x3e, 0x02, 0x08, 0x37, 0x56, 0x02, 0x7e, 0x22,
+	0x8f, 0x7e, 0x11, 0x00, 0xde, 0xc2, 0x89, 0xe9, 0x77, 0xc7, 0xea, 0x49, 0xbe, 0x2b, 0x06, 0x26,
+	0x02, 0x9f, 0x59, 0x8a, 0x95, 0x3f, 0x99, 0xc7, 0x1c, 0xaa, 0xa2, 0xb0, 0x92, 0x55, 0x8d, 0x8a,
+	0xba, 0xc2, 0x4f, 0xe5, 0x71, 0xcf, 0x42, 0xcf, 0xcb, 0xfb, 0x49, 0x2a, 0x19, 0x7f, 0x21, 0x8f,
+	0xc9, 0x33, 0x0a, 0xa9, 0xd5, 0xc6, 0x5a, 0x18, 0xc4, 0x8c, 0xfa, 0xcc, 0xa2, 0x9b, 0x5c, 0x2f,
+	0xfd, 0x5f, 0xf3, 0xe4, 0x18, 0x90, 0x48, 0x94, 0x38, 0x72, 0x48, 0xf8, 0xb7, 0x3c, 0xee, 0x86,
+	0x24, 0xe0, 0x05, 0xaa, 0x69, 0xd4, 0xeb, 0xf6, 0xd5, 0xa6, 0x6d, 0xac, 0x52, 0xdb, 0xd3, 0xff,
+	0x3d, 0x8f, 0xc7, 0x46, 0x25, 0x87, 0x77, 0x06, 0xfd, 0x3f, 0x54, 0x4e, 0xc7, 0x6d, 0xd6, 0xd0,
+	0x4c, 0xdc, 0x00, 0xee, 0x68, 0xfd, 0x3f, 0xf3, 0xd8, 0x1e, 0xa8, 0x9c, 0x9b, 0x94, 0x79, 0xa1,
+	0xda, 0xff, 0x95, 0x17, 0x71, 0x1f, 0x53, 0x6b, 0x96, 0x93, 0x40, 0xfc, 0x77, 0x5e, 0x9c, 0x2e,
+	0x8e, 0x08, 0x6b, 0x87, 0x0a, 0xf8, 0xeb, 0x82, 0x38, 0x18, 0x09, 0x80, 0x5b, 0xad, 0xf2, 0x98,
+	0xae, 0x61, 0xfd, 0x43, 0xd4, 0xff, 0xe4, 0x15, 0x14, 0x65, 0x71, 0xde, 0xab, 0xba, 0x18, 0x93,
+	0x36, 0x45, 0x4f, 0xea, 0x5f, 0x54, 0x6d, 0xc1, 0x92, 0x19, 0x9d, 0x2c, 0x2e, 0xe4, 0x65, 0x55,
+	0x08, 0x27, 0x33, 0x5a, 0x73, 0x7d, 0x9a, 0x44, 0xbd, 0xa2, 0x0a, 0xc1, 0x36, 0x38, 0x49, 0x7e,
+	0x55, 0x75, 0x48, 0xa8, 0x6f, 0xe4, 0xcd, 0x2f, 0xf1, 0x78, 0x8d, 0xa8, 0xf2, 0xfa, 0x1a, 0xd3,
+	0xbf, 0x9c, 0xd4, 0xb0, 0x6e, 0x1b, 0x15, 0x2a, 0x7b, 0x58, 0x24, 0x7f, 0x45, 0x0d, 0x15, 0x9f,
+	0x19, 0x8e, 0xc7, 0xbb, 0xdf, 0x84, 0x02, 0xaf, 0xa9, 0x7b, 0x89, 0xd5, 0x90, 0xef, 0x31, 0x27,
+	0x7d, 0x55, 0x5d, 0x3d, 0x62, 0xba, 0xc2, 0x2c, 0x5f, 0x88, 0xff, 0x9a, 0x1a, 0x65, 0x75, 0x83,
+	0x79, 0x8a, 0xe9, 0x5c, 0x09, 0x71, 0x03, 0xfb, 0x7a, 0x1e, 0xfb, 0x3a, 0x75, 0x57, 0x65, 0x70,
+	0x3b, 0xa2, 0x59, 0x8f, 0xbb, 0xa3, 0x6f, 0xe4, 0xb1, 0xb6, 0x85, 0xba, 0xf8, 0xcc, 0xf0, 0xe9,
+	0x1a, 0xdf, 0x1f, 0xfd, 0x9b, 0x6a, 0xa4, 0x62, 0xbb, 0x4a, 0xcd, 0xe6, 0xaa, 0x51, 0xd9, 0xd0,
+	0x9f, 0x2d, 0x28, 0x2c, 0x48, 0xc0, 0x59, 0xce, 0xf2, 0xc3, 0x05, 0x25, 0x43, 0xd5, 0x59, 0xc3,
+	0x11, 0x4a, 0xbf, 0xbd, 0xa0, 0x44, 0x50, 0xa4, 0x2e, 0x66, 0xbf, 0x9a, 0xc1, 0x01, 0x3f, 0x32,
+	0x16, 0x50, 0x77, 0x6d, 0xab, 0x22, 0x74, 0x79, 0x47, 0x01, 0x4f, 0x69, 0x1a, 0xc0, 0xef, 0x2c,
+	0x46, 0x74, 0x02, 0xdf, 0x59, 0x40, 0xb7, 0x8a, 0x12, 0xe4, 0xc5, 0xb9, 0x1b, 0x49, 0x1f, 0x29,
+	0x92, 0xa3, 0x70, 0x88, 0x93, 0x2a, 0x21, 0x19, 0xe7, 0x5f, 0x2a, 0x62, 0x5d, 0x16, 0xf3, 0xa2,
+	0x6b, 0xa8, 0xd4, 0x4c, 0x7e, 0x97, 0x70, 0x5c, 0xa7, 0x79, 0x8d, 0x32, 0x17, 0xef, 0x35, 0x62,
+	0xd7, 0x3e, 0x5a, 0x44, 0xd7, 0x8f, 0xc3, 0xfa, 0x56, 0x8d, 0x9a, 0xd8, 0xcc, 0x23, 0xec, 0x63,
+	0x45, 0x6c, 0x09, 0xc6, 0xc1, 0xa2, 0xac, 0xcc, 0x71, 0x1f, 0x9f, 0x88, 0xc3, 0x7a, 0xd8, 0x88,
+	0xac, 0xfa, 0xc4, 0xc8, 0xb2, 0x26, 0xc5, 0xa6, 0x9c, 0x3a, 0x15, 0x8b, 0x7a, 0x9c, 0x09, 0x61,
+	0x9f, 0x2c, 0x62, 0x15, 0x58, 0x77, 0xdd, 0x8d, 0x31, 0xaa, 0x3f, 0x07, 0xb8, 0x95, 0x9c, 0x98,
+	0x14, 0xfe, 0xbe, 0x98, 0x90, 0xd4, 0xee, 0xf9, 0x98, 0xc0, 0x0f, 0x50, 0xdd, 0x35, 0x45, 0x64,
+	0xbd, 0x1f, 0x30, 0xbe, 0xb1, 0xf0, 0x55, 0x5d, 0x76, 0xc5, 0x60, 0x26, 0x6f, 0xa1, 0xa2, 0x6b,
+	0xb5, 0x38, 0x03, 0x80, 0xdb, 0xa9, 0x62, 0x52, 0xb9, 0xf2, 0x35, 0x58, 0x7e, 0x1e, 0x60, 0x3e,
+	0xf9, 0xe8, 0x4a, 0xf2, 0xa0, 0x39, 0x96, 0xad, 0x1f, 0x20, 0x47, 0x40, 0x37, 0x4c, 0xac, 0xed,
+	0x55, 0xa3, 0x61, 0x63, 0x31, 0xae, 0xbb, 0xfa, 0x36, 0x39, 0x0a, 0x24, 0xac, 0x97, 0xca, 0x7c,
+	0x80, 0xd7, 0xf8, 0xd1, 0xf9, 0xe6, 0x9a, 0xed, 0xae, 0x1a, 0xb6, 0x8c, 0x01, 0xfd, 0x06, 0x5e,
+	0x4b, 0xd7, 0x2a, 0xb6, 0xdb, 0x88, 0xca, 0xa0, 0xd1, 0xf0, 0xd7, 0x25, 0x19, 0x6f, 0x00, 0x3b,
+	0xe4, 0x38, 0x2c, 0x8c, 0x27, 0xdd, 0x24, 0x8b, 0x70, 0x44, 0x2c, 0x21, 0x45, 0xc8, 0x07, 0x1f,
+	0xbd, 0x1d, 0x53, 0x24, 0x6b, 0xf8, 0xb6, 0xf3, 0x24, 0xaa, 0x5b, 0xb5, 0x9e, 0x10, 0xa1, 0x26,
+	0xea, 0xaf, 0x78, 0x83, 0x39, 0x0a, 0x44, 0x62, 0xc3, 0x57, 0x03, 0x9f, 0x5d, 0xd5, 0x3b, 0xa4,
+	0x0c, 0xa7, 0x11, 0xaf, 0x3c, 0x42, 0x44, 0x85, 0x48, 0x1a, 0xb1, 0x1b, 0x62, 0xbc, 0x0d, 0xa3,
+	0x5a, 0x75, 0x6d, 0x33, 0xea, 0x4e, 0xa2, 0xf7, 0x0d, 0xbd, 0x8b, 0x86, 0x22, 0x46, 0x79, 0x61,
+	0x08, 0x2d, 0xe1, 0x27, 0x45, 0xef, 0x91, 0x73, 0x70, 0x1f, 0x22, 0x26, 0x5e, 0xe9, 0xf9, 0xd5,
+	0x7f, 0x8f, 0x2c, 0xc3, 0xf9, 0x84, 0x69, 0xa3, 0xc0, 0xd0, 0xd8, 0xa7, 0xc9, 0x69, 0x28, 0xf1,
+	0x27, 0xb2, 0xd4, 0x9d, 0x5f, 0x64, 0x24, 0xbd, 0x8f, 0x19, 0x53, 0xb6, 0xbd, 0x23, 0xc5, 0x53,
+	0xff, 0x4c, 0x06, 0x5b, 0x14, 0x41, 0x8e, 0xfa, 0x08, 0xd1, 0x1f, 0xe9, 0x9f, 0xcd, 0x88, 0x1e,
+	0xd4, 0xf3, 0x0d, 0xdb, 0xe6, 0x89, 0x4c, 0xff, 0x63, 0x3e, 0xd5, 0xa8, 0xaf, 0x31, 0xc3, 0xa4,
+	0x62, 0xea, 0x4f, 0x32, 0xe4, 0x21, 0x78, 0x60, 0x9c, 0x67, 0x44, 0x1d, 0x0d, 0xfd, 0xe8, 0x6e,
+	0x52, 0xc6, 0x2c, 0x93, 0x7a, 0xfa, 0x9f, 0xf2, 0x07, 0x3f, 0x55, 0xc8, 0xa3, 0x8f, 0xe8, 0x7f,
+	0x96, 0x21, 0x2b, 0xf0, 0xe6, 0x89, 0x62, 0xc2, 0x0c, 0x6a, 0xd4, 0xa8, 0x57, 0x37, 0x2a, 0x54,
+	0xff, 0xf3, 0x0c, 0xe6, 0x41, 0xc4, 0xa7, 0x72, 0x9a, 0xfe, 0x17, 0x19, 0x3c, 0x24, 0x09, 0x8a,
+	0x4c, 0x66, 0x9b, 0x96, 0x6b, 0x8b, 0x7d, 0xf8, 0xcb, 0x0c, 0xf6, 0x78, 0xa1, 0x69, 0xe1, 0xc3,
+	0xe8, 0x3f, 0x64, 0x88, 0x0e, 0x33, 0xe1, 0xac, 0x5b, 0x37, 0xf4, 0x7f, 0xcc, 0x60, 0x56, 0x4b,
+	0x5f, 0x1a, 0x6c, 0x77, 0xcd, 0xd3, 0x5f, 0xcc, 0xc6, 0x9e, 0xc3, 0x62, 0x68, 0x39, 0xd4, 0xf3,
+	0x30, 0x38, 0x57, 0xa9, 0xfe, 0x21, 0x85, 0x16, 0xb3, 0xf1, 0x0d, 0xd1, 0x3f, 0x9c, 0xc5, 0x76,
+	0xd8, 0x30, 0x4d, 0xbc, 0xfe, 0x4e, 0xbc, 0x84, 0x9f, 0x81, 0x52, 0x02, 0x32, 0x72, 0x01, 0x3f,
+	0x07, 0x4b, 0x09, 0xc0, 0x84, 0xcb, 0xf7, 0x69, 0x38, 0x9e, 0x80, 0xa5, 0x2f, 0xde, 0xe9, 0x75,
+	0x46, 0x2e, 0xdd, 0xa7, 0x60, 0x31, 0x05, 0x48, 0x5c, 0xb8, 0x4f, 0xc0, 0xd1, 0xa4, 0x1a, 0xea,
+	0x65, 0x5b, 0x59, 0x7c, 0xec, 0x45, 0x3b, 0xf2, 0xd1, 0xba, 0xeb, 0xf9, 0x6a, 0x54, 0xbe, 0x9f,
+	0xdf, 0xb2, 0xf8, 0xbb, 0x46, 0x14, 0x95, 0x78, 0xdd, 0x5b, 0x00, 0xbd, 0xe1, 0xf0, 0x36, 0x38,
+	0x9e, 0x7e, 0x95, 0xdf, 0x9f, 0x30, 0x75, 0xcb, 0xa3, 0x52, 0x6f, 0xd8, 0xb6, 0xfe, 0x9b, 0x53,
+	0xbc, 0xd1, 0xa7, 0xa8, 0x8d, 0x83, 0xfd, 0x6e, 0xd5, 0x36, 0xd6, 0xa2, 0xbe, 0xa8, 0x6a, 0xd8,
+	0x1e, 0xd5, 0xff, 0x66, 0x8a, 0x1c, 0x04, 0x70, 0xeb, 0xd4, 0x69, 0x5a, 0x9e, 0xd7, 0xa0, 0xfa,
+	0x3b, 0xf2, 0x8f, 0x7c, 0x70, 0x1a, 0x0e, 0x7a, 0xf2, 0xff, 0xca, 0x78, 0x41, 0xff, 0x56, 0x7b,
+	0x2b, 0x20, 0x15, 0x28, 0xac, 0x05, 0x43, 0xf9, 0xe7, 0xac, 0x23, 0x5f, 0x0c, 0xe9, 0xee, 0xde,
+	0xf0, 0x76, 0x29, 0xf1, 0xbf, 0x59, 0xca, 0x87, 0xde, 0xfe, 0x57, 0x9f, 0x7b, 0x6f, 0x76, 0x86,
+	0x14, 0x2f, 0xde, 0x7a, 0xf8, 0x22, 0xff, 0x20, 0x47, 0xd6, 0xa0, 0xc0, 0xbf, 0x17, 0xda, 0xbd,
+	0x1d, 0x12, 0xfe, 0xc5, 0x54, 0xf8, 0x69, 0xb2, 0x94, 0x9e, 0x28, 0x2f, 0x70, 0x01, 0x07, 0xc9,
+	0x1c, 0x0a, 0x10, 0x7f, 0xe6, 0xd7, 0xe9, 0xed, 0x5c, 0xc8, 0x3c, 0x94, 0x21, 0x6b, 0x30, 0xcd,
+	0x05, 0x0d, 0x26, 0xea, 0x32, 0x22, 0x8d, 0x70, 0x69, 0xb3, 0x04, 0x22, 0x69, 0x83, 0x87, 0x32,
+	0xe4, 0x09, 0xc8, 0xd3, 0xb7, 0x06, 0x5b, 0xfb, 0xc3, 0x80, 0x2c, 0x4a, 0x8e, 0x91, 0x6f, 0x95,
+	0xa5, 0x09, 0x6b, 0x94, 0x4f, 0x70, 0x91, 0x0b, 0xe5, 0x19, 0x2e, 0x52, 0x88, 0xb9, 0x24, 0xbf,
+	0x5c, 0x92, 0x16, 0x14, 0x8d, 0xfd, 0x61, 0x8f, 0x7f, 0xf9, 0x20, 0x0b, 0xc9, 0xaf, 0x94, 0x77,
+	0x13, 0x7c, 0x8e, 0x0b, 0x3e, 0x53, 0x3a, 0x8a, 0x82, 0xf9, 0x87, 0xc7, 0x8b, 0xad, 0xfd, 0x61,
+	0xaf, 0x19, 0xae, 0x21, 0xbe, 0x6f, 0x92, 0x26, 0x14, 0x70, 0x09, 0xfe, 0x65, 0xff, 0x1e, 0x57,
+	0x38, 0xcb, 0x57, 0x38, 0x5d, 0x5a, 0xe0, 0x9b, 0x73, 0xbb, 0xbb, 0x35, 0x76, 0x81, 0x2d, 0x00,
+	0x5c, 0x40, 0x7c, 0x77, 0xb9, 0xd7, 0x25, 0xce, 0xf3, 0x25, 0x96, 0x4a, 0xc7, 0x70, 0x09, 0xf1,
+	0x49, 0x74, 0xec, 0x22, 0x4f, 0xc2, 0x7c, 0xf2, 0x63, 0x21, 0x39, 0x19, 0xfe, 0x01, 0xc4, 0xb8,
+	0xef, 0x9a, 0xa5, 0x53, 0x13, 0xa8, 0xe2, 0x0b, 0x63, 0xb4, 0x29, 0xba, 0x58, 0xf6, 0xfa, 0xfe,
+	0xce, 0xc5, 0x16, 0x47, 0x5e, 0xca, 0x2c, 0x13, 0x1b, 0xa6, 0xd7, 0x5b, 0xdd, 0xed, 0x4e, 0x40,
+	0x12, 0xdf, 0xaf, 0x27, 0xda, 0x70, 0x92, 0x0b, 0x3b, 0x5a, 0x3e, 0x14, 0x07, 0xcd, 0xc5, 0x9b,
+	0x5c, 0xc0, 0xa5, 0xcc, 0xf2, 0xf5, 0x69, 0x8e, 0x7e, 0xf4, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
+	0xeb, 0xda, 0xa3, 0x1d, 0x59, 0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3179,6 +3863,9 @@ type SkaffoldServiceClient interface {
 	AutoSync(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// EXPERIMENTAL. Forwards the debugging port of a debugging container and returns the connection details.
+	// The port forward is kept alive across pod restarts. Only available with `skaffold debug`.
+	AttachDebugger(ctx context.Context, in *AttachDebuggerRequest, opts ...grpc.CallOption) (*AttachDebuggerResponse, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -3299,6 +3986,15 @@ func (c *skaffoldServiceClient) AutoDeploy(ctx context.Context, in *TriggerReque
 	return out, nil
 }
 
+func (c *skaffoldServiceClient) AttachDebugger(ctx context.Context, in *AttachDebuggerRequest, opts ...grpc.CallOption) (*AttachDebuggerResponse, error) {
+	out := new(AttachDebuggerResponse)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/AttachDebugger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skaffoldServiceClient) Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/Handle", in, out, opts...)
@@ -3325,6 +4021,9 @@ type SkaffoldServiceServer interface {
 	AutoSync(context.Context, *TriggerRequest) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(context.Context, *TriggerRequest) (*empty.Empty, error)
+	// EXPERIMENTAL. Forwards the debugging port of a debugging container and returns the connection details.
+	// The port forward is kept alive across pod restarts. Only available with `skaffold debug`.
+	AttachDebugger(context.Context, *AttachDebuggerRequest) (*AttachDebuggerResponse, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(context.Context, *Event) (*empty.Empty, error)
 }
@@ -3354,6 +4053,9 @@ func (*UnimplementedSkaffoldServiceServer) AutoSync(ctx context.Context, req *Tr
 func (*UnimplementedSkaffoldServiceServer) AutoDeploy(ctx context.Context, req *TriggerRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoDeploy not implemented")
 }
+func (*UnimplementedSkaffoldServiceServer) AttachDebugger(ctx context.Context, req *AttachDebuggerRequest) (*AttachDebuggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachDebugger not implemented")
+}
 func (*UnimplementedSkaffoldServiceServer) Handle(ctx context.Context, req *Event) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
 }
@@ -3499,6 +4201,24 @@ func _SkaffoldService_AutoDeploy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldService_AttachDebugger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachDebuggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).AttachDebugger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/AttachDebugger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).AttachDebugger(ctx, req.(*AttachDebuggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkaffoldService_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Event)
 	if err := dec(in); err != nil {
@@ -3541,6 +4261,10 @@ var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoDeploy",
 			Handler:    _SkaffoldService_AutoDeploy_Handler,
 		},
+		{
+			MethodName: "AttachDebugger",
+			Handler:    _SkaffoldService_AttachDebugger_Handler,
+		},
 		{
 			MethodName: "Handle",
 			Handler:    _SkaffoldService_Handle_Handler,
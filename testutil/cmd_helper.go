@@ -72,6 +72,10 @@ func CmdRunInput(command, input string) *FakeCmd {
 	return newFakeCmd().AndRunInput(command, input)
 }
 
+func CmdRunInputOut(command, input, output string) *FakeCmd {
+	return newFakeCmd().AndRunInputOut(command, input, output)
+}
+
 func CmdRunErr(command string, err error) *FakeCmd {
 	return newFakeCmd().AndRunErr(command, err)
 }